@@ -18,6 +18,24 @@ func getOpts() ([]embedded.Option, []error) {
 		pts[i] = embeddedmock.NewPT(id)
 	}
 
+	tcIds := []string{"TC_1"}
+	tcs := make([]embedded.TCSensor, len(tcIds))
+	for i, id := range tcIds {
+		tcs[i] = embeddedmock.NewTC(id)
+	}
+
+	iioIds := []string{"iio:device0:voltage0", "iio:device0:voltage1"}
+	iios := make([]embedded.IIOSensor, len(iioIds))
+	for i, id := range iioIds {
+		iios[i] = embeddedmock.NewIIO(id)
+	}
+
+	counterIds := []string{"FLOW_1"}
+	counters := make([]embedded.PulseCounter, len(counterIds))
+	for i, id := range counterIds {
+		counters[i] = embeddedmock.NewCounter(id)
+	}
+
 	dsIds := []struct {
 		bus, id string
 	}{
@@ -63,6 +81,9 @@ func getOpts() ([]embedded.Option, []error) {
 
 	return []embedded.Option{
 		embedded.WithPT(pts),
+		embedded.WithTC(tcs),
+		embedded.WithIIO(iios),
+		embedded.WithCounters(counters),
 		embedded.WithDS18B20(dss),
 		embedded.WithHeaters(heaters),
 		embedded.WithGPIOs(gpios),
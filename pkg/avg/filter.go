@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package avg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+type FilterType string
+
+// Possible filters
+const (
+	// EMA is exponential moving average, Alpha is weight of new value
+	EMA FilterType = "ema"
+	// Median returns median of last Window values
+	Median FilterType = "median"
+	// Spike rejects value, which differs from previous one more than MaxDelta.
+	// Reference is taken from first two consecutive values within MaxDelta. Until then values are returned unfiltered,
+	// so spike at start reaches output, but it is never used as reference
+	Spike FilterType = "spike"
+	// Kalman is 1-D Kalman filter of constant value disturbed by ProcessNoise
+	Kalman FilterType = "kalman"
+)
+
+// DefaultMaxRejected is used by Spike, when MaxRejected is not provided
+const DefaultMaxRejected = 3
+
+var (
+	ErrUnknownFilter = errors.New("unknown filter")
+	ErrAlpha         = errors.New("alpha must be in range (0, 1]")
+	ErrMaxDelta      = errors.New("max delta must be greater than 0")
+	ErrNoise         = errors.New("noise must be greater than 0")
+)
+
+// Filter returns filtered value after adding new one
+type Filter interface {
+	Add(value float64) float64
+}
+
+// FilterConfig selects filter and its parameters, only parameters of Type are used
+type FilterConfig struct {
+	Type FilterType `json:"type"`
+	// Alpha is used by EMA
+	Alpha float64 `json:"alpha"`
+	// Window is used by Median. Minimum size is 1, 0 is silently changed to 1
+	Window uint `json:"window"`
+	// MaxDelta and MaxRejected are used by Spike. After MaxRejected spikes in a row, value is accepted as step change
+	MaxDelta    float64 `json:"max_delta"`
+	MaxRejected uint    `json:"max_rejected"`
+	// ProcessNoise and MeasurementNoise are used by Kalman, they are variances
+	ProcessNoise     float64 `json:"process_noise"`
+	MeasurementNoise float64 `json:"measurement_noise"`
+}
+
+// Chain passes value through filters in order of configs, empty Chain returns value untouched
+type Chain struct {
+	configs []FilterConfig
+	filters []Filter
+}
+
+// NewFilter creates Filter described by config
+func NewFilter(config FilterConfig) (Filter, error) {
+	switch config.Type {
+	case EMA:
+		if config.Alpha <= 0 || config.Alpha > 1 {
+			return nil, fmt.Errorf("NewFilter {Type: %v, Alpha: %v}: %w", config.Type, config.Alpha, ErrAlpha)
+		}
+		return &ema{alpha: config.Alpha}, nil
+	case Median:
+		window := config.Window
+		if window == 0 {
+			window = 1
+		}
+		return &median{buffer: make([]float64, 0, window), size: window}, nil
+	case Spike:
+		if config.MaxDelta <= 0 {
+			return nil, fmt.Errorf("NewFilter {Type: %v, MaxDelta: %v}: %w", config.Type, config.MaxDelta, ErrMaxDelta)
+		}
+		maxRejected := config.MaxRejected
+		if maxRejected == 0 {
+			maxRejected = DefaultMaxRejected
+		}
+		return &spike{maxDelta: config.MaxDelta, maxRejected: maxRejected}, nil
+	case Kalman:
+		if config.ProcessNoise <= 0 || config.MeasurementNoise <= 0 {
+			return nil, fmt.Errorf("NewFilter {Type: %v, ProcessNoise: %v, MeasurementNoise: %v}: %w",
+				config.Type, config.ProcessNoise, config.MeasurementNoise, ErrNoise)
+		}
+		return &kalman{q: config.ProcessNoise, r: config.MeasurementNoise}, nil
+	}
+	return nil, fmt.Errorf("NewFilter {Type: %v}: %w", config.Type, ErrUnknownFilter)
+}
+
+// NewChain creates Chain of filters described by configs
+func NewChain(configs []FilterConfig) (*Chain, error) {
+	c := &Chain{
+		configs: append([]FilterConfig(nil), configs...),
+		filters: make([]Filter, 0, len(configs)),
+	}
+	for i, config := range configs {
+		f, err := NewFilter(config)
+		if err != nil {
+			return nil, fmt.Errorf("NewChain {Filter: %v}: %w", i, err)
+		}
+		c.filters = append(c.filters, f)
+	}
+	return c, nil
+}
+
+// Add passes value through filters and returns filtered value
+func (c *Chain) Add(value float64) float64 {
+	for _, f := range c.filters {
+		value = f.Add(value)
+	}
+	return value
+}
+
+// Configs returns configs, which Chain was created with
+func (c *Chain) Configs() []FilterConfig {
+	return append([]FilterConfig(nil), c.configs...)
+}
+
+// Matches returns true, if Chain was created with same configs
+func (c *Chain) Matches(configs []FilterConfig) bool {
+	if len(c.configs) != len(configs) {
+		return false
+	}
+	for i := range configs {
+		if c.configs[i] != configs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type ema struct {
+	alpha, value float64
+	started      bool
+}
+
+func (e *ema) Add(value float64) float64 {
+	if !e.started {
+		e.value, e.started = value, true
+		return value
+	}
+	e.value += e.alpha * (value - e.value)
+	return e.value
+}
+
+type median struct {
+	buffer []float64
+	size   uint
+}
+
+func (m *median) Add(value float64) float64 {
+	if uint(len(m.buffer)) == m.size {
+		m.buffer = m.buffer[1:]
+	}
+	m.buffer = append(m.buffer, value)
+
+	sorted := append([]float64(nil), m.buffer...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+type spike struct {
+	maxDelta    float64
+	maxRejected uint
+	rejected    uint
+	last        float64
+	// seeded is set after first value, started after two consecutive values agree. Without reference value is returned as is
+	seeded, started bool
+}
+
+func (s *spike) Add(value float64) float64 {
+	if !s.started {
+		s.started = s.seeded && math.Abs(value-s.last) <= s.maxDelta
+		s.last, s.seeded = value, true
+		return value
+	}
+	if math.Abs(value-s.last) > s.maxDelta && s.rejected < s.maxRejected {
+		s.rejected++
+		return s.last
+	}
+	s.last, s.rejected = value, 0
+	return value
+}
+
+type kalman struct {
+	// q is process noise, r is measurement noise, p is variance of estimate x
+	q, r, p, x float64
+	started    bool
+}
+
+func (k *kalman) Add(value float64) float64 {
+	if !k.started {
+		k.x, k.p, k.started = value, k.r, true
+		return value
+	}
+	k.p += k.q
+	gain := k.p / (k.p + k.r)
+	k.x += gain * (value - k.x)
+	k.p *= 1 - gain
+	return k.x
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package avg_test
+
+import (
+	"github.com/a-clap/embedded/pkg/avg"
+)
+
+func (t *AvgTestSuite) TestFilter() {
+	args := []struct {
+		name     string
+		config   avg.FilterConfig
+		values   []float64
+		expected []float64
+	}{
+		{
+			name:     "ema",
+			config:   avg.FilterConfig{Type: avg.EMA, Alpha: 0.5},
+			values:   []float64{20, 22, 22, 30},
+			expected: []float64{20, 21, 21.5, 25.75},
+		},
+		{
+			name:     "ema, alpha 1 doesn't filter",
+			config:   avg.FilterConfig{Type: avg.EMA, Alpha: 1},
+			values:   []float64{20, 22, 30},
+			expected: []float64{20, 22, 30},
+		},
+		{
+			name:     "median",
+			config:   avg.FilterConfig{Type: avg.Median, Window: 3},
+			values:   []float64{20, 85, 21, 22, 23},
+			expected: []float64{20, 52.5, 21, 22, 22},
+		},
+		{
+			name:     "median, window 0 is 1",
+			config:   avg.FilterConfig{Type: avg.Median},
+			values:   []float64{20, 85, 21},
+			expected: []float64{20, 85, 21},
+		},
+		{
+			name:     "spike rejected",
+			config:   avg.FilterConfig{Type: avg.Spike, MaxDelta: 5},
+			values:   []float64{20, 21, 85, 24},
+			expected: []float64{20, 21, 21, 24},
+		},
+		{
+			name:     "spike, first value isn't reference",
+			config:   avg.FilterConfig{Type: avg.Spike, MaxDelta: 5},
+			values:   []float64{85.0, 20, 20.5, 85, 21},
+			expected: []float64{85.0, 20, 20.5, 20.5, 21},
+		},
+		{
+			name:     "spike, starts with spikes",
+			config:   avg.FilterConfig{Type: avg.Spike, MaxDelta: 5},
+			values:   []float64{85, -10, 20, 21, 85, -10, 22},
+			expected: []float64{85, -10, 20, 21, 21, 21, 22},
+		},
+		{
+			name:     "spike, step change is accepted",
+			config:   avg.FilterConfig{Type: avg.Spike, MaxDelta: 5, MaxRejected: 2},
+			values:   []float64{20, 20, 40, 40, 40, 41},
+			expected: []float64{20, 20, 20, 20, 40, 41},
+		},
+		{
+			name:     "kalman",
+			config:   avg.FilterConfig{Type: avg.Kalman, ProcessNoise: 1, MeasurementNoise: 1},
+			values:   []float64{20, 23},
+			expected: []float64{20, 22},
+		},
+	}
+	for _, arg := range args {
+		f, err := avg.NewFilter(arg.config)
+		t.Require().Nil(err, arg.name)
+		for i, value := range arg.values {
+			t.InDelta(arg.expected[i], f.Add(value), 1e-9, arg.name)
+		}
+	}
+}
+
+func (t *AvgTestSuite) TestFilter_Kalman() {
+	f, err := avg.NewFilter(avg.FilterConfig{Type: avg.Kalman, ProcessNoise: 0.001, MeasurementNoise: 1})
+	t.Require().Nil(err)
+	// Noise around 20 is smoothed
+	var value float64
+	for i := 0; i < 50; i++ {
+		value = f.Add(20 + float64(i%2*2-1))
+	}
+	t.InDelta(20, value, 0.2)
+}
+
+func (t *AvgTestSuite) TestFilter_Errors() {
+	args := []struct {
+		name   string
+		config avg.FilterConfig
+		err    error
+	}{
+		{name: "unknown", config: avg.FilterConfig{Type: "lowpass"}, err: avg.ErrUnknownFilter},
+		{name: "ema, alpha 0", config: avg.FilterConfig{Type: avg.EMA}, err: avg.ErrAlpha},
+		{name: "ema, alpha over 1", config: avg.FilterConfig{Type: avg.EMA, Alpha: 1.5}, err: avg.ErrAlpha},
+		{name: "spike without delta", config: avg.FilterConfig{Type: avg.Spike}, err: avg.ErrMaxDelta},
+		{name: "kalman without noise", config: avg.FilterConfig{Type: avg.Kalman, ProcessNoise: 1}, err: avg.ErrNoise},
+	}
+	for _, arg := range args {
+		_, err := avg.NewFilter(arg.config)
+		t.ErrorIs(err, arg.err, arg.name)
+		_, err = avg.NewChain([]avg.FilterConfig{{Type: avg.EMA, Alpha: 0.5}, arg.config})
+		t.ErrorIs(err, arg.err, arg.name)
+	}
+}
+
+func (t *AvgTestSuite) TestChain() {
+	r := t.Require()
+	configs := []avg.FilterConfig{
+		{Type: avg.Spike, MaxDelta: 5},
+		{Type: avg.EMA, Alpha: 0.5},
+	}
+	c, err := avg.NewChain(configs)
+	r.Nil(err)
+	r.True(c.Matches(configs))
+	r.False(c.Matches(configs[:1]))
+	r.False(c.Matches([]avg.FilterConfig{configs[0], {Type: avg.EMA, Alpha: 0.25}}))
+	r.Equal(configs, c.Configs())
+
+	// Spike is rejected before it reaches EMA
+	for i, expected := range []float64{20, 20.5, 20.75} {
+		r.InDelta(expected, c.Add([]float64{20, 21, 85}[i]), 1e-9)
+	}
+
+	// Empty chain doesn't change value
+	c, err = avg.NewChain(nil)
+	r.Nil(err)
+	r.True(c.Matches(nil))
+	r.InDelta(85.0, c.Add(85), 1e-9)
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package calibration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Model selects, how raw reading is mapped to calibrated temperature
+type Model string
+
+// Possible models
+const (
+	// None leaves reading untouched
+	None Model = ""
+	// Offset adds Offset to reading
+	Offset Model = "offset"
+	// TwoPoint computes gain and offset from two Points
+	TwoPoint Model = "two_point"
+	// Piecewise interpolates linearly between Points, outside of them first and last segment is extended
+	Piecewise Model = "piecewise"
+	// Polynomial computes Coefficients[0] + Coefficients[1]*x + Coefficients[2]*x^2 + ...
+	Polynomial Model = "polynomial"
+)
+
+var (
+	ErrUnknownModel    = errors.New("unknown calibration model")
+	ErrNotEnoughPoints = errors.New("not enough calibration points")
+	ErrPointsOrder     = errors.New("raw values of points must be increasing")
+	ErrNoCoefficients  = errors.New("polynomial needs coefficients")
+)
+
+// Point maps raw reading of sensor to reference temperature
+type Point struct {
+	Raw       float64 `json:"raw"`
+	Reference float64 `json:"reference"`
+}
+
+// Calibration is applied to each reading of sensor. Stamp is time, when calibration was set
+type Calibration struct {
+	Model        Model     `json:"model"`
+	Offset       float64   `json:"offset"`
+	Points       []Point   `json:"points"`
+	Coefficients []float64 `json:"coefficients"`
+	Stamp        time.Time `json:"stamp"`
+}
+
+// Validate checks, whether Calibration can be applied
+func (c Calibration) Validate() error {
+	switch c.Model {
+	case None, Offset:
+		return nil
+	case TwoPoint:
+		if len(c.Points) != 2 {
+			return fmt.Errorf("Validate {Model: %v, Points: %v}: %w", c.Model, len(c.Points), ErrNotEnoughPoints)
+		}
+	case Piecewise:
+		if len(c.Points) < 2 {
+			return fmt.Errorf("Validate {Model: %v, Points: %v}: %w", c.Model, len(c.Points), ErrNotEnoughPoints)
+		}
+	case Polynomial:
+		if len(c.Coefficients) == 0 {
+			return fmt.Errorf("Validate {Model: %v}: %w", c.Model, ErrNoCoefficients)
+		}
+		return nil
+	default:
+		return fmt.Errorf("Validate {Model: %v}: %w", c.Model, ErrUnknownModel)
+	}
+	for i := 1; i < len(c.Points); i++ {
+		if c.Points[i].Raw <= c.Points[i-1].Raw {
+			return fmt.Errorf("Validate {Model: %v, Point: %v}: %w", c.Model, i, ErrPointsOrder)
+		}
+	}
+	return nil
+}
+
+// Apply returns calibrated value of raw reading, Calibration must be valid
+func (c Calibration) Apply(raw float64) float64 {
+	switch c.Model {
+	case Offset:
+		return raw + c.Offset
+	case TwoPoint, Piecewise:
+		return c.interpolate(raw)
+	case Polynomial:
+		v := 0.0
+		for i := len(c.Coefficients) - 1; i >= 0; i-- {
+			v = v*raw + c.Coefficients[i]
+		}
+		return v
+	default:
+		return raw
+	}
+}
+
+// Equal returns true, if both calibrations give same results, Stamp is not compared
+func (c Calibration) Equal(other Calibration) bool {
+	if c.Model != other.Model || c.Offset != other.Offset ||
+		len(c.Points) != len(other.Points) || len(c.Coefficients) != len(other.Coefficients) {
+		return false
+	}
+	for i := range c.Points {
+		if c.Points[i] != other.Points[i] {
+			return false
+		}
+	}
+	for i := range c.Coefficients {
+		if c.Coefficients[i] != other.Coefficients[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// interpolate finds segment for raw and interpolates linearly within it
+func (c Calibration) interpolate(raw float64) float64 {
+	i := 1
+	for i < len(c.Points)-1 && raw > c.Points[i].Raw {
+		i++
+	}
+	p0, p1 := c.Points[i-1], c.Points[i]
+	gain := (p1.Reference - p0.Reference) / (p1.Raw - p0.Raw)
+	return p0.Reference + (raw-p0.Raw)*gain
+}
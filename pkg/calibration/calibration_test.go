@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package calibration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/calibration"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalibrationTestSuite struct {
+	suite.Suite
+}
+
+func TestCalibrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CalibrationTestSuite))
+}
+
+func (t *CalibrationTestSuite) TestApply() {
+	args := []struct {
+		name     string
+		c        calibration.Calibration
+		raw      float64
+		expected float64
+	}{
+		{
+			name:     "none",
+			c:        calibration.Calibration{},
+			raw:      21.5,
+			expected: 21.5,
+		},
+		{
+			name:     "offset",
+			c:        calibration.Calibration{Model: calibration.Offset, Offset: -0.5},
+			raw:      21.5,
+			expected: 21.0,
+		},
+		{
+			name: "two point",
+			c: calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{
+				{Raw: 20.5, Reference: 20}, {Raw: 98.5, Reference: 100},
+			}},
+			raw:      59.5,
+			expected: 60,
+		},
+		{
+			name: "two point, extrapolate",
+			c: calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{
+				{Raw: 20.5, Reference: 20}, {Raw: 98.5, Reference: 100},
+			}},
+			raw:      -18.5,
+			expected: -20,
+		},
+		{
+			name: "piecewise, middle segment",
+			c: calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{
+				{Raw: 0, Reference: 0}, {Raw: 50, Reference: 51}, {Raw: 100, Reference: 99},
+			}},
+			raw:      75,
+			expected: 75,
+		},
+		{
+			name: "piecewise, point",
+			c: calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{
+				{Raw: 0, Reference: 0}, {Raw: 50, Reference: 51}, {Raw: 100, Reference: 99},
+			}},
+			raw:      50,
+			expected: 51,
+		},
+		{
+			name: "piecewise, above last point",
+			c: calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{
+				{Raw: 0, Reference: 0}, {Raw: 50, Reference: 51}, {Raw: 100, Reference: 99},
+			}},
+			raw:      110,
+			expected: 108.6,
+		},
+		{
+			name: "piecewise, below first point",
+			c: calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{
+				{Raw: 0, Reference: 0}, {Raw: 50, Reference: 51}, {Raw: 100, Reference: 99},
+			}},
+			raw:      -10,
+			expected: -10.2,
+		},
+		{
+			name:     "polynomial",
+			c:        calibration.Calibration{Model: calibration.Polynomial, Coefficients: []float64{0.5, 1.01, -0.0001}},
+			raw:      100,
+			expected: 100.5,
+		},
+	}
+	r := t.Require()
+	for _, arg := range args {
+		r.Nil(arg.c.Validate(), arg.name)
+		r.InDelta(arg.expected, arg.c.Apply(arg.raw), 1e-9, arg.name)
+	}
+}
+
+func (t *CalibrationTestSuite) TestValidate() {
+	args := []struct {
+		name string
+		c    calibration.Calibration
+		err  error
+	}{
+		{
+			name: "unknown model",
+			c:    calibration.Calibration{Model: "spline"},
+			err:  calibration.ErrUnknownModel,
+		},
+		{
+			name: "two point with three points",
+			c:    calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{{Raw: 1}, {Raw: 2}, {Raw: 3}}},
+			err:  calibration.ErrNotEnoughPoints,
+		},
+		{
+			name: "piecewise with single point",
+			c:    calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{{Raw: 1}}},
+			err:  calibration.ErrNotEnoughPoints,
+		},
+		{
+			name: "two point with same raw",
+			c:    calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{{Raw: 1}, {Raw: 1}}},
+			err:  calibration.ErrPointsOrder,
+		},
+		{
+			name: "piecewise not sorted",
+			c:    calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{{Raw: 1}, {Raw: 3}, {Raw: 2}}},
+			err:  calibration.ErrPointsOrder,
+		},
+		{
+			name: "polynomial without coefficients",
+			c:    calibration.Calibration{Model: calibration.Polynomial},
+			err:  calibration.ErrNoCoefficients,
+		},
+	}
+	r := t.Require()
+	for _, arg := range args {
+		r.ErrorIs(arg.c.Validate(), arg.err, arg.name)
+	}
+}
+
+func (t *CalibrationTestSuite) TestEqual() {
+	r := t.Require()
+	c := calibration.Calibration{
+		Model:  calibration.Piecewise,
+		Points: []calibration.Point{{Raw: 0, Reference: 1}, {Raw: 50, Reference: 51}},
+		Stamp:  time.Now(),
+	}
+	other := c
+	other.Points = []calibration.Point{{Raw: 0, Reference: 1}, {Raw: 50, Reference: 51}}
+	other.Stamp = time.Time{}
+	r.True(c.Equal(other))
+
+	other.Points[1].Reference = 50
+	r.False(c.Equal(other))
+
+	r.False(c.Equal(calibration.Calibration{Model: calibration.Offset}))
+	r.False(calibration.Calibration{Model: calibration.Polynomial, Coefficients: []float64{1}}.
+		Equal(calibration.Calibration{Model: calibration.Polynomial, Coefficients: []float64{2}}))
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package calibration
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DefaultDegree is degree of polynomial used by Fit, when degree is not provided
+const DefaultDegree = 2
+
+var (
+	ErrDegree   = errors.New("degree of polynomial must be at least 1")
+	ErrSingular = errors.New("points don't determine calibration")
+)
+
+// Fit computes Calibration of model, which maps Raw to Reference of points.
+// TwoPoint and Polynomial are least squares fits, so more points than needed may be used.
+// Degree is used only by Polynomial, 0 means DefaultDegree.
+func Fit(model Model, points []Point, degree int) (Calibration, error) {
+	sorted := append([]Point(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Raw < sorted[j].Raw })
+
+	c := Calibration{Model: model}
+	switch model {
+	case None:
+		return c, nil
+	case Offset:
+		if len(sorted) < 1 {
+			return c, fmt.Errorf("Fit {Model: %v, Points: %v}: %w", model, len(sorted), ErrNotEnoughPoints)
+		}
+		for _, p := range sorted {
+			c.Offset += p.Reference - p.Raw
+		}
+		c.Offset /= float64(len(sorted))
+		return c, nil
+	case TwoPoint:
+		if len(sorted) < 2 {
+			return c, fmt.Errorf("Fit {Model: %v, Points: %v}: %w", model, len(sorted), ErrNotEnoughPoints)
+		}
+		coefficients, err := leastSquares(sorted, 1)
+		if err != nil {
+			return c, fmt.Errorf("Fit {Model: %v}: %w", model, err)
+		}
+		first, last := sorted[0].Raw, sorted[len(sorted)-1].Raw
+		c.Points = []Point{
+			{Raw: first, Reference: coefficients[0] + coefficients[1]*first},
+			{Raw: last, Reference: coefficients[0] + coefficients[1]*last},
+		}
+	case Piecewise:
+		c.Points = sorted
+	case Polynomial:
+		if degree == 0 {
+			degree = DefaultDegree
+		}
+		if degree < 1 {
+			return c, fmt.Errorf("Fit {Model: %v, Degree: %v}: %w", model, degree, ErrDegree)
+		}
+		if len(sorted) < degree+1 {
+			return c, fmt.Errorf("Fit {Model: %v, Points: %v, Degree: %v}: %w", model, len(sorted), degree, ErrNotEnoughPoints)
+		}
+		coefficients, err := leastSquares(sorted, degree)
+		if err != nil {
+			return c, fmt.Errorf("Fit {Model: %v, Degree: %v}: %w", model, degree, err)
+		}
+		c.Coefficients = coefficients
+	default:
+		return c, fmt.Errorf("Fit {Model: %v}: %w", model, ErrUnknownModel)
+	}
+	if err := c.Validate(); err != nil {
+		return c, fmt.Errorf("Fit: %w", err)
+	}
+	return c, nil
+}
+
+// MaxError returns the largest distance between calibrated Raw and Reference of points
+func (c Calibration) MaxError(points []Point) float64 {
+	max := 0.0
+	for _, p := range points {
+		max = math.Max(max, math.Abs(c.Apply(p.Raw)-p.Reference))
+	}
+	return max
+}
+
+// leastSquares returns coefficients of polynomial of degree, lowest power first.
+// Normal equations are solved with Gaussian elimination.
+func leastSquares(points []Point, degree int) ([]float64, error) {
+	n := degree + 1
+	// a is augmented matrix n x (n+1)
+	a := make([][]float64, n)
+	for row := range a {
+		a[row] = make([]float64, n+1)
+		for col := 0; col < n; col++ {
+			for _, p := range points {
+				a[row][col] += math.Pow(p.Raw, float64(row+col))
+			}
+		}
+		for _, p := range points {
+			a[row][n] += p.Reference * math.Pow(p.Raw, float64(row))
+		}
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, ErrSingular
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for row := 0; row < n; row++ {
+			if row == col {
+				continue
+			}
+			f := a[row][col] / a[col][col]
+			for k := col; k <= n; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+		}
+	}
+
+	coefficients := make([]float64, n)
+	for i := range coefficients {
+		coefficients[i] = a[i][n] / a[i][i]
+	}
+	return coefficients, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package calibration_test
+
+import (
+	"github.com/a-clap/embedded/pkg/calibration"
+)
+
+func (t *CalibrationTestSuite) TestFit() {
+	// Probe reads 0.5 too much at 20 and 1.5 too little at 100
+	points := []calibration.Point{{Raw: 98.5, Reference: 100}, {Raw: 20.5, Reference: 20}}
+	args := []struct {
+		name   string
+		model  calibration.Model
+		points []calibration.Point
+		degree int
+		raw    float64
+		value  float64
+	}{
+		{name: "none", model: calibration.None, points: points, raw: 50, value: 50},
+		{name: "offset", model: calibration.Offset, points: points, raw: 50, value: 50.5},
+		{name: "two point", model: calibration.TwoPoint, points: points, raw: 59.5, value: 60},
+		{name: "piecewise", model: calibration.Piecewise, points: points, raw: 59.5, value: 60},
+		{name: "polynomial, linear", model: calibration.Polynomial, points: points, degree: 1, raw: 59.5, value: 60},
+		{
+			name:   "polynomial, quadratic",
+			model:  calibration.Polynomial,
+			points: []calibration.Point{{Raw: 0, Reference: 1}, {Raw: 10, Reference: 111}, {Raw: 20, Reference: 421}, {Raw: 30, Reference: 931}},
+			raw:    5,
+			value:  1 + 5 + 25,
+		},
+	}
+	r := t.Require()
+	for _, arg := range args {
+		c, err := calibration.Fit(arg.model, arg.points, arg.degree)
+		r.Nil(err, arg.name)
+		r.Equal(arg.model, c.Model, arg.name)
+		r.InDelta(arg.value, c.Apply(arg.raw), 1e-6, arg.name)
+	}
+}
+
+func (t *CalibrationTestSuite) TestFit_LeastSquares() {
+	r := t.Require()
+	// Points don't lie on line, so fit has residual error
+	points := []calibration.Point{{Raw: 0, Reference: 0}, {Raw: 50, Reference: 51}, {Raw: 100, Reference: 100}}
+	c, err := calibration.Fit(calibration.TwoPoint, points, 0)
+	r.Nil(err)
+	r.Len(c.Points, 2)
+	r.InDelta(0.0, c.Points[0].Raw, 1e-9)
+	r.InDelta(100.0, c.Points[1].Raw, 1e-9)
+	r.InDelta(2.0/3, c.MaxError(points), 1e-9)
+
+	// Piecewise goes through each point
+	c, err = calibration.Fit(calibration.Piecewise, points, 0)
+	r.Nil(err)
+	r.InDelta(0.0, c.MaxError(points), 1e-9)
+}
+
+func (t *CalibrationTestSuite) TestFit_Errors() {
+	args := []struct {
+		name   string
+		model  calibration.Model
+		points []calibration.Point
+		degree int
+		err    error
+	}{
+		{name: "offset without points", model: calibration.Offset, err: calibration.ErrNotEnoughPoints},
+		{name: "two point with single point", model: calibration.TwoPoint, points: []calibration.Point{{Raw: 1}}, err: calibration.ErrNotEnoughPoints},
+		{name: "two point with same raw", model: calibration.TwoPoint, points: []calibration.Point{{Raw: 1}, {Raw: 1, Reference: 2}}, err: calibration.ErrSingular},
+		{name: "piecewise with same raw", model: calibration.Piecewise, points: []calibration.Point{{Raw: 1}, {Raw: 1, Reference: 2}}, err: calibration.ErrPointsOrder},
+		{name: "polynomial, not enough points", model: calibration.Polynomial, points: []calibration.Point{{Raw: 1}, {Raw: 2}}, err: calibration.ErrNotEnoughPoints},
+		{name: "polynomial, wrong degree", model: calibration.Polynomial, points: []calibration.Point{{Raw: 1}, {Raw: 2}}, degree: -1, err: calibration.ErrDegree},
+		{name: "unknown model", model: "spline", err: calibration.ErrUnknownModel},
+	}
+	r := t.Require()
+	for _, arg := range args {
+		_, err := calibration.Fit(arg.model, arg.points, arg.degree)
+		r.ErrorIs(err, arg.err, arg.name)
+	}
+}
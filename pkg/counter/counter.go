@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package counter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/gpio"
+)
+
+var (
+	ErrPulsesPerLitre = errors.New("pulses per litre must be positive")
+	ErrWindow         = errors.New("window must be positive")
+	ErrUnknownEdge    = errors.New("unknown edge, expected rising, falling or both")
+)
+
+// Edge selects which edges of input are counted
+type Edge string
+
+// Possible edges
+const (
+	EdgeRising  Edge = "rising"
+	EdgeFalling Edge = "falling"
+	EdgeBoth    Edge = "both"
+)
+
+// Config allows user to configure Counter (except ID, which is unique and can't be changed)
+type Config struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// PulsesPerLitre is K-factor of flow meter, e.g. 450 for meter with F = 7.5 * Q (l/min)
+	PulsesPerLitre float64 `json:"pulses_per_litre"`
+	// Window is period, over which Frequency is calculated
+	Window time.Duration `json:"window"`
+}
+
+// Status describes pulses counted since start and since last Reset of totalizer
+type Status struct {
+	ID        string    `json:"id"`
+	Started   time.Time `json:"started"`
+	Count     uint64    `json:"count"`
+	LastPulse time.Time `json:"last_pulse"`
+	// Frequency is number of pulses in last Window per second, Flow is based on it
+	Frequency float64 `json:"frequency"`
+	// Flow is in litres per minute
+	Flow float64 `json:"flow"`
+	// Reset is time of last Reset, Total is in litres
+	Reset           time.Time `json:"reset"`
+	CountSinceReset uint64    `json:"count_since_reset"`
+	Total           float64   `json:"total"`
+}
+
+// Counter counts pulses, e.g. from hall-effect flow meter
+type Counter struct {
+	mtx     sync.Mutex
+	cfg     Config
+	started time.Time
+	count   uint64
+	last    time.Time
+	// stamps are pulses within Window, oldest first
+	stamps     []time.Time
+	reset      time.Time
+	countReset uint64
+	total      float64
+	// pin and edge are set by WithGpio, in is requested by New
+	pin  *gpio.Pin
+	edge Edge
+	in   *gpio.In
+}
+
+// New creates Counter, pulses are provided via Pulse or gpio (see WithGpio)
+func New(options ...Option) (*Counter, error) {
+	now := time.Now()
+	c := &Counter{
+		cfg: Config{
+			PulsesPerLitre: 450,
+			Window:         time.Second,
+		},
+		started: now,
+		reset:   now,
+		edge:    EdgeRising,
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	if err := c.cfg.validate(); err != nil {
+		return nil, fmt.Errorf("New {ID: %v}: %w", c.cfg.ID, err)
+	}
+	if c.pin != nil {
+		if err := c.open(); err != nil {
+			return nil, fmt.Errorf("New.open {ID: %v}: %w", c.cfg.ID, err)
+		}
+	}
+	return c, nil
+}
+
+// ID returns unique ID of Counter
+func (c *Counter) ID() string {
+	return c.cfg.ID
+}
+
+// Pulse counts single pulse, which happened at stamp
+func (c *Counter) Pulse(stamp time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.count++
+	c.countReset++
+	c.total += 1 / c.cfg.PulsesPerLitre
+	c.last = stamp
+	c.stamps = append(c.stamps, stamp)
+	c.prune(stamp)
+}
+
+// Status returns current state of Counter
+func (c *Counter) Status() Status {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.prune(time.Now())
+	frequency := float64(len(c.stamps)) / c.cfg.Window.Seconds()
+	return Status{
+		ID:              c.cfg.ID,
+		Started:         c.started,
+		Count:           c.count,
+		LastPulse:       c.last,
+		Frequency:       frequency,
+		Flow:            frequency / c.cfg.PulsesPerLitre * 60,
+		Reset:           c.reset,
+		CountSinceReset: c.countReset,
+		Total:           c.total,
+	}
+}
+
+// Reset starts new totalizer period, Count since start is kept
+func (c *Counter) Reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.reset = time.Now()
+	c.countReset = 0
+	c.total = 0
+}
+
+// Configure allows user to configure Counter with Config, totalizer keeps volume counted with previous PulsesPerLitre
+func (c *Counter) Configure(config Config) error {
+	if err := config.validate(); err != nil {
+		return fmt.Errorf("Configure {ID: %v}: %w", c.ID(), err)
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.cfg.Name = config.Name
+	c.cfg.PulsesPerLitre = config.PulsesPerLitre
+	c.cfg.Window = config.Window
+	return nil
+}
+
+// GetConfig returns current config
+func (c *Counter) GetConfig() Config {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.cfg
+}
+
+// Close releases gpio, if Counter was created on it
+func (c *Counter) Close() error {
+	if c.in != nil {
+		return c.in.Close()
+	}
+	return nil
+}
+
+// prune removes pulses older than Window, c.mtx must be locked
+func (c *Counter) prune(now time.Time) {
+	from := now.Add(-c.cfg.Window)
+	i := 0
+	for i < len(c.stamps) && !c.stamps[i].After(from) {
+		i++
+	}
+	c.stamps = c.stamps[i:]
+}
+
+func (c Config) validate() error {
+	if c.PulsesPerLitre <= 0 {
+		return fmt.Errorf("{PulsesPerLitre: %v}: %w", c.PulsesPerLitre, ErrPulsesPerLitre)
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("{Window: %v}: %w", c.Window, ErrWindow)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package counter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
+	"github.com/stretchr/testify/suite"
+)
+
+type CounterSuite struct {
+	suite.Suite
+}
+
+func TestCounterSuite(t *testing.T) {
+	suite.Run(t, new(CounterSuite))
+}
+
+func (t *CounterSuite) TestNew() {
+	r := t.Require()
+	c, err := counter.New()
+	r.Nil(err)
+	r.Equal(counter.Config{PulsesPerLitre: 450, Window: time.Second}, c.GetConfig())
+
+	c, err = counter.New(
+		counter.WithID("condenser"),
+		counter.WithName("coolant"),
+		counter.WithPulsesPerLitre(98),
+		counter.WithWindow(5*time.Second),
+	)
+	r.Nil(err)
+	r.Equal("condenser", c.ID())
+	r.Equal(counter.Config{ID: "condenser", Name: "coolant", PulsesPerLitre: 98, Window: 5 * time.Second}, c.GetConfig())
+	r.Nil(c.Close())
+
+	status := c.Status()
+	r.Equal("condenser", status.ID)
+	r.Zero(status.Count)
+	r.Zero(status.Frequency)
+	r.Zero(status.Flow)
+	r.Zero(status.Total)
+	r.Equal(status.Started, status.Reset)
+	r.True(status.LastPulse.IsZero())
+}
+
+func (t *CounterSuite) TestNew_Errors() {
+	r := t.Require()
+	c, err := counter.New(counter.WithPulsesPerLitre(0))
+	r.Nil(c)
+	r.ErrorIs(err, counter.ErrPulsesPerLitre)
+
+	c, err = counter.New(counter.WithWindow(-time.Second))
+	r.Nil(c)
+	r.ErrorIs(err, counter.ErrWindow)
+}
+
+func (t *CounterSuite) TestFlow() {
+	r := t.Require()
+	c, err := counter.New(counter.WithPulsesPerLitre(450), counter.WithWindow(time.Second))
+	r.Nil(err)
+
+	// 75 pulses in last second: 75 Hz is 10 l/min
+	start := time.Now().Add(-750 * time.Millisecond)
+	for i := 0; i < 75; i++ {
+		c.Pulse(start.Add(time.Duration(i) * 10 * time.Millisecond))
+	}
+	status := c.Status()
+	r.Equal(uint64(75), status.Count)
+	r.Equal(uint64(75), status.CountSinceReset)
+	r.InDelta(75.0, status.Frequency, 1e-9)
+	r.InDelta(10.0, status.Flow, 1e-9)
+	r.InDelta(75.0/450, status.Total, 1e-9)
+}
+
+func (t *CounterSuite) TestFlow_Window() {
+	r := t.Require()
+	c, err := counter.New(counter.WithPulsesPerLitre(100), counter.WithWindow(50*time.Millisecond))
+	r.Nil(err)
+
+	for i := 0; i < 5; i++ {
+		c.Pulse(time.Now())
+	}
+	status := c.Status()
+	r.InDelta(100.0, status.Frequency, 1e-9)
+	r.InDelta(60.0, status.Flow, 1e-9)
+	r.False(status.LastPulse.IsZero())
+
+	// Flow stops, but count and total are kept
+	<-time.After(60 * time.Millisecond)
+	status = c.Status()
+	r.Zero(status.Frequency)
+	r.Zero(status.Flow)
+	r.Equal(uint64(5), status.Count)
+	r.InDelta(0.05, status.Total, 1e-9)
+}
+
+func (t *CounterSuite) TestReset() {
+	r := t.Require()
+	c, err := counter.New(counter.WithPulsesPerLitre(10))
+	r.Nil(err)
+	for i := 0; i < 25; i++ {
+		c.Pulse(time.Now())
+	}
+	before := c.Status()
+	r.InDelta(2.5, before.Total, 1e-9)
+
+	c.Reset()
+	status := c.Status()
+	r.Equal(uint64(25), status.Count)
+	r.Zero(status.CountSinceReset)
+	r.Zero(status.Total)
+	r.Equal(before.Started, status.Started)
+	r.True(status.Reset.After(before.Reset))
+
+	c.Pulse(time.Now())
+	status = c.Status()
+	r.Equal(uint64(26), status.Count)
+	r.Equal(uint64(1), status.CountSinceReset)
+	r.InDelta(0.1, status.Total, 1e-9)
+}
+
+func (t *CounterSuite) TestConfigure() {
+	r := t.Require()
+	c, err := counter.New(counter.WithID("id"), counter.WithPulsesPerLitre(10))
+	r.Nil(err)
+	for i := 0; i < 10; i++ {
+		c.Pulse(time.Now())
+	}
+
+	cfg := counter.Config{ID: "other", Name: "coolant", PulsesPerLitre: 20, Window: 2 * time.Second}
+	r.Nil(c.Configure(cfg))
+	cfg.ID = "id"
+	r.Equal(cfg, c.GetConfig())
+
+	// Volume counted before is kept
+	for i := 0; i < 10; i++ {
+		c.Pulse(time.Now())
+	}
+	status := c.Status()
+	r.InDelta(1.5, status.Total, 1e-9)
+	r.InDelta(10.0, status.Frequency, 1e-9)
+	r.InDelta(30.0, status.Flow, 1e-9)
+
+	wrong := cfg
+	wrong.PulsesPerLitre = -1
+	r.ErrorIs(c.Configure(wrong), counter.ErrPulsesPerLitre)
+	wrong = cfg
+	wrong.Window = 0
+	r.ErrorIs(c.Configure(wrong), counter.ErrWindow)
+	r.Equal(cfg, c.GetConfig())
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
+	"github.com/a-clap/embedded/pkg/gpio"
+)
+
+func main() {
+	// YF-S201 flow meter: F = 7.5 * Q (l/min), so 450 pulses per litre
+	c, err := counter.New(
+		counter.WithName("water inlet"),
+		counter.WithPulsesPerLitre(450),
+		counter.WithGpio(gpio.Pin{Chip: "gpiochip0", Line: 12}, counter.EdgeRising),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer c.Close()
+
+	for i := 0; i < 10; i++ {
+		<-time.After(1 * time.Second)
+		s := c.Status()
+		fmt.Printf("count: %v, frequency: %v Hz, flow: %v l/min, total: %v l\n", s.Count, s.Frequency, s.Flow, s.Total)
+	}
+
+	// Start new totalizer period
+	c.Reset()
+
+	fmt.Println("All good!")
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package counter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/gpio"
+	"github.com/warthog618/gpiod"
+)
+
+var edges = map[Edge]gpiod.LineEdge{
+	EdgeRising:  gpiod.WithRisingEdge,
+	EdgeFalling: gpiod.WithFallingEdge,
+	EdgeBoth:    gpiod.WithBothEdges,
+}
+
+// open requests gpio with edge events, each event is a Pulse
+func (c *Counter) open() error {
+	edge, ok := edges[c.edge]
+	if !ok {
+		return fmt.Errorf("{Edge: %v}: %w", c.edge, ErrUnknownEdge)
+	}
+	var err error
+	c.in, err = gpio.Input(*c.pin, c.cfg.ID, edge, gpiod.WithEventHandler(c.eventHandler))
+	return err
+}
+
+func (c *Counter) eventHandler(gpiod.LineEvent) {
+	c.Pulse(time.Now())
+}
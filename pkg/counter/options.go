@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package counter
+
+import (
+	"time"
+
+	"github.com/a-clap/embedded/pkg/gpio"
+)
+
+type Option func(c *Counter)
+
+// WithID sets unique ID for Counter - cannot be changed
+func WithID(id string) Option {
+	return func(c *Counter) {
+		c.cfg.ID = id
+	}
+}
+
+// WithName sets Counter name - it can be changed
+func WithName(name string) Option {
+	return func(c *Counter) {
+		c.cfg.Name = name
+	}
+}
+
+// WithPulsesPerLitre sets K-factor of flow meter, 450 by default
+func WithPulsesPerLitre(pulses float64) Option {
+	return func(c *Counter) {
+		c.cfg.PulsesPerLitre = pulses
+	}
+}
+
+// WithWindow sets period, over which Frequency is calculated, 1 s by default
+func WithWindow(window time.Duration) Option {
+	return func(c *Counter) {
+		c.cfg.Window = window
+	}
+}
+
+// WithGpio counts edges of input on pin, EdgeRising if edge is empty
+func WithGpio(pin gpio.Pin, edge Edge) Option {
+	return func(c *Counter) {
+		c.pin = &pin
+		if edge != "" {
+			c.edge = edge
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Range of TH and TL registers
+const (
+	AlarmMin = -55
+	AlarmMax = 125
+)
+
+var (
+	ErrAlarmsNotSupported = errors.New("alarms not enabled")
+	ErrAlarmOutOfRange    = errors.New("alarm out of range")
+)
+
+// InAlarm returns true, if last conversion was lower or equal to AlarmLow, or higher or equal to AlarmHigh.
+// Like DS18B20 does, only integer part of temperature is compared.
+func (s *Sensor) InAlarm() bool {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.alarm
+}
+
+// AlarmsEnabled returns true, if Sensor was created with WithAlarms and its family has TH and TL registers
+func (s *Sensor) AlarmsEnabled() bool {
+	return s.alarms
+}
+
+// checkAlarm updates alarm state based on raw temperature, s.cfgMtx must be locked
+func (s *Sensor) checkAlarm(t64 float64) {
+	t := int(math.Floor(t64))
+	s.alarm = s.alarms && (t <= s.cfg.AlarmLow || t >= s.cfg.AlarmHigh)
+}
+
+// readAlarms parses alarms attribute, which is in format "TL TH"
+func (s *Sensor) readAlarms() (low, high int, err error) {
+	buf, err := s.readFile(s.alarmsPath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("readAlarms {ID: %v}: %w", s.fullID, err)
+	}
+	values := strings.Fields(buf)
+	if len(values) != 2 {
+		return 0, 0, fmt.Errorf("readAlarms {ID: %v, value: %v}: %w", s.fullID, buf, ErrUnexpectedFormat)
+	}
+	if low, err = strconv.Atoi(values[0]); err != nil {
+		return 0, 0, fmt.Errorf("readAlarms {ID: %v, value: %v}: %w", s.fullID, buf, err)
+	}
+	if high, err = strconv.Atoi(values[1]); err != nil {
+		return 0, 0, fmt.Errorf("readAlarms {ID: %v, value: %v}: %w", s.fullID, buf, err)
+	}
+	return low, high, nil
+}
+
+// setAlarms writes TL and TH, driver stores them in EEPROM
+func (s *Sensor) setAlarms(low, high int) error {
+	if !s.alarms {
+		return ErrAlarmsNotSupported
+	}
+	if low < AlarmMin || high > AlarmMax || low > high {
+		return fmt.Errorf("setAlarms {Low: %v, High: %v}: %w", low, high, ErrAlarmOutOfRange)
+	}
+	buf := strconv.Itoa(low) + " " + strconv.Itoa(high)
+	if err := s.WriteFile(s.alarmsPath, []byte(buf)); err != nil {
+		return fmt.Errorf("setAlarms {Path: %v}: %w", s.alarmsPath, err)
+	}
+	return nil
+}
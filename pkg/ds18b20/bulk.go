@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/a-clap/logging"
+)
+
+const (
+	// bulkTimeout is maximum time of conversion, 12 bit resolution needs 750ms
+	bulkTimeout = time.Second
+	// bulkCheck is period of checking, whether conversion is done
+	bulkCheck = 10 * time.Millisecond
+	// bulkReadingsMax is number of kept batches, the oldest ones are dropped
+	bulkReadingsMax = 100
+)
+
+var (
+	ErrBulkTimeout = errors.New("timeout waiting for bulk conversion")
+	errBulkStopped = errors.New("bulk read stopped")
+)
+
+// BulkReadings are readings of all polled sensors on Bus, which share single conversion
+type BulkReadings struct {
+	Stamp    time.Time  `json:"stamp"`
+	Readings []Readings `json:"readings"`
+}
+
+// BulkRead returns true, if sensors on Bus are polled with single conversion (see WithBulkRead)
+func (b *Bus) BulkRead() bool {
+	return b.bulk > 0
+}
+
+// GetReadings returns all batches collected by bulk read and then clears them
+func (b *Bus) GetReadings() []BulkReadings {
+	b.bulkMtx.Lock()
+	defer b.bulkMtx.Unlock()
+	if len(b.batches) == 0 {
+		return nil
+	}
+	c := make([]BulkReadings, len(b.batches))
+	copy(c, b.batches)
+	b.batches = nil
+	return c
+}
+
+// join adds Sensor to bulk read, first one starts polling
+func (b *Bus) join(s *Sensor) {
+	b.bulkMtx.Lock()
+	defer b.bulkMtx.Unlock()
+	if b.members == nil {
+		b.members = make(map[string]*Sensor)
+	}
+	b.members[s.id] = s
+	if b.stop == nil {
+		b.stop, b.fin = make(chan struct{}), make(chan struct{})
+		go b.poll(b.stop, b.fin)
+	}
+}
+
+// leave removes Sensor from bulk read, last one stops polling
+func (b *Bus) leave(s *Sensor) {
+	b.bulkMtx.Lock()
+	delete(b.members, s.id)
+	if len(b.members) > 0 || b.stop == nil {
+		b.bulkMtx.Unlock()
+		return
+	}
+	stop, fin := b.stop, b.fin
+	b.stop, b.fin = nil, nil
+	b.bulkMtx.Unlock()
+
+	close(stop)
+	<-fin
+}
+
+func (b *Bus) poll(stop, fin chan struct{}) {
+	defer close(fin)
+	ticker := time.NewTicker(b.bulk)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			b.cycle(stop)
+		}
+	}
+}
+
+// cycle triggers conversion on all sensors and then reads them
+func (b *Bus) cycle(stop chan struct{}) {
+	stamp, err := b.convert(stop)
+	if errors.Is(err, errBulkStopped) {
+		return
+	}
+	if err != nil {
+		logger.Error("error on ds18b20 bulk read", logging.String("path", b.o.Path()), logging.String("error", err.Error()))
+	}
+
+	b.bulkMtx.Lock()
+	members := make([]*Sensor, 0, len(b.members))
+	for _, s := range b.members {
+		members = append(members, s)
+	}
+	b.bulkMtx.Unlock()
+	sort.Slice(members, func(i, j int) bool { return members[i].id < members[j].id })
+
+	batch := BulkReadings{Stamp: stamp, Readings: make([]Readings, 0, len(members))}
+	for _, s := range members {
+		r := Readings{ID: s.id, Stamp: stamp}
+		if err != nil {
+			r.Error = err.Error()
+		} else if actual, filtered, average, err := s.measure(); err != nil {
+			r.Error = err.Error()
+		} else {
+			r.Temperature, r.Filtered, r.Average, r.Alarm = actual, filtered, average, s.InAlarm()
+		}
+		batch.Readings = append(batch.Readings, r)
+	}
+
+	b.bulkMtx.Lock()
+	defer b.bulkMtx.Unlock()
+	b.batches = append(b.batches, batch)
+	if len(b.batches) > bulkReadingsMax {
+		b.batches = b.batches[1:]
+	}
+}
+
+// convert writes trigger to therm_bulk_read and waits until conversion is done.
+// Returned stamp is moment of finished conversion.
+func (b *Bus) convert(stop chan struct{}) (time.Time, error) {
+	bulkPath := path.Join(b.o.Path(), "therm_bulk_read")
+	if err := b.o.WriteFile(bulkPath, []byte("trigger")); err != nil {
+		return time.Now(), fmt.Errorf("convert.WriteFile {Path: %v}: %w", bulkPath, err)
+	}
+
+	deadline := time.After(bulkTimeout)
+	for {
+		buf, err := b.o.ReadFile(bulkPath)
+		if err != nil {
+			return time.Now(), fmt.Errorf("convert.ReadFile {Path: %v}: %w", bulkPath, err)
+		}
+		// -1 means conversion in progress, 1 - conversion done, 0 - no conversion pending
+		if strings.TrimSpace(string(buf)) != "-1" {
+			return time.Now(), nil
+		}
+		select {
+		case <-stop:
+			return time.Now(), errBulkStopped
+		case <-deadline:
+			return time.Now(), fmt.Errorf("convert {Path: %v}: %w", bulkPath, ErrBulkTimeout)
+		case <-time.After(bulkCheck):
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20_test
+
+import (
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BulkSuite struct {
+	suite.Suite
+	bus *ds18b20.Bus
+}
+
+const (
+	bulkPath     = "/sys/bus/w1/devices/w1_bus_master1"
+	bulkInterval = 5 * time.Millisecond
+)
+
+func TestBulkSuite(t *testing.T) {
+	suite.Run(t, new(BulkSuite))
+}
+
+func (t *BulkSuite) newBus(onewire *OnewireMock, ids ...string) []*ds18b20.Sensor {
+	onewire.On("Path").Return(bulkPath)
+	for _, id := range ids {
+		onewire.On("ReadFile", path.Join(bulkPath, id, "resolution")).Return([]byte("12"), nil)
+		onewire.On("ReadFile", path.Join(bulkPath, id, "ext_power")).Return([]byte("1"), nil)
+	}
+
+	r := t.Require()
+	bus, err := ds18b20.NewBus(ds18b20.WithInterface(onewire), ds18b20.WithBulkRead(bulkInterval))
+	r.Nil(err)
+	r.True(bus.BulkRead())
+
+	sensors := make([]*ds18b20.Sensor, 0, len(ids))
+	for _, id := range ids {
+		s, err := bus.NewSensor(id)
+		r.Nil(err)
+		sensors = append(sensors, s)
+	}
+	t.bus = bus
+	return sensors
+}
+
+func (t *BulkSuite) TestBulkRead() {
+	r := t.Require()
+	onewire := new(OnewireMock)
+	bulkFile := path.Join(bulkPath, "therm_bulk_read")
+	onewire.On("WriteFile", bulkFile, []byte("trigger")).Return(nil)
+	// Conversion in progress on first check
+	onewire.On("ReadFile", bulkFile).Return([]byte("-1\n"), nil).Once()
+	onewire.On("ReadFile", bulkFile).Return([]byte("1\n"), nil)
+	onewire.On("ReadFile", path.Join(bulkPath, "28-2", "temperature")).Return([]byte("23125"), nil)
+	onewire.On("ReadFile", path.Join(bulkPath, "28-1", "temperature")).Return([]byte("-1500"), nil)
+
+	sensors := t.newBus(onewire, "28-2", "28-1")
+	for _, s := range sensors {
+		s.Poll()
+	}
+
+	var batches []ds18b20.BulkReadings
+	r.Eventually(func() bool {
+		batches = append(batches, t.bus.GetReadings()...)
+		return len(batches) >= 2
+	}, time.Second, time.Millisecond)
+
+	for _, s := range sensors {
+		s.Close()
+		// Readings are collected by Bus
+		r.Nil(s.GetReadings())
+	}
+
+	for _, batch := range batches {
+		r.Len(batch.Readings, 2)
+		// Sorted by ID, each reading shares stamp of conversion
+		r.Equal("28-1", batch.Readings[0].ID)
+		r.InDelta(-1.5, batch.Readings[0].Temperature, 1e-9)
+		r.Equal("28-2", batch.Readings[1].ID)
+		r.InDelta(23.125, batch.Readings[1].Temperature, 1e-9)
+		for _, reading := range batch.Readings {
+			r.Empty(reading.Error)
+			r.Equal(batch.Stamp, reading.Stamp)
+		}
+	}
+	r.True(batches[1].Stamp.After(batches[0].Stamp))
+
+	// Last closed sensor stops polling
+	t.bus.GetReadings()
+	<-time.After(3 * bulkInterval)
+	r.Nil(t.bus.GetReadings())
+}
+
+func (t *BulkSuite) TestBulkRead_TriggerError() {
+	r := t.Require()
+	onewire := new(OnewireMock)
+	onewire.On("WriteFile", path.Join(bulkPath, "therm_bulk_read"), mock.Anything).Return(io.ErrClosedPipe)
+
+	sensors := t.newBus(onewire, "28-1")
+	sensors[0].Poll()
+	defer sensors[0].Close()
+
+	var batches []ds18b20.BulkReadings
+	r.Eventually(func() bool {
+		batches = append(batches, t.bus.GetReadings()...)
+		return len(batches) > 0
+	}, time.Second, time.Millisecond)
+	r.Len(batches[0].Readings, 1)
+	r.Equal("28-1", batches[0].Readings[0].ID)
+	r.Contains(batches[0].Readings[0].Error, io.ErrClosedPipe.Error())
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -29,8 +31,16 @@ type Onewire interface {
 }
 
 type Bus struct {
-	ids []string
-	o   Onewire
+	mtx           sync.Mutex
+	ids           []string
+	o             Onewire
+	sensorOptions []SensorOption
+	// bulk is period of bulk read, 0 if disabled. bulkMtx protects members, stop, fin and batches
+	bulk      time.Duration
+	bulkMtx   sync.Mutex
+	members   map[string]*Sensor
+	stop, fin chan struct{}
+	batches   []BulkReadings
 }
 
 // NewBus creates Bus with BusOption
@@ -49,22 +59,37 @@ func NewBus(options ...BusOption) (*Bus, error) {
 	return b, nil
 }
 
-// IDs return slice of DS18B20 ID found on provided Path
+// IDs return slice of thermometers ID found on provided Path, other devices are listed by Devices
 func (b *Bus) IDs() ([]string, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
 	err := b.updateIDs()
 	if err != nil {
 		return nil, fmt.Errorf("IDs: %w", err)
 	}
-	return b.ids, nil
+	// Caller gets own copy, next call overwrites b.ids
+	var ids []string
+	for _, id := range b.ids {
+		if FamilyOf(id).Thermometer() {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
 }
 
 // NewSensor creates DS18B20 Sensor based on ID
 func (b *Bus) NewSensor(id string) (*Sensor, error) {
+	if f := FamilyOf(id); f.Supported() && !f.Thermometer() {
+		return nil, fmt.Errorf("NewSensor {ID: %v, Family: %v}: %w", id, f.Name(), ErrUnsupportedFamily)
+	}
 	// delegate creation of Sensor to NewSensor
-	s, err := NewSensor(b.o, id, b.o.Path())
+	s, err := NewSensor(b.o, id, b.o.Path(), b.sensorOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("NewSensor: %w", err)
 	}
+	if b.BulkRead() {
+		s.bulk = b
+	}
 	return s, nil
 }
 
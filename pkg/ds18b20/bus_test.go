@@ -103,6 +103,7 @@ func (t *BusSuite) TestBus_NewSensorGood() {
 	onewire := new(OnewireMock)
 	onewire.On("Path").Return(w1path)
 	onewire.On("ReadFile", path.Join(w1path, id, "resolution")).Return(resolutionBuf, nil).Once()
+	onewire.On("ReadFile", path.Join(w1path, id, "ext_power")).Return([]byte("1"), nil)
 
 	bus, _ := ds18b20.NewBus(
 		ds18b20.WithInterface(onewire),
@@ -165,15 +166,16 @@ func (t *BusSuite) TestBus_DiscoverSingleError() {
 	onewire := new(OnewireMock)
 	w1Path := "all good"
 
-	ids := []string{"1", "2", "3"}
+	ids := []string{"28-1", "28-2", "28-3"}
 	errs := []error{nil, io.ErrNoProgress, nil}
 	res := []byte("9")
 	for i, id := range ids {
 		onewire.On("ReadFile", path.Join(w1Path, id, "resolution")).Return(res, errs[i])
+		onewire.On("ReadFile", path.Join(w1Path, id, "ext_power")).Return([]byte("1"), nil)
 	}
 
 	onewire.On("Path").Return(w1Path)
-	onewire.On("ReadFile", path.Join(w1Path, "w1_master_slaves")).Return([]byte("1\n2\n3"), nil)
+	onewire.On("ReadFile", path.Join(w1Path, "w1_master_slaves")).Return([]byte("28-1\n28-2\n28-3"), nil)
 	r := t.Require()
 	bus, err := ds18b20.NewBus(ds18b20.WithInterface(onewire))
 	r.Nil(err)
@@ -193,11 +195,12 @@ func (t *BusSuite) TestBus_DiscoverFine() {
 	onewire := new(OnewireMock)
 	w1Path := "all good"
 
-	s_ids := []string{"1", "2", "3"}
-	ids := []byte("1\n2\n3")
+	s_ids := []string{"28-1", "28-2", "28-3"}
+	ids := []byte("28-1\n28-2\n28-3")
 	res := []byte("9")
 	for _, id := range s_ids {
 		onewire.On("ReadFile", path.Join(w1Path, id, "resolution")).Return(res, nil)
+		onewire.On("ReadFile", path.Join(w1Path, id, "ext_power")).Return([]byte("1"), nil)
 	}
 
 	onewire.On("Path").Return(w1Path)
@@ -238,6 +241,62 @@ func (t *BusSuite) TestBus_DiscoverError() {
 	r.ErrorContains(errs[0], "Discover")
 }
 
+func (t *BusSuite) TestBus_SensorOptions() {
+	onewire := new(OnewireMock)
+	w1Path := "/sys/bus/w1/devices/w1_bus_master1"
+	onewire.On("Path").Return(w1Path)
+	onewire.On("ReadFile", path.Join(w1Path, "28-05169463beff", "resolution")).Return([]byte("12"), nil)
+	onewire.On("ReadFile", path.Join(w1Path, "28-05169463beff", "ext_power")).Return([]byte("1"), nil)
+	onewire.On("ReadFile", path.Join(w1Path, "28-05169463beff", "w1_slave")).
+		Return([]byte("72 01 4b 46 7f ff 0e 10 57 : crc=57 YES\n72 01 4b 46 7f ff 0e 10 57 t=23125\n"), nil)
+
+	r := t.Require()
+	bus, err := ds18b20.NewBus(ds18b20.WithInterface(onewire), ds18b20.WithSensorOptions(ds18b20.WithReadMode(ds18b20.ReadW1Slave)))
+	r.Nil(err)
+	s, err := bus.NewSensor("28-05169463beff")
+	r.Nil(err)
+	v, _, err := s.Temperature()
+	r.Nil(err)
+	r.InDelta(23.125, v, 0.0001)
+}
+
+func (t *BusSuite) TestBus_Devices() {
+	onewire := new(OnewireMock)
+	w1Path := "/sys/bus/w1/devices/w1_bus_master1"
+	onewire.On("Path").Return(w1Path)
+	onewire.On("ReadFile", path.Join(w1Path, "w1_master_slaves")).
+		Return([]byte("28-05169463beff\n10-000801b5a7c8\n3A-0000000a1b2c\n3b-0000001921e8\n22-000003c9b5d6\n01-000012345678\n"), nil)
+
+	r := t.Require()
+	bus, err := ds18b20.NewBus(ds18b20.WithInterface(onewire))
+	r.Nil(err)
+
+	devices, err := bus.Devices()
+	r.Nil(err)
+	r.Equal([]ds18b20.Device{
+		{ID: "28-05169463beff", Family: ds18b20.FamilyDS18B20, Name: "DS18B20", Supported: true},
+		{ID: "10-000801b5a7c8", Family: ds18b20.FamilyDS18S20, Name: "DS18S20", Supported: true},
+		{ID: "3A-0000000a1b2c", Family: ds18b20.FamilyDS2413, Name: "DS2413", Supported: true},
+		{ID: "3b-0000001921e8", Family: ds18b20.FamilyMAX31850, Name: "MAX31850", Supported: true},
+		{ID: "22-000003c9b5d6", Family: ds18b20.FamilyDS1822, Name: "DS1822", Supported: true},
+		{ID: "01-000012345678", Family: "01", Name: "", Supported: false},
+	}, devices)
+
+	// Only thermometers are listed by IDs
+	ids, err := bus.IDs()
+	r.Nil(err)
+	r.Equal([]string{"28-05169463beff", "10-000801b5a7c8", "3b-0000001921e8", "22-000003c9b5d6"}, ids)
+
+	_, err = bus.NewSensor("3A-0000000a1b2c")
+	r.ErrorIs(err, ds18b20.ErrUnsupportedFamily)
+
+	switches, errs := bus.Switches()
+	r.Nil(errs)
+	r.Len(switches, 1)
+	r.Equal("3A-0000000a1b2c", switches[0].ID())
+	r.Equal("w1_bus_master1:3A-0000000a1b2c", switches[0].FullID())
+}
+
 func (t *BusSuite) TestBus_NoInterface() {
 	r := t.Require()
 	bus, err := ds18b20.NewBus()
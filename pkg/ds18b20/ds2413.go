@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+)
+
+// Channel is one of two PIO of DS2413
+type Channel int
+
+// Possible channels
+const (
+	ChannelA Channel = iota
+	ChannelB
+)
+
+const (
+	// ds2413Output are bits, which must be set on each write to output
+	ds2413Output = 0xFC
+)
+
+var (
+	ErrUnknownChannel     = errors.New("unknown channel")
+	ErrUnexpectedSwitchIO = errors.New("unexpected length of ds2413 state")
+)
+
+// Switch represents DS2413 dual channel addressable switch, handled by w1_ds2413 driver.
+// Each PIO is open drain: latch set to false turns on transistor and pulls pin low,
+// latch set to true releases pin, so it can be used as input.
+type Switch struct {
+	FileReaderWriter
+	id, fullID            string
+	statePath, outputPath string
+	// mtx serializes read-modify-write of output
+	mtx sync.Mutex
+}
+
+func (c Channel) String() string {
+	switch c {
+	case ChannelA:
+		return "A"
+	case ChannelB:
+		return "B"
+	default:
+		return fmt.Sprintf("Channel(%d)", int(c))
+	}
+}
+
+// NewSwitch creates Switch, id must belong to FamilyDS2413
+func NewSwitch(o FileReaderWriter, id, basePath string) (*Switch, error) {
+	bus := basePath[strings.LastIndex(basePath, "/")+1:]
+	if f := FamilyOf(id); f != FamilyDS2413 {
+		return nil, fmt.Errorf("NewSwitch {ID: %v, Family: %v}: %w", id, f, ErrUnsupportedFamily)
+	}
+	return &Switch{
+		FileReaderWriter: o,
+		id:               id,
+		fullID:           bus + ":" + id,
+		statePath:        path.Join(basePath, id, "state"),
+		outputPath:       path.Join(basePath, id, "output"),
+	}, nil
+}
+
+// NewSwitch creates DS2413 Switch based on ID
+func (b *Bus) NewSwitch(id string) (*Switch, error) {
+	s, err := NewSwitch(b.o, id, b.o.Path())
+	if err != nil {
+		return nil, fmt.Errorf("NewSwitch: %w", err)
+	}
+	return s, nil
+}
+
+// Switches creates slice of DS2413 found on Path
+func (b *Bus) Switches() (s []*Switch, errs []error) {
+	devices, err := b.Devices()
+	if err != nil {
+		return nil, []error{fmt.Errorf("Switches: %w", err)}
+	}
+	for _, dev := range devices {
+		if dev.Family != FamilyDS2413 {
+			continue
+		}
+		sw, err := b.NewSwitch(dev.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Switches.NewSwitch: %w", err))
+			continue
+		}
+		s = append(s, sw)
+	}
+	return s, errs
+}
+
+// ID returns Switch hardware id
+func (s *Switch) ID() string {
+	return s.id
+}
+
+// FullID returns Switch hardware id in convention: w1Path:id
+func (s *Switch) FullID() string {
+	return s.fullID
+}
+
+// State returns level of pin and state of output latch
+func (s *Switch) State(ch Channel) (pin, latch bool, err error) {
+	if ch != ChannelA && ch != ChannelB {
+		return false, false, fmt.Errorf("State {ID: %v, Channel: %v}: %w", s.fullID, ch, ErrUnknownChannel)
+	}
+	state, err := s.state()
+	if err != nil {
+		return false, false, fmt.Errorf("State: %w", err)
+	}
+	// bit0 - PIOA pin, bit1 - PIOA latch, bit2 - PIOB pin, bit3 - PIOB latch
+	shift := 2 * uint(ch)
+	return state&(1<<shift) != 0, state&(2<<shift) != 0, nil
+}
+
+// Set changes output latch of channel, latch of other channel is kept
+func (s *Switch) Set(ch Channel, latch bool) error {
+	if ch != ChannelA && ch != ChannelB {
+		return fmt.Errorf("Set {ID: %v, Channel: %v}: %w", s.fullID, ch, ErrUnknownChannel)
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	state, err := s.state()
+	if err != nil {
+		return fmt.Errorf("Set: %w", err)
+	}
+	// Output takes latches on bit0 (PIOA) and bit1 (PIOB)
+	out := (state>>1)&1 | (state>>2)&2
+	if latch {
+		out |= 1 << uint(ch)
+	} else {
+		out &^= 1 << uint(ch)
+	}
+	if err := s.WriteFile(s.outputPath, []byte{ds2413Output | out}); err != nil {
+		return fmt.Errorf("Set {ID: %v, Path: %v}: %w", s.fullID, s.outputPath, err)
+	}
+	return nil
+}
+
+// state reads single byte from state attribute
+func (s *Switch) state() (byte, error) {
+	buf, err := s.ReadFile(s.statePath)
+	if err != nil {
+		return 0, fmt.Errorf("state {ID: %v, Path: %v}: %w", s.fullID, s.statePath, err)
+	}
+	if len(buf) != 1 {
+		return 0, fmt.Errorf("state {ID: %v, Len: %v}: %w", s.fullID, len(buf), ErrUnexpectedSwitchIO)
+	}
+	return buf[0], nil
+}
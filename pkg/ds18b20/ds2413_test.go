@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20_test
+
+import (
+	"io"
+	"path"
+	"testing"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/stretchr/testify/suite"
+)
+
+type SwitchSuite struct {
+	suite.Suite
+}
+
+func TestSwitchSuite(t *testing.T) {
+	suite.Run(t, new(SwitchSuite))
+}
+
+func (t *SwitchSuite) TestSwitch_WrongFamily() {
+	r := t.Require()
+	_, err := ds18b20.NewSwitch(new(FileMock), "28-05169463beff", "base")
+	r.ErrorIs(err, ds18b20.ErrUnsupportedFamily)
+}
+
+func (t *SwitchSuite) TestSwitch_State() {
+	r := t.Require()
+	file := new(FileMock)
+	statePath := path.Join("base", "3a-0000000a1b2c", "state")
+	s, err := ds18b20.NewSwitch(file, "3a-0000000a1b2c", "base")
+	r.Nil(err)
+
+	// PIOA: pin high, latch set; PIOB: pin low, latch cleared. Upper nibble is complement of lower one
+	file.On("ReadFile", statePath).Return([]byte{0xC3}, nil).Once()
+	pin, latch, err := s.State(ds18b20.ChannelA)
+	r.Nil(err)
+	r.True(pin)
+	r.True(latch)
+
+	file.On("ReadFile", statePath).Return([]byte{0xC3}, nil).Once()
+	pin, latch, err = s.State(ds18b20.ChannelB)
+	r.Nil(err)
+	r.False(pin)
+	r.False(latch)
+
+	_, _, err = s.State(ds18b20.Channel(2))
+	r.ErrorIs(err, ds18b20.ErrUnknownChannel)
+
+	file.On("ReadFile", statePath).Return([]byte{0xC3, 0x0a}, nil).Once()
+	_, _, err = s.State(ds18b20.ChannelA)
+	r.ErrorIs(err, ds18b20.ErrUnexpectedSwitchIO)
+
+	file.On("ReadFile", statePath).Return([]byte{}, io.ErrUnexpectedEOF).Once()
+	_, _, err = s.State(ds18b20.ChannelA)
+	r.ErrorIs(err, io.ErrUnexpectedEOF)
+	file.AssertExpectations(t.T())
+}
+
+func (t *SwitchSuite) TestSwitch_Set() {
+	r := t.Require()
+	file := new(FileMock)
+	statePath := path.Join("base", "3a-0000000a1b2c", "state")
+	outputPath := path.Join("base", "3a-0000000a1b2c", "output")
+	s, err := ds18b20.NewSwitch(file, "3a-0000000a1b2c", "base")
+	r.Nil(err)
+
+	// Latch of PIOB is set, so it is kept while PIOA is set
+	file.On("ReadFile", statePath).Return([]byte{0x78}, nil).Once()
+	file.On("WriteFile", outputPath, []byte{0xFF}).Return(nil).Once()
+	r.Nil(s.Set(ds18b20.ChannelA, true))
+
+	// Clearing PIOB keeps PIOA latch
+	file.On("ReadFile", statePath).Return([]byte{0x4b}, nil).Once()
+	file.On("WriteFile", outputPath, []byte{0xFD}).Return(nil).Once()
+	r.Nil(s.Set(ds18b20.ChannelB, false))
+
+	file.On("ReadFile", statePath).Return([]byte{0xF0}, nil).Once()
+	file.On("WriteFile", outputPath, []byte{0xFE}).Return(io.ErrClosedPipe).Once()
+	r.ErrorIs(s.Set(ds18b20.ChannelB, true), io.ErrClosedPipe)
+
+	r.ErrorIs(s.Set(ds18b20.Channel(-1), true), ds18b20.ErrUnknownChannel)
+	file.AssertExpectations(t.T())
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Commands accepted by eeprom_cmd attribute
+const (
+	eepromSave    = "save"
+	eepromRestore = "restore"
+)
+
+var (
+	ErrUnexpectedPowerMode = errors.New("unexpected value of ext_power")
+	ErrNoEEPROM            = errors.New("device has no eeprom")
+)
+
+// Save stores resolution and alarms in EEPROM, so they survive power cycle
+func (s *Sensor) Save() error {
+	if !s.family.hasEEPROM() {
+		return fmt.Errorf("Save {ID: %v}: %w", s.fullID, ErrNoEEPROM)
+	}
+	if err := s.WriteFile(s.eepromPath, []byte(eepromSave)); err != nil {
+		return fmt.Errorf("Save {ID: %v, Path: %v}: %w", s.fullID, s.eepromPath, err)
+	}
+	return nil
+}
+
+// Restore loads resolution and alarms from EEPROM, discarding changes, which weren't saved
+func (s *Sensor) Restore() error {
+	if !s.family.hasEEPROM() {
+		return fmt.Errorf("Restore {ID: %v}: %w", s.fullID, ErrNoEEPROM)
+	}
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	if err := s.WriteFile(s.eepromPath, []byte(eepromRestore)); err != nil {
+		return fmt.Errorf("Restore {ID: %v, Path: %v}: %w", s.fullID, s.eepromPath, err)
+	}
+
+	res, err := s.resolution()
+	if err != nil {
+		return fmt.Errorf("Restore.resolution {ID: %v}: %w", s.fullID, err)
+	}
+	s.cfg.Resolution = res
+
+	if s.alarms {
+		low, high, err := s.readAlarms()
+		if err != nil {
+			return fmt.Errorf("Restore.readAlarms {ID: %v}: %w", s.fullID, err)
+		}
+		s.cfg.AlarmLow, s.cfg.AlarmHigh = low, high
+	}
+	return nil
+}
+
+// parasitic reads ext_power, which is 0 for sensor powered from data line and 1 for external supply
+func (s *Sensor) parasitic() (bool, error) {
+	buf, err := s.readFile(s.extPowerPath)
+	if err != nil {
+		return false, fmt.Errorf("parasitic {ID: %v}: %w", s.fullID, err)
+	}
+	switch buf {
+	case "0":
+		return true, nil
+	case "1":
+		return false, nil
+	default:
+		return false, fmt.Errorf("parasitic {ID: %v, value: %v}: %w", s.fullID, buf, ErrUnexpectedPowerMode)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Family is first part of onewire ID, which identifies type of device
+type Family string
+
+// Supported families
+const (
+	FamilyDS18S20  Family = "10"
+	FamilyDS1822   Family = "22"
+	FamilyDS18B20  Family = "28"
+	FamilyDS2413   Family = "3a"
+	FamilyMAX31850 Family = "3b"
+)
+
+var (
+	ErrUnsupportedFamily = errors.New("unsupported family")
+)
+
+var familyNames = map[Family]string{
+	FamilyDS18S20:  "DS18S20",
+	FamilyDS1822:   "DS1822",
+	FamilyDS18B20:  "DS18B20",
+	FamilyDS2413:   "DS2413",
+	FamilyMAX31850: "MAX31850",
+}
+
+// Device is slave found on Bus
+type Device struct {
+	ID        string `json:"id"`
+	Family    Family `json:"family"`
+	Name      string `json:"name"`
+	Supported bool   `json:"supported"`
+}
+
+// FamilyOf returns Family based on ID in format ff-xxxxxxxxxxxx
+func FamilyOf(id string) Family {
+	idx := strings.Index(id, "-")
+	if idx < 0 {
+		return ""
+	}
+	return Family(strings.ToLower(id[:idx]))
+}
+
+// Name returns name of device, or empty string for unsupported Family
+func (f Family) Name() string {
+	return familyNames[f]
+}
+
+// Supported returns true, if there is driver for Family
+func (f Family) Supported() bool {
+	_, ok := familyNames[f]
+	return ok
+}
+
+// Thermometer returns true, if Family is handled by Sensor
+func (f Family) Thermometer() bool {
+	switch f {
+	case FamilyDS18S20, FamilyDS1822, FamilyDS18B20, FamilyMAX31850:
+		return true
+	default:
+		return false
+	}
+}
+
+// fixedResolution returns resolution of Family, which can't be changed
+func (f Family) fixedResolution() (Resolution, bool) {
+	switch f {
+	case FamilyDS18S20:
+		return Resolution9Bit, true
+	case FamilyMAX31850:
+		return Resolution14Bit, true
+	default:
+		return 0, false
+	}
+}
+
+// hasAlarms returns true, if Family has TH and TL registers
+func (f Family) hasAlarms() bool {
+	return f != FamilyMAX31850
+}
+
+// hasEEPROM returns true, if Family is able to store configuration
+func (f Family) hasEEPROM() bool {
+	return f != FamilyMAX31850
+}
+
+// hasPowerOnReset returns true, if Family reports 85°C before first conversion
+func (f Family) hasPowerOnReset() bool {
+	return f != FamilyMAX31850
+}
+
+// Devices returns all slaves on Bus, unsupported ones are listed too
+func (b *Bus) Devices() ([]Device, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if err := b.updateIDs(); err != nil {
+		return nil, fmt.Errorf("Devices: %w", err)
+	}
+	devices := make([]Device, len(b.ids))
+	for i, id := range b.ids {
+		f := FamilyOf(id)
+		devices[i] = Device{ID: id, Family: f, Name: f.Name(), Supported: f.Supported()}
+	}
+	return devices, nil
+}
@@ -5,8 +5,43 @@
 
 package ds18b20
 
+import (
+	"time"
+)
+
 type BusOption func(bus *Bus)
 
+// WithBulkRead makes polled sensors convert temperature at once, by writing trigger to therm_bulk_read every interval.
+// Readings are collected by Bus.GetReadings, PollInterval of Sensor is not used.
+func WithBulkRead(interval time.Duration) BusOption {
+	return func(bus *Bus) {
+		bus.bulk = interval
+	}
+}
+
+type SensorOption func(s *Sensor)
+
+// WithReadMode selects source of temperature, ReadTemperature is default
+func WithReadMode(mode ReadMode) SensorOption {
+	return func(s *Sensor) {
+		s.mode = mode
+	}
+}
+
+// WithAlarms enables TH and TL registers, which are accessed by alarms attribute (not available on older kernels)
+func WithAlarms() SensorOption {
+	return func(s *Sensor) {
+		s.alarms = true
+	}
+}
+
+// WithSensorOptions applies options to each Sensor created by Bus
+func WithSensorOptions(options ...SensorOption) BusOption {
+	return func(bus *Bus) {
+		bus.sensorOptions = append(bus.sensorOptions, options...)
+	}
+}
+
 func WithInterface(o Onewire) BusOption {
 	return func(bus *Bus) {
 		bus.o = o
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
 	"github.com/a-clap/logging"
 )
 
@@ -31,34 +32,56 @@ const (
 	Resolution10Bit Resolution = 10
 	Resolution11Bit Resolution = 11
 	Resolution12Bit Resolution = 12
+	// Resolution14Bit is fixed resolution of MAX31850
+	Resolution14Bit Resolution = 14
 )
 
 var (
 	ErrUnexpectedResolution = errors.New("unexpected resolution")
+	ErrFixedResolution      = errors.New("resolution can't be changed")
 )
 
 // Readings are returned, when Sensor is used in Poll mode
 type Readings struct {
 	ID          string    `json:"id"`
 	Temperature float64   `json:"temperature"`
+	Filtered    float64   `json:"filtered"`
 	Average     float64   `json:"average"`
 	Stamp       time.Time `json:"stamp"`
 	Error       string    `json:"error"`
+	Alarm       bool      `json:"alarm"`
 }
 
-// Sensor represents DS18b20
+// Sensor represents DS18b20 and other onewire thermometers (see Family)
 type Sensor struct {
 	FileReaderWriter
 	fullID                          string
 	id                              string
+	family                          Family
 	temperaturePath, resolutionPath string
 	polling                         atomic.Bool
 	fin, stop                       chan struct{}
 	data                            chan Readings
 	average                         *avg.Avg
+	filter                          *avg.Chain
 	cfg                             SensorConfig
 	readings                        []Readings
 	mtx                             *sync.Mutex
+	mode                            ReadMode
+	w1SlavePath                     string
+	alarmsPath                      string
+	eepromPath, extPowerPath        string
+	// alarms is set by WithAlarms, alarm is state of last conversion
+	alarms, alarm bool
+	// bulk is set, if Sensor is polled by Bus with single conversion
+	bulk *Bus
+	// last is previous reading of w1_slave (without correction), used to confirm power-on reset value
+	last *float64
+	// latest is result of last conversion, see Latest
+	latest Readings
+	// ctl serializes Poll and Close, cfgMtx protects cfg, average, filter, last, alarm and latest
+	ctl    sync.Mutex
+	cfgMtx sync.Mutex
 }
 
 // SensorConfig allows user to configure Sensor (except ID, which is unique and can't be changed)
@@ -69,18 +92,33 @@ type SensorConfig struct {
 	Resolution   Resolution    `json:"resolution"`
 	PollInterval time.Duration `json:"poll_interval"`
 	Samples      uint          `json:"samples"`
+	// AlarmLow and AlarmHigh are TL and TH registers, see InAlarm
+	AlarmLow  int `json:"alarm_low"`
+	AlarmHigh int `json:"alarm_high"`
+	// Parasitic is true, if sensor is powered from data line. It is read only
+	Parasitic bool `json:"parasitic"`
+	// Calibration is applied before Correction, Stamp is set by Configure, unless other Stamp is given
+	Calibration calibration.Calibration `json:"calibration"`
+	// Filters are applied in order to corrected temperature, Average is computed from filtered value
+	Filters []avg.FilterConfig `json:"filters"`
 }
 
-// NewSensor creates new sensor based on args
-func NewSensor(o FileReaderWriter, id, basePath string) (*Sensor, error) {
+// NewSensor creates new sensor based on args. Family is taken from id, unknown one is handled like DS18B20.
+func NewSensor(o FileReaderWriter, id, basePath string, options ...SensorOption) (*Sensor, error) {
 	bus := basePath[strings.LastIndex(basePath, "/")+1:]
 
 	s := &Sensor{
 		FileReaderWriter: o,
 		id:               id,
 		fullID:           bus + ":" + id,
+		family:           FamilyOf(id),
 		temperaturePath:  path.Join(basePath, id, "temperature"),
 		resolutionPath:   path.Join(basePath, id, "resolution"),
+		w1SlavePath:      path.Join(basePath, id, "w1_slave"),
+		alarmsPath:       path.Join(basePath, id, "alarms"),
+		eepromPath:       path.Join(basePath, id, "eeprom_cmd"),
+		extPowerPath:     path.Join(basePath, id, "ext_power"),
+		mode:             ReadTemperature,
 		polling:          atomic.Bool{},
 		fin:              nil,
 		stop:             nil,
@@ -98,18 +136,40 @@ func NewSensor(o FileReaderWriter, id, basePath string) (*Sensor, error) {
 		mtx:      &sync.Mutex{},
 	}
 	s.average = avg.New(s.cfg.Samples)
+	s.filter, _ = avg.NewChain(nil)
+
+	for _, opt := range options {
+		opt(s)
+	}
+	if s.mode != ReadTemperature && s.mode != ReadW1Slave {
+		return nil, fmt.Errorf("NewSensor {ID: %v, ReadMode: %v}: %w", s.fullID, s.mode, ErrUnknownReadMode)
+	}
 
 	var err error
 	if s.cfg.Resolution, err = s.resolution(); err != nil {
 		return nil, fmt.Errorf("NewSensor.resolution {ID: %v}: %w", s.fullID, err)
 	}
 
+	// WithAlarms is applied to whole Bus, so it is silently ignored for families without TH and TL
+	s.alarms = s.alarms && s.family.hasAlarms()
+
+	if s.alarms {
+		if s.cfg.AlarmLow, s.cfg.AlarmHigh, err = s.readAlarms(); err != nil {
+			return nil, fmt.Errorf("NewSensor.readAlarms {ID: %v}: %w", s.fullID, err)
+		}
+	}
+
+	// Older kernels don't have ext_power, so it is not an error
+	if s.cfg.Parasitic, err = s.parasitic(); err != nil {
+		logger.Debug("ds18b20 power mode unknown", logging.String("ID", s.fullID), logging.String("error", err.Error()))
+	}
+
 	return s, nil
 }
 
 // Name returns user provided name
 func (s *Sensor) Name() string {
-	return s.cfg.Name
+	return s.GetConfig().Name
 }
 
 // ID returns Sensor hardware id in id
@@ -123,13 +183,20 @@ func (s *Sensor) FullID() string {
 }
 
 // Poll is an option to run temperature updates in background
-// After calling Poll, user can get data from GetReadings
+// After calling Poll, user can get data from GetReadings.
+// If Sensor was created by Bus with bulk read, data is collected by Bus.GetReadings.
 func (s *Sensor) Poll() {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
 	if s.polling.Load() {
 		return
 	}
 
 	s.polling.Store(true)
+	if s.bulk != nil {
+		s.bulk.join(s)
+		return
+	}
 	s.fin = make(chan struct{})
 	s.stop = make(chan struct{})
 	s.data = make(chan Readings, 10)
@@ -138,13 +205,50 @@ func (s *Sensor) Poll() {
 
 // Temperature returns current temperature and average (which is based on Samples)
 func (s *Sensor) Temperature() (actual, avg float64, err error) {
+	actual, _, avg, err = s.measure()
+	return
+}
+
+// measure reads temperature and passes it through Filters, filtered value is averaged
+func (s *Sensor) measure() (actual, filtered, average float64, err error) {
+	var t64 float64
+	if s.mode == ReadW1Slave {
+		t64, err = s.w1Slave()
+	} else {
+		t64, err = s.temperature()
+	}
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	if err != nil {
+		s.latest = Readings{ID: s.id, Stamp: time.Now(), Error: err.Error()}
+		return 0, 0, 0, err
+	}
+	s.checkAlarm(t64)
+	actual = s.cfg.Calibration.Apply(t64) + s.cfg.Correction
+	filtered = s.filter.Add(actual)
+	s.average.Add(filtered)
+	average = s.average.Average()
+	s.latest = Readings{ID: s.id, Temperature: actual, Filtered: filtered, Average: average, Stamp: time.Now(), Alarm: s.alarm}
+	return actual, filtered, average, nil
+}
+
+// Latest returns result of last conversion (made by Poll, bulk read or Temperature) without starting new one.
+// Stamp is zero before first conversion.
+func (s *Sensor) Latest() Readings {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.latest
+}
+
+// temperature reads value from temperature attribute
+func (s *Sensor) temperature() (float64, error) {
 	conv, err := s.readFile(s.temperaturePath)
 	if err != nil || len(conv) == 0 {
 		if err == nil {
 			err = errors.New("file returned empty buffer")
 		}
 		err = fmt.Errorf("Temperature.readFile {ID: %v, path: %v}: %w", s.fullID, s.temperaturePath, err)
-		return 0, 0, err
+		return 0, err
 	}
 
 	length := len(conv)
@@ -162,15 +266,15 @@ func (s *Sensor) Temperature() (actual, avg float64, err error) {
 	t64, err := strconv.ParseFloat(conv, 64)
 	if err != nil {
 		err = fmt.Errorf("Temperature.ParseFloat {ID: %v, path: %v, value:%v}: %w", s.fullID, s.temperaturePath, conv, err)
-		return 0, 0, err
+		return 0, err
 	}
-	tmp := t64 + s.cfg.Correction
-	s.average.Add(tmp)
-	return tmp, s.Average(), nil
+	return t64, nil
 }
 
 // Average returns current average temperature
 func (s *Sensor) Average() float64 {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	return s.average.Average()
 }
 
@@ -190,6 +294,8 @@ func (s *Sensor) GetReadings() []Readings {
 
 // Configure allows user to configure sensor with SensorConfig
 func (s *Sensor) Configure(config SensorConfig) error {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	s.cfg.Name = config.Name
 
 	if s.cfg.Samples != config.Samples {
@@ -198,12 +304,42 @@ func (s *Sensor) Configure(config SensorConfig) error {
 	}
 
 	if s.cfg.Resolution != config.Resolution {
+		if _, fixed := s.family.fixedResolution(); fixed {
+			return fmt.Errorf("Configure {ID: %v, Resolution: %v}: %w", s.fullID, config.Resolution, ErrFixedResolution)
+		}
 		if err := s.setResolution(config.Resolution); err != nil {
 			return fmt.Errorf("Configure.setResolution {ID: %v, Resolution: %v}: %w", s.fullID, config.Resolution, err)
 		}
 		s.cfg.Resolution = config.Resolution
 	}
 
+	if s.cfg.AlarmLow != config.AlarmLow || s.cfg.AlarmHigh != config.AlarmHigh {
+		if err := s.setAlarms(config.AlarmLow, config.AlarmHigh); err != nil {
+			return fmt.Errorf("Configure.setAlarms {ID: %v, AlarmLow: %v, AlarmHigh: %v}: %w", s.fullID, config.AlarmLow, config.AlarmHigh, err)
+		}
+		s.cfg.AlarmLow, s.cfg.AlarmHigh = config.AlarmLow, config.AlarmHigh
+	}
+
+	if !s.cfg.Calibration.Equal(config.Calibration) {
+		if err := config.Calibration.Validate(); err != nil {
+			return fmt.Errorf("Configure {ID: %v}: %w", s.fullID, err)
+		}
+		stamp := s.cfg.Calibration.Stamp
+		s.cfg.Calibration = config.Calibration
+		// Other Stamp is kept, so previous calibration can be restored
+		if config.Calibration.Stamp.IsZero() || config.Calibration.Stamp.Equal(stamp) {
+			s.cfg.Calibration.Stamp = time.Now()
+		}
+	}
+
+	if !s.filter.Matches(config.Filters) {
+		filter, err := avg.NewChain(config.Filters)
+		if err != nil {
+			return fmt.Errorf("Configure {ID: %v}: %w", s.fullID, err)
+		}
+		s.filter, s.cfg.Filters = filter, filter.Configs()
+	}
+
 	s.cfg.PollInterval = config.PollInterval
 	s.cfg.Correction = config.Correction
 	return nil
@@ -211,15 +347,24 @@ func (s *Sensor) Configure(config SensorConfig) error {
 
 // GetConfig returns current config
 func (s *Sensor) GetConfig() SensorConfig {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	return s.cfg
 }
 
 // Close should be called, if user used Poll
 func (s *Sensor) Close() {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
 	if !s.polling.Load() {
 		// Nothing to do
 		return
 	}
+	if s.bulk != nil {
+		s.polling.Store(false)
+		s.bulk.leave(s)
+		return
+	}
 
 	// Close stop channel to signal finish of polling
 	close(s.stop)
@@ -234,6 +379,9 @@ func (s *Sensor) Close() {
 }
 
 func (s *Sensor) resolution() (r Resolution, err error) {
+	if res, fixed := s.family.fixedResolution(); fixed {
+		return res, nil
+	}
 	// Sometimes resolution returns < 0, no idea why
 	tries := 3
 	var resolution int64
@@ -268,18 +416,19 @@ func (s *Sensor) setResolution(res Resolution) (err error) {
 }
 
 func (s *Sensor) poll() {
-	go func() {
-		for data := range s.data {
-			s.add(data)
+	// Goroutine may outlive Close, so it can't read s.data, which is replaced by next Poll
+	go func(data chan Readings) {
+		for r := range data {
+			s.add(r)
 		}
-	}()
+	}(s.data)
 
 	for s.polling.Load() {
 		select {
 		case <-s.stop:
 			s.polling.Store(false)
-		case <-time.After(s.cfg.PollInterval):
-			actual, average, err := s.Temperature()
+		case <-time.After(s.GetConfig().PollInterval):
+			actual, filtered, average, err := s.measure()
 			e := ""
 			if err != nil {
 				e = err.Error()
@@ -287,9 +436,11 @@ func (s *Sensor) poll() {
 			r := Readings{
 				ID:          s.id,
 				Temperature: actual,
+				Filtered:    filtered,
 				Average:     average,
 				Stamp:       time.Now(),
 				Error:       e,
+				Alarm:       err == nil && s.InAlarm(),
 			}
 			if e != "" {
 				logger.Error("error on ds18b20.Poll", logging.Reflect("readings", r))
@@ -10,9 +10,12 @@ import (
 	"io"
 	"path"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
 	"github.com/a-clap/embedded/pkg/ds18b20"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -58,6 +61,7 @@ func (t *SensorSuite) TestSensor_SetResolutionError() {
 	resolution := []byte("9")
 	filer := new(FileMock)
 	filer.On("ReadFile", mock.Anything).Return(resolution, nil).Once()
+	filer.On("ReadFile", path.Join("base", "1", "ext_power")).Return([]byte("1"), nil)
 	r := t.Require()
 	s, err := ds18b20.NewSensor(filer, "1", "base")
 	r.NotNil(s)
@@ -135,6 +139,7 @@ func (t *SensorSuite) TestSensor_NewSensor_ReadResolution() {
 
 		fileMock := new(FileMock)
 		fileMock.On("ReadFile", resolutionPath).Return(arg.resolution, nil)
+		fileMock.On("ReadFile", path.Join(arg.path, arg.id, "ext_power")).Return([]byte("1"), nil)
 
 		s, err := ds18b20.NewSensor(fileMock, arg.id, arg.path)
 		if arg.expectedErr != nil {
@@ -196,6 +201,7 @@ func (t *SensorSuite) TestSensor_TemperatureConversions() {
 		r := t.Require()
 		file := new(FileMock)
 		file.On("ReadFile", path.Join("", "", "resolution")).Return(resolution, nil)
+		file.On("ReadFile", path.Join("", "", "ext_power")).Return([]byte("1"), nil)
 		file.On("ReadFile", path.Join("", "", "temperature")).Return(arg.temperatureBuf, nil)
 
 		s, err := ds18b20.NewSensor(file, "", "")
@@ -211,6 +217,331 @@ func (t *SensorSuite) TestSensor_TemperatureConversions() {
 	}
 }
 
+func (t *SensorSuite) TestSensor_W1Slave() {
+	const (
+		good    = "72 01 4b 46 7f ff 0e 10 57 : crc=57 YES\n72 01 4b 46 7f ff 0e 10 57 t=23125\n"
+		bad     = "72 01 4b 46 7f ff 0e 10 57 : crc=12 NO\n72 01 4b 46 7f ff 0e 10 57 t=23125\n"
+		reset   = "50 05 4b 46 7f ff 0c 10 1c : crc=1c YES\n50 05 4b 46 7f ff 0c 10 1c t=85000\n"
+		hot     = "4e 05 4b 46 7f ff 0e 10 0f : crc=0f YES\n4e 05 4b 46 7f ff 0e 10 0f t=84875\n"
+		minus   = "5e ff 4b 46 7f ff 02 10 68 : crc=68 YES\n5e ff 4b 46 7f ff 02 10 68 t=-10125\n"
+		garbage = "72 01 4b 46 7f ff 0e 10 57 : crc=57 YES\n"
+	)
+	args := []struct {
+		name        string
+		reads       []string
+		expected    []float64
+		expectedErr error
+	}{
+		{
+			name:     "crc ok",
+			reads:    []string{good},
+			expected: []float64{23.125},
+		},
+		{
+			name:     "negative",
+			reads:    []string{minus},
+			expected: []float64{-10.125},
+		},
+		{
+			name:     "crc retried",
+			reads:    []string{bad, bad, good},
+			expected: []float64{23.125},
+		},
+		{
+			name:        "crc fails on each try",
+			reads:       []string{bad, bad, bad},
+			expectedErr: ds18b20.ErrCRC,
+		},
+		{
+			name:     "power-on reset rejected",
+			reads:    []string{reset, good},
+			expected: []float64{23.125},
+		},
+		{
+			name:     "power-on reset confirmed by next read",
+			reads:    []string{reset, reset},
+			expected: []float64{85},
+		},
+		{
+			name:     "power-on reset confirmed by previous read",
+			reads:    []string{hot, reset},
+			expected: []float64{84.875, 85},
+		},
+		{
+			name:        "power-on reset not confirmed",
+			reads:       []string{reset, bad, bad},
+			expectedErr: ds18b20.ErrPowerOnReset,
+		},
+		{
+			name:        "unexpected format",
+			reads:       []string{garbage},
+			expectedErr: ds18b20.ErrUnexpectedFormat,
+		},
+	}
+	for _, arg := range args {
+		r := t.Require()
+		file := new(FileMock)
+		file.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("11"), nil)
+		file.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("1"), nil)
+		for _, read := range arg.reads {
+			file.On("ReadFile", path.Join("base", "id", "w1_slave")).Return([]byte(read), nil).Once()
+		}
+
+		s, err := ds18b20.NewSensor(file, "id", "base", ds18b20.WithReadMode(ds18b20.ReadW1Slave))
+		r.Nil(err, arg.name)
+		if arg.expectedErr != nil {
+			_, _, err := s.Temperature()
+			r.ErrorIs(err, arg.expectedErr, arg.name)
+			continue
+		}
+		for _, expected := range arg.expected {
+			v, _, err := s.Temperature()
+			r.Nil(err, arg.name)
+			r.InDelta(expected, v, 0.0001, arg.name)
+		}
+		file.AssertExpectations(t.T())
+	}
+}
+
+func (t *SensorSuite) TestSensor_UnknownReadMode() {
+	r := t.Require()
+	file := new(FileMock)
+	s, err := ds18b20.NewSensor(file, "id", "base", ds18b20.WithReadMode("random"))
+	r.Nil(s)
+	r.ErrorIs(err, ds18b20.ErrUnknownReadMode)
+}
+
+func (t *SensorSuite) TestSensor_Alarms() {
+	r := t.Require()
+	file := new(FileMock)
+	alarmsPath := path.Join("base", "id", "alarms")
+	temperaturePath := path.Join("base", "id", "temperature")
+	file.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("11"), nil)
+	file.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("1"), nil)
+	file.On("ReadFile", alarmsPath).Return([]byte("-10 30\n"), nil)
+
+	s, err := ds18b20.NewSensor(file, "id", "base", ds18b20.WithAlarms())
+	r.Nil(err)
+	r.True(s.AlarmsEnabled())
+	cfg := s.GetConfig()
+	r.Equal(-10, cfg.AlarmLow)
+	r.Equal(30, cfg.AlarmHigh)
+
+	// Only integer part is compared, as DS18B20 does
+	for _, arg := range []struct {
+		temperature string
+		alarm       bool
+	}{
+		{temperature: "20000", alarm: false},
+		{temperature: "29999", alarm: false},
+		{temperature: "30000", alarm: true},
+		{temperature: "-9000", alarm: false},
+		// Integer part of -9.5 is -10 in two's complement
+		{temperature: "-9500", alarm: true},
+		{temperature: "-10500", alarm: true},
+	} {
+		file.On("ReadFile", temperaturePath).Return([]byte(arg.temperature), nil).Once()
+		_, _, err := s.Temperature()
+		r.Nil(err)
+		r.Equal(arg.alarm, s.InAlarm(), arg.temperature)
+	}
+
+	cfg.AlarmLow, cfg.AlarmHigh = 0, 40
+	file.On("WriteFile", alarmsPath, []byte("0 40")).Return(nil).Once()
+	r.Nil(s.Configure(cfg))
+	r.Equal(cfg, s.GetConfig())
+
+	cfg.AlarmHigh = 126
+	r.ErrorIs(s.Configure(cfg), ds18b20.ErrAlarmOutOfRange)
+	cfg.AlarmLow, cfg.AlarmHigh = 50, 40
+	r.ErrorIs(s.Configure(cfg), ds18b20.ErrAlarmOutOfRange)
+
+	// Without alarms, TH and TL can't be changed and sensor is never in alarm
+	noAlarms := new(FileMock)
+	noAlarms.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("11"), nil)
+	noAlarms.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("1"), nil)
+	noAlarms.On("ReadFile", temperaturePath).Return([]byte("125000"), nil)
+	s, err = ds18b20.NewSensor(noAlarms, "id", "base")
+	r.Nil(err)
+	r.False(s.AlarmsEnabled())
+	_, _, err = s.Temperature()
+	r.Nil(err)
+	r.False(s.InAlarm())
+	cfg = s.GetConfig()
+	cfg.AlarmHigh = 40
+	r.ErrorIs(s.Configure(cfg), ds18b20.ErrAlarmsNotSupported)
+}
+
+func (t *SensorSuite) TestSensor_EEPROM() {
+	r := t.Require()
+	file := new(FileMock)
+	resolutionPath := path.Join("base", "id", "resolution")
+	alarmsPath := path.Join("base", "id", "alarms")
+	eepromPath := path.Join("base", "id", "eeprom_cmd")
+	file.On("ReadFile", resolutionPath).Return([]byte("12"), nil).Once()
+	file.On("ReadFile", alarmsPath).Return([]byte("-10 30"), nil).Once()
+	file.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("0\n"), nil)
+
+	s, err := ds18b20.NewSensor(file, "id", "base", ds18b20.WithAlarms())
+	r.Nil(err)
+	r.True(s.GetConfig().Parasitic)
+
+	file.On("WriteFile", eepromPath, []byte("save")).Return(nil).Once()
+	r.Nil(s.Save())
+
+	// Restore reloads registers from EEPROM
+	file.On("WriteFile", eepromPath, []byte("restore")).Return(nil).Once()
+	file.On("ReadFile", resolutionPath).Return([]byte("9"), nil).Once()
+	file.On("ReadFile", alarmsPath).Return([]byte("0 85"), nil).Once()
+	r.Nil(s.Restore())
+	cfg := s.GetConfig()
+	r.Equal(ds18b20.Resolution9Bit, cfg.Resolution)
+	r.Equal(0, cfg.AlarmLow)
+	r.Equal(85, cfg.AlarmHigh)
+
+	file.On("WriteFile", eepromPath, mock.Anything).Return(io.ErrClosedPipe)
+	r.ErrorIs(s.Save(), io.ErrClosedPipe)
+	r.ErrorIs(s.Restore(), io.ErrClosedPipe)
+	file.AssertExpectations(t.T())
+}
+
+func (t *SensorSuite) TestSensor_PowerMode() {
+	for _, arg := range []struct {
+		extPower  []byte
+		err       error
+		parasitic bool
+	}{
+		{extPower: []byte("0"), parasitic: true},
+		{extPower: []byte("1\n"), parasitic: false},
+		// Unknown mode isn't an error, older kernels don't have ext_power
+		{extPower: []byte("-1"), parasitic: false},
+		{extPower: []byte{}, err: io.ErrUnexpectedEOF, parasitic: false},
+	} {
+		r := t.Require()
+		file := new(FileMock)
+		file.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("12"), nil)
+		file.On("ReadFile", path.Join("base", "id", "ext_power")).Return(arg.extPower, arg.err)
+		s, err := ds18b20.NewSensor(file, "id", "base")
+		r.Nil(err)
+		r.Equal(arg.parasitic, s.GetConfig().Parasitic, string(arg.extPower))
+	}
+}
+
+func (t *SensorSuite) TestSensor_Families() {
+	r := t.Require()
+
+	// DS18S20 has fixed resolution, resolution attribute is not read
+	file := new(FileMock)
+	file.On("ReadFile", path.Join("base", "10-000801b5a7c8", "ext_power")).Return([]byte("1"), nil)
+	file.On("ReadFile", path.Join("base", "10-000801b5a7c8", "temperature")).Return([]byte("21500"), nil)
+	s, err := ds18b20.NewSensor(file, "10-000801b5a7c8", "base")
+	r.Nil(err)
+	cfg := s.GetConfig()
+	r.Equal(ds18b20.Resolution9Bit, cfg.Resolution)
+	v, _, err := s.Temperature()
+	r.Nil(err)
+	r.InDelta(21.5, v, 1e-9)
+	cfg.Resolution = ds18b20.Resolution12Bit
+	r.ErrorIs(s.Configure(cfg), ds18b20.ErrFixedResolution)
+	file.AssertExpectations(t.T())
+
+	// DS1822 behaves like DS18B20
+	file = new(FileMock)
+	file.On("ReadFile", path.Join("base", "22-000003c9b5d6", "resolution")).Return([]byte("10"), nil)
+	file.On("ReadFile", path.Join("base", "22-000003c9b5d6", "ext_power")).Return([]byte("1"), nil)
+	s, err = ds18b20.NewSensor(file, "22-000003c9b5d6", "base")
+	r.Nil(err)
+	r.Equal(ds18b20.Resolution10Bit, s.GetConfig().Resolution)
+
+	// MAX31850 has no alarms, no eeprom and 85°C is valid reading
+	file = new(FileMock)
+	w1Slave := path.Join("base", "3b-0000001921e8", "w1_slave")
+	file.On("ReadFile", path.Join("base", "3b-0000001921e8", "ext_power")).Return([]byte{}, io.ErrUnexpectedEOF)
+	file.On("ReadFile", w1Slave).Return([]byte("50 05 00 00 f0 ff ff ff 8e : crc=8e YES\n50 05 00 00 f0 ff ff ff 8e t=85000\n"), nil).Once()
+	s, err = ds18b20.NewSensor(file, "3b-0000001921e8", "base", ds18b20.WithAlarms(), ds18b20.WithReadMode(ds18b20.ReadW1Slave))
+	r.Nil(err)
+	r.False(s.AlarmsEnabled())
+	r.Equal(ds18b20.Resolution14Bit, s.GetConfig().Resolution)
+	v, _, err = s.Temperature()
+	r.Nil(err)
+	r.InDelta(85.0, v, 1e-9)
+	r.False(s.InAlarm())
+	cfg = s.GetConfig()
+	cfg.AlarmHigh = 40
+	r.ErrorIs(s.Configure(cfg), ds18b20.ErrAlarmsNotSupported)
+	r.ErrorIs(s.Save(), ds18b20.ErrNoEEPROM)
+	r.ErrorIs(s.Restore(), ds18b20.ErrNoEEPROM)
+	file.AssertExpectations(t.T())
+}
+
+func (t *SensorSuite) TestSensor_Calibration() {
+	r := t.Require()
+	file := new(FileMock)
+	file.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("12"), nil)
+	file.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("1"), nil)
+	file.On("ReadFile", path.Join("base", "id", "temperature")).Return([]byte("98500"), nil)
+	s, err := ds18b20.NewSensor(file, "id", "base")
+	r.Nil(err)
+
+	cfg := s.GetConfig()
+	cfg.Calibration = calibration.Calibration{Model: calibration.Polynomial}
+	r.ErrorIs(s.Configure(cfg), calibration.ErrNoCoefficients)
+
+	before := time.Now()
+	cfg.Calibration = calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{{Raw: 20.5, Reference: 20}, {Raw: 98.5, Reference: 100}}}
+	cfg.Correction = 0.5
+	r.Nil(s.Configure(cfg))
+	got := s.GetConfig()
+	r.Equal(calibration.TwoPoint, got.Calibration.Model)
+	r.False(got.Calibration.Stamp.Before(before))
+
+	// Calibration first, then Correction
+	v, average, err := s.Temperature()
+	r.Nil(err)
+	r.InDelta(100.5, v, 1e-9)
+	r.InDelta(100.5, average, 1e-9)
+
+	// Stamp is changed only with calibration
+	r.Nil(s.Configure(got))
+	r.Equal(got.Calibration.Stamp, s.GetConfig().Calibration.Stamp)
+
+	// Previous calibration is restored with its Stamp
+	restore := calibration.Calibration{Model: calibration.Offset, Offset: 1, Stamp: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cfg.Calibration = restore
+	r.Nil(s.Configure(cfg))
+	r.Equal(restore.Stamp, s.GetConfig().Calibration.Stamp)
+}
+
+func (t *SensorSuite) TestSensor_Filters() {
+	r := t.Require()
+	file := new(FileMock)
+	file.On("ReadFile", path.Join("base", "id", "resolution")).Return([]byte("12"), nil)
+	file.On("ReadFile", path.Join("base", "id", "ext_power")).Return([]byte("1"), nil)
+	s, err := ds18b20.NewSensor(file, "id", "base")
+	r.Nil(err)
+
+	cfg := s.GetConfig()
+	cfg.Filters = []avg.FilterConfig{{Type: avg.EMA, Alpha: 2}}
+	r.ErrorIs(s.Configure(cfg), avg.ErrAlpha)
+	r.Nil(s.GetConfig().Filters)
+
+	cfg.Filters = []avg.FilterConfig{{Type: avg.Spike, MaxDelta: 5}}
+	r.Nil(s.Configure(cfg))
+	r.Equal(cfg.Filters, s.GetConfig().Filters)
+
+	// Spike of 85 degrees doesn't reach average
+	for _, temperature := range []string{"21500", "22000", "85000"} {
+		file.On("ReadFile", path.Join("base", "id", "temperature")).Return([]byte(temperature), nil).Once()
+	}
+	expected := []struct{ actual, average float64 }{{21.5, 21.5}, {22, 21.75}, {85, 65.5 / 3}}
+	for _, e := range expected {
+		actual, average, err := s.Temperature()
+		r.Nil(err)
+		r.InDelta(e.actual, actual, 1e-9)
+		r.InDelta(e.average, average, 1e-9)
+	}
+}
+
 func (t *SensorSuite) TestSensor_InitConfig() {
 	args := []struct {
 		name           string
@@ -287,6 +618,7 @@ func (t *SensorSuite) TestSensor_InitConfig() {
 
 		filer := new(FileMock)
 		filer.On("ReadFile", path.Join("", "", "resolution")).Return(resolution, nil)
+		filer.On("ReadFile", path.Join("", "", "ext_power")).Return([]byte("1"), nil)
 		filer.On("WriteFile", path.Join("", "", "resolution"), arg.expectedResBuf).Return(arg.resWriteErr)
 
 		s, err := ds18b20.NewSensor(filer, "", "")
@@ -404,18 +736,79 @@ func (t *SensorSuite) TestSensor_Poll() {
 
 	sensor.Poll()
 	data := make([]ds18b20.Readings, 0, len(temperatures))
-	<-time.After(cfg.PollInterval * time.Duration(len(temperatures)+1))
-	data = append(data, sensor.GetReadings()...)
+	// Collect readings until there is enough of them, scheduler may delay single poll
+	timeout := time.After(time.Second)
+	for len(data) < len(temperatures) {
+		select {
+		case <-timeout:
+			r.Fail("timeout waiting for readings")
+		case <-time.After(cfg.PollInterval):
+			data = append(data, sensor.GetReadings()...)
+		}
+	}
 
 	sensor.Close()
-	r.Equal(len(data), len(temperatures))
+	r.GreaterOrEqual(len(data), len(temperatures))
 
 	for i := range data {
 		if i == 0 {
 			continue
 		}
 		diff := data[i].Stamp.Sub(data[i-1].Stamp)
-		r.InDelta(cfg.PollInterval, diff, float64(3*time.Millisecond))
+		r.GreaterOrEqual(diff, cfg.PollInterval-time.Millisecond)
+		r.Less(diff, 3*cfg.PollInterval)
 	}
 
 }
+
+func (t *SensorSuite) TestSensor_Concurrent() {
+	filer := new(FileMock)
+	filer.On("ReadFile", path.Join("", "blah", "resolution")).Return([]byte("11"), nil)
+	filer.On("ReadFile", path.Join("", "blah", "ext_power")).Return([]byte("1"), nil)
+	filer.On("ReadFile", path.Join("", "blah", "temperature")).Return([]byte("21500"), nil)
+	filer.On("WriteFile", mock.Anything, mock.Anything).Return(nil)
+
+	r := t.Require()
+	sensor, err := ds18b20.NewSensor(filer, "blah", "")
+	r.Nil(err)
+
+	// Configure changes PollInterval while poll loop is reading it
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 30; j++ {
+				switch (i + j) % 4 {
+				case 0:
+					sensor.Poll()
+				case 1:
+					sensor.Close()
+				case 2:
+					_ = sensor.Configure(ds18b20.SensorConfig{
+						Name:         strconv.Itoa(j),
+						Correction:   float64(j),
+						Resolution:   ds18b20.Resolution9Bit + ds18b20.Resolution(j%4),
+						PollInterval: time.Duration(j+1) * time.Millisecond,
+						Samples:      uint(j%5 + 1),
+					})
+				case 3:
+					_, _, _ = sensor.Temperature()
+				}
+				_ = sensor.GetConfig()
+				_ = sensor.Average()
+				_ = sensor.GetReadings()
+			}
+		}(i)
+	}
+	wg.Wait()
+	sensor.Close()
+
+	cfg := ds18b20.SensorConfig{Name: "final", ID: "blah", Resolution: ds18b20.Resolution12Bit, PollInterval: time.Millisecond, Samples: 1}
+	r.Nil(sensor.Configure(cfg))
+	r.Equal(cfg, sensor.GetConfig())
+	actual, average, err := sensor.Temperature()
+	r.Nil(err)
+	r.InDelta(21.5, actual, 1e-9)
+	r.InDelta(21.5, average, 1e-9)
+}
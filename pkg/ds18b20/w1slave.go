@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package ds18b20
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/a-clap/logging"
+)
+
+// ReadMode selects sysfs file, which is used to get temperature
+type ReadMode string
+
+// Possible read modes
+const (
+	// ReadTemperature uses temperature attribute, it is not available on older kernels
+	ReadTemperature ReadMode = "temperature"
+	// ReadW1Slave parses w1_slave file and checks CRC of scratchpad
+	ReadW1Slave ReadMode = "w1_slave"
+)
+
+const (
+	// w1SlaveTries is number of reads of w1_slave, before error is returned
+	w1SlaveTries = 3
+	// powerOnReset is value of scratchpad after power-on, when conversion didn't happen
+	powerOnReset = 85.0
+	// powerOnResetDelta is distance from powerOnReset, in which previous reading confirms powerOnReset
+	powerOnResetDelta = 1.0
+)
+
+var (
+	ErrUnknownReadMode  = errors.New("unknown read mode")
+	ErrCRC              = errors.New("crc check failed")
+	ErrUnexpectedFormat = errors.New("unexpected format of w1_slave")
+	ErrPowerOnReset     = errors.New("power-on reset value not confirmed")
+)
+
+// w1Slave reads temperature from w1_slave file. Reading is retried on CRC failure and on power-on reset value,
+// which is accepted only if it is confirmed by previous reading or by the next one.
+func (s *Sensor) w1Slave() (float64, error) {
+	var err error
+	reset := false
+	for i := 0; i < w1SlaveTries; i++ {
+		var t64 float64
+		t64, err = s.parseW1Slave()
+		if err != nil {
+			if errors.Is(err, ErrCRC) {
+				logger.Debug("ds18b20 crc failed, retrying", logging.String("ID", s.fullID))
+				continue
+			}
+			return 0, err
+		}
+
+		if t64 == powerOnReset && s.family.hasPowerOnReset() && !reset && !s.nearPowerOnReset() {
+			// Either sensor was just powered, or it is really hot - next reading will tell
+			reset = true
+			continue
+		}
+		s.cfgMtx.Lock()
+		s.last = &t64
+		s.cfgMtx.Unlock()
+		return t64, nil
+	}
+	if reset {
+		// Power-on reset is more likely cause than CRC failures, which followed it
+		err = ErrPowerOnReset
+	}
+	return 0, fmt.Errorf("Temperature.w1Slave {ID: %v, path: %v}: %w", s.fullID, s.w1SlavePath, err)
+}
+
+// parseW1Slave parses file in format:
+//
+//	72 01 4b 46 7f ff 0e 10 57 : crc=57 YES
+//	72 01 4b 46 7f ff 0e 10 57 t=23125
+func (s *Sensor) parseW1Slave() (float64, error) {
+	buf, err := s.readFile(s.w1SlavePath)
+	if err != nil {
+		return 0, fmt.Errorf("Temperature.readFile {ID: %v, path: %v}: %w", s.fullID, s.w1SlavePath, err)
+	}
+	lines := strings.Split(buf, "\n")
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("Temperature.parseW1Slave {ID: %v, value: %v}: %w", s.fullID, buf, ErrUnexpectedFormat)
+	}
+
+	crc := strings.TrimSpace(lines[0])
+	switch {
+	case strings.HasSuffix(crc, "YES"):
+	case strings.HasSuffix(crc, "NO"):
+		return 0, fmt.Errorf("Temperature.parseW1Slave {ID: %v, value: %v}: %w", s.fullID, crc, ErrCRC)
+	default:
+		return 0, fmt.Errorf("Temperature.parseW1Slave {ID: %v, value: %v}: %w", s.fullID, crc, ErrUnexpectedFormat)
+	}
+
+	idx := strings.LastIndex(lines[1], "t=")
+	if idx < 0 {
+		return 0, fmt.Errorf("Temperature.parseW1Slave {ID: %v, value: %v}: %w", s.fullID, lines[1], ErrUnexpectedFormat)
+	}
+	conv := strings.TrimSpace(lines[1][idx+2:])
+	milli, err := strconv.ParseInt(conv, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("Temperature.ParseInt {ID: %v, path: %v, value:%v}: %w", s.fullID, s.w1SlavePath, conv, err)
+	}
+	return float64(milli) / 1000, nil
+}
+
+// nearPowerOnReset returns true, if previous reading was close to powerOnReset
+func (s *Sensor) nearPowerOnReset() bool {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.last != nil && math.Abs(*s.last-powerOnReset) <= powerOnResetDelta
+}
@@ -0,0 +1,608 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/calibration"
+	"github.com/a-clap/logging"
+)
+
+// States of calibration session
+const (
+	CalibrationIdle     = "idle"
+	CalibrationSampling = "sampling"
+)
+
+// Commands accepted by CalibrationHandler.Command
+const (
+	// CalibrationSample collects paired samples at plateau, temperature should be stable
+	CalibrationSample = "sample"
+	// CalibrationApply configures targets with previewed calibration and ends session
+	CalibrationApply = "apply"
+	// CalibrationCancel restores previous calibration of targets and ends session
+	CalibrationCancel = "cancel"
+)
+
+const (
+	calibrationDefaultSamples  = 10
+	calibrationDefaultInterval = time.Second
+)
+
+var (
+	ErrNoTargets           = errors.New("calibration needs at least one target")
+	ErrReferenceIsTarget   = errors.New("reference can't be a target")
+	ErrSensorCalibrated    = errors.New("sensor is a target of another calibration")
+	ErrCalibrationSampling = errors.New("calibration is sampling")
+)
+
+type CalibrationError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *CalibrationError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+// CalibrationSensor is DS18B20 or PT100 sensor taking part in calibration
+type CalibrationSensor struct {
+	ID         string `json:"id"`
+	SensorType string `json:"sensor_type"`
+}
+
+// CalibrationConfig names reference sensor and targets, which are calibrated against it.
+// At each plateau Samples pairs are read every Interval. Degree is used only by polynomial Model.
+type CalibrationConfig struct {
+	ID        string              `json:"id"`
+	Reference CalibrationSensor   `json:"reference"`
+	Targets   []CalibrationSensor `json:"targets"`
+	Model     calibration.Model   `json:"model"`
+	Degree    int                 `json:"degree"`
+	Samples   uint                `json:"samples"`
+	Interval  time.Duration       `json:"interval"`
+}
+
+// CalibrationCommand is used to mark plateau, apply or cancel calibration
+type CalibrationCommand struct {
+	ID      string `json:"id"`
+	Command string `json:"command"`
+}
+
+// CalibrationPlateau is mean of samples collected at single plateau, Raw is in order of Targets
+type CalibrationPlateau struct {
+	Reference float64   `json:"reference"`
+	Raw       []float64 `json:"raw"`
+	Samples   uint      `json:"samples"`
+	Stamp     time.Time `json:"stamp"`
+}
+
+// CalibrationPreview is calibration computed for target, MaxError is the largest error at plateaus
+type CalibrationPreview struct {
+	Target      CalibrationSensor       `json:"target"`
+	Calibration calibration.Calibration `json:"calibration"`
+	MaxError    float64                 `json:"max_error"`
+	Error       string                  `json:"error"`
+}
+
+// CalibrationStatus reports progress of session, Fault is error of last plateau
+type CalibrationStatus struct {
+	ID       string               `json:"id"`
+	State    string               `json:"state"`
+	Plateaus []CalibrationPlateau `json:"plateaus"`
+	Preview  []CalibrationPreview `json:"preview"`
+	Fault    string               `json:"fault"`
+}
+
+// CalibrationRecord is entry of calibration history of Sensor
+type CalibrationRecord struct {
+	Sensor      CalibrationSensor       `json:"sensor"`
+	Reference   CalibrationSensor       `json:"reference"`
+	Calibration calibration.Calibration `json:"calibration"`
+	Plateaus    []CalibrationPlateau    `json:"plateaus"`
+	MaxError    float64                 `json:"max_error"`
+}
+
+// calibrationBackup keeps calibration of target from before session
+type calibrationBackup struct {
+	calibration calibration.Calibration
+	correction  float64
+}
+
+type calibrationSession struct {
+	cfg       CalibrationConfig
+	status    CalibrationStatus
+	previous  []calibrationBackup
+	stop, fin chan struct{}
+	mtx       sync.Mutex
+	// cmd serializes commands
+	cmd sync.Mutex
+}
+
+// CalibrationHandler calibrates sensors against reference sensor.
+// Targets are read without calibration during session, so their readings can be paired with reference.
+type CalibrationHandler struct {
+	ds       *DSHandler
+	pt       *PTHandler
+	path     string
+	sessions map[string]*calibrationSession
+	history  []CalibrationRecord
+	mtx      sync.Mutex
+	storeMtx sync.Mutex
+}
+
+// Create starts session and returns config with defaults filled, calibration of targets is cleared until session ends
+func (c *CalibrationHandler) Create(cfg CalibrationConfig) (CalibrationConfig, error) {
+	if err := c.verify(&cfg); err != nil {
+		return CalibrationConfig{}, &CalibrationError{ID: cfg.ID, Op: "Create.verify", Err: err.Error()}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.sessions[cfg.ID]; ok {
+		return CalibrationConfig{}, &CalibrationError{ID: cfg.ID, Op: "Create", Err: ErrIDAlreadyExists.Error()}
+	}
+	for _, s := range c.sessions {
+		for _, used := range s.cfg.sensors() {
+			for _, sensor := range cfg.sensors() {
+				// Reference may be shared, it is only read
+				if used == sensor && (used != s.cfg.Reference || sensor != cfg.Reference) {
+					return CalibrationConfig{}, &CalibrationError{ID: cfg.ID, Op: "Create", Err: ErrSensorCalibrated.Error()}
+				}
+			}
+		}
+	}
+
+	session := &calibrationSession{
+		cfg:      cfg,
+		status:   CalibrationStatus{ID: cfg.ID, State: CalibrationIdle},
+		previous: make([]calibrationBackup, 0, len(cfg.Targets)),
+	}
+	for _, target := range cfg.Targets {
+		backup, err := c.calibrationOf(target)
+		if err == nil {
+			err = c.setCalibration(target, calibrationBackup{})
+		}
+		if err != nil {
+			c.restore(session)
+			return CalibrationConfig{}, &CalibrationError{ID: cfg.ID, Op: "Create.setCalibration", Err: err.Error()}
+		}
+		session.previous = append(session.previous, backup)
+	}
+	if c.sessions == nil {
+		c.sessions = make(map[string]*calibrationSession)
+	}
+	c.sessions[cfg.ID] = session
+	logger.Debug("New calibration", logging.String("ID", cfg.ID))
+	return cfg, nil
+}
+
+// Command marks plateau, applies or cancels session
+func (c *CalibrationHandler) Command(id, command string) error {
+	session, err := c.sessionBy(id)
+	if err != nil {
+		return &CalibrationError{ID: id, Op: "Command.sessionBy", Err: err.Error()}
+	}
+
+	session.cmd.Lock()
+	defer session.cmd.Unlock()
+	switch command {
+	case CalibrationSample:
+		err = c.plateau(session)
+	case CalibrationApply:
+		err = c.apply(session)
+	case CalibrationCancel:
+		c.cancel(session)
+	default:
+		err = ErrUnknownCommand
+	}
+	if err != nil {
+		return &CalibrationError{ID: id, Op: "Command." + command, Err: err.Error()}
+	}
+	return nil
+}
+
+// StatusBy returns status of session with specified id, including preview of calibration
+func (c *CalibrationHandler) StatusBy(id string) (CalibrationStatus, error) {
+	session, err := c.sessionBy(id)
+	if err != nil {
+		return CalibrationStatus{}, &CalibrationError{ID: id, Op: "StatusBy.sessionBy", Err: err.Error()}
+	}
+	return session.snapshot(), nil
+}
+
+// Status returns status of all sessions
+func (c *CalibrationHandler) Status() []CalibrationStatus {
+	c.mtx.Lock()
+	sessions := make([]*calibrationSession, 0, len(c.sessions))
+	for _, s := range c.sessions {
+		sessions = append(sessions, s)
+	}
+	c.mtx.Unlock()
+
+	status := make([]CalibrationStatus, 0, len(sessions))
+	for _, s := range sessions {
+		status = append(status, s.snapshot())
+	}
+	return status
+}
+
+// History returns applied calibrations, oldest first
+func (c *CalibrationHandler) History() []CalibrationRecord {
+	c.storeMtx.Lock()
+	defer c.storeMtx.Unlock()
+	return append([]CalibrationRecord(nil), c.history...)
+}
+
+// HistoryBy returns applied calibrations of sensor with specified id, oldest first
+func (c *CalibrationHandler) HistoryBy(id string) []CalibrationRecord {
+	var records []CalibrationRecord
+	for _, record := range c.History() {
+		if record.Sensor.ID == id {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (c *CalibrationHandler) sessionBy(id string) (*calibrationSession, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	s, ok := c.sessions[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return s, nil
+}
+
+// remove ends session
+func (c *CalibrationHandler) remove(id string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.sessions, id)
+}
+
+// sensors returns reference and targets in new slice
+func (cfg *CalibrationConfig) sensors() []CalibrationSensor {
+	return append(append(make([]CalibrationSensor, 0, len(cfg.Targets)+1), cfg.Targets...), cfg.Reference)
+}
+
+// verify checks, whether sensors exist and fills defaults
+func (c *CalibrationHandler) verify(cfg *CalibrationConfig) error {
+	if len(cfg.Targets) == 0 {
+		return ErrNoTargets
+	}
+	for _, s := range cfg.sensors() {
+		if _, err := c.calibrationOf(s); err != nil {
+			return err
+		}
+	}
+	for _, target := range cfg.Targets {
+		if target == cfg.Reference {
+			return ErrReferenceIsTarget
+		}
+	}
+	if cfg.Model == calibration.None {
+		cfg.Model = calibration.TwoPoint
+	}
+	if _, err := calibration.Fit(cfg.Model, nil, cfg.Degree); errors.Is(err, calibration.ErrUnknownModel) || errors.Is(err, calibration.ErrDegree) {
+		return err
+	}
+	if cfg.Samples == 0 {
+		cfg.Samples = calibrationDefaultSamples
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = calibrationDefaultInterval
+	}
+	return nil
+}
+
+// plateau starts polling of sensors and collecting samples in background
+func (c *CalibrationHandler) plateau(s *calibrationSession) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.status.State == CalibrationSampling {
+		return ErrCalibrationSampling
+	}
+	for _, sensor := range s.cfg.sensors() {
+		if err := enableSensor(c.ds, c.pt, sensor.SensorType, sensor.ID); err != nil {
+			return err
+		}
+	}
+	s.status.State, s.status.Fault = CalibrationSampling, ""
+	s.stop, s.fin = make(chan struct{}), make(chan struct{})
+	go c.sample(s, s.stop, s.fin)
+	return nil
+}
+
+// sample takes Samples of polled readings every Interval, then adds mean as plateau.
+// Each sample needs new reading of every sensor, readings from before plateau are not used.
+func (c *CalibrationHandler) sample(s *calibrationSession, stop, fin chan struct{}) {
+	defer close(fin)
+	cfg := s.cfg
+	plateau := CalibrationPlateau{Raw: make([]float64, len(cfg.Targets))}
+	// Reference is first, then targets
+	sensors := append([]CalibrationSensor{cfg.Reference}, cfg.Targets...)
+	stamps := make([]time.Time, len(sensors))
+	for i := range stamps {
+		stamps[i] = time.Now()
+	}
+	values := make([]float64, len(sensors))
+	latest := make([]time.Time, len(sensors))
+	var err error
+	for plateau.Samples < cfg.Samples && err == nil {
+		select {
+		case <-stop:
+			return
+		case <-time.After(cfg.Interval):
+		}
+		fresh := true
+		for i, sensor := range sensors {
+			if values[i], latest[i], err = c.read(sensor, i == 0); err != nil {
+				break
+			}
+			fresh = fresh && latest[i].After(stamps[i])
+		}
+		if err != nil || !fresh {
+			continue
+		}
+		copy(stamps, latest)
+		plateau.Reference += values[0]
+		for i := range plateau.Raw {
+			plateau.Raw[i] += values[i+1]
+		}
+		plateau.Samples++
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.status.State = CalibrationIdle
+	if err != nil {
+		logger.Error("Calibration plateau", logging.String("ID", cfg.ID), logging.String("error", err.Error()))
+		s.status.Fault = err.Error()
+		return
+	}
+	n := float64(plateau.Samples)
+	plateau.Reference /= n
+	for i := range plateau.Raw {
+		plateau.Raw[i] /= n
+	}
+	plateau.Stamp = time.Now()
+	s.status.Plateaus = append(s.status.Plateaus, plateau)
+}
+
+// apply configures targets with previewed calibration, history is recorded for each target
+func (c *CalibrationHandler) apply(s *calibrationSession) error {
+	status := s.snapshot()
+	if status.State == CalibrationSampling {
+		return ErrCalibrationSampling
+	}
+	for _, preview := range status.Preview {
+		if preview.Error != "" {
+			return errors.New(preview.Error)
+		}
+	}
+
+	var errs []string
+	records := make([]CalibrationRecord, 0, len(status.Preview))
+	for _, preview := range status.Preview {
+		if err := c.setCalibration(preview.Target, calibrationBackup{calibration: preview.Calibration}); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		// Stamp is set by sensor
+		applied, err := c.calibrationOf(preview.Target)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		records = append(records, CalibrationRecord{
+			Sensor:      preview.Target,
+			Reference:   s.cfg.Reference,
+			Calibration: applied.calibration,
+			Plateaus:    status.Plateaus,
+			MaxError:    preview.MaxError,
+		})
+	}
+	c.record(records)
+	c.remove(s.cfg.ID)
+	if errs != nil {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// cancel stops sampling, restores targets and ends session
+func (c *CalibrationHandler) cancel(s *calibrationSession) {
+	c.halt(s)
+	c.restore(s)
+	c.remove(s.cfg.ID)
+}
+
+// halt stops sampling, if it is in progress
+func (c *CalibrationHandler) halt(s *calibrationSession) {
+	s.mtx.Lock()
+	stop, fin := s.stop, s.fin
+	sampling := s.status.State == CalibrationSampling
+	s.mtx.Unlock()
+	if !sampling {
+		return
+	}
+	close(stop)
+	<-fin
+}
+
+// restore sets calibration of targets from before session
+func (c *CalibrationHandler) restore(s *calibrationSession) {
+	for i, backup := range s.previous {
+		if err := c.setCalibration(s.cfg.Targets[i], backup); err != nil {
+			logger.Error("Calibration restore", logging.String("ID", s.cfg.Targets[i].ID), logging.String("error", err.Error()))
+		}
+	}
+}
+
+// snapshot returns status with preview computed from collected plateaus
+func (s *calibrationSession) snapshot() CalibrationStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	status := s.status
+	status.Plateaus = append([]CalibrationPlateau(nil), s.status.Plateaus...)
+	status.Preview = make([]CalibrationPreview, len(s.cfg.Targets))
+	for i, target := range s.cfg.Targets {
+		points := make([]calibration.Point, len(status.Plateaus))
+		for j, plateau := range status.Plateaus {
+			points[j] = calibration.Point{Raw: plateau.Raw[i], Reference: plateau.Reference}
+		}
+		preview := CalibrationPreview{Target: target}
+		fit, err := calibration.Fit(s.cfg.Model, points, s.cfg.Degree)
+		if err != nil {
+			preview.Error = err.Error()
+		} else {
+			preview.Calibration, preview.MaxError = fit, fit.MaxError(points)
+		}
+		status.Preview[i] = preview
+	}
+	return status
+}
+
+// read returns last polled temperature of sensor and its stamp, so sensor isn't converted nor averaged again.
+// Reference is read as reported to user, targets are read without correction.
+func (c *CalibrationHandler) read(s CalibrationSensor, reference bool) (float64, time.Time, error) {
+	switch s.SensorType {
+	case SensorDS18B20:
+		// DS18B20 adds Correction to actual temperature, targets have it cleared
+		sensor, err := c.ds.sensorBy(s.ID)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if !sensor.enabled() {
+			return 0, time.Time{}, ErrSensorNotEnabled
+		}
+		r := sensor.Latest()
+		if r.Error != "" {
+			return 0, r.Stamp, errors.New(r.Error)
+		}
+		return r.Temperature, r.Stamp, nil
+	case SensorPT100:
+		sensor, err := c.pt.sensorBy(s.ID)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if !sensor.enabled() {
+			return 0, time.Time{}, ErrSensorNotEnabled
+		}
+		r := sensor.Latest()
+		if r.Error != "" {
+			return 0, r.Stamp, errors.New(r.Error)
+		}
+		if reference {
+			r.Temperature += sensor.GetConfig().Correction
+		}
+		return r.Temperature, r.Stamp, nil
+	}
+	return 0, time.Time{}, ErrUnknownSensorType
+}
+
+func (c *CalibrationHandler) calibrationOf(s CalibrationSensor) (calibrationBackup, error) {
+	switch s.SensorType {
+	case SensorDS18B20:
+		cfg, err := c.ds.GetConfig(s.ID)
+		return calibrationBackup{calibration: cfg.Calibration, correction: cfg.Correction}, err
+	case SensorPT100:
+		cfg, err := c.pt.GetConfig(s.ID)
+		return calibrationBackup{calibration: cfg.Calibration, correction: cfg.Correction}, err
+	}
+	return calibrationBackup{}, ErrUnknownSensorType
+}
+
+func (c *CalibrationHandler) setCalibration(s CalibrationSensor, b calibrationBackup) error {
+	switch s.SensorType {
+	case SensorDS18B20:
+		cfg, err := c.ds.GetConfig(s.ID)
+		if err != nil {
+			return err
+		}
+		cfg.Calibration, cfg.Correction, cfg.Persist = b.calibration, b.correction, false
+		_, err = c.ds.SetConfig(cfg)
+		return err
+	case SensorPT100:
+		cfg, err := c.pt.GetConfig(s.ID)
+		if err != nil {
+			return err
+		}
+		cfg.Calibration, cfg.Correction = b.calibration, b.correction
+		_, err = c.pt.SetConfig(cfg)
+		return err
+	}
+	return ErrUnknownSensorType
+}
+
+// record appends records to history and writes it to file, if path was set
+func (c *CalibrationHandler) record(records []CalibrationRecord) {
+	c.storeMtx.Lock()
+	defer c.storeMtx.Unlock()
+	c.history = append(c.history, records...)
+	if c.path == "" {
+		return
+	}
+	if err := writeJSON(c.path, c.history); err != nil {
+		logger.Error("Calibration save", logging.String("path", c.path), logging.String("error", err.Error()))
+	}
+}
+
+// load reads history from file, missing file is not an error
+func (c *CalibrationHandler) load() error {
+	buf, err := os.ReadFile(c.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	c.storeMtx.Lock()
+	defer c.storeMtx.Unlock()
+	return json.Unmarshal(buf, &c.history)
+}
+
+// Open loads history from file
+func (c *CalibrationHandler) Open() {
+	if c.path == "" {
+		return
+	}
+	if err := c.load(); err != nil {
+		logger.Error("Calibration load", logging.String("path", c.path), logging.String("error", err.Error()))
+	}
+}
+
+// Close cancels sessions, so targets get back their calibration
+func (c *CalibrationHandler) Close() {
+	c.mtx.Lock()
+	sessions := make([]*calibrationSession, 0, len(c.sessions))
+	for _, s := range c.sessions {
+		sessions = append(sessions, s)
+	}
+	c.mtx.Unlock()
+	for _, s := range sessions {
+		s.cmd.Lock()
+		c.cancel(s)
+		s.cmd.Unlock()
+	}
+}
@@ -0,0 +1,387 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/calibration"
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/max31865"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// CalibrationTestSuite uses real sensors: DS18B20 read 21.5, PT100 reads close to 0
+type CalibrationTestSuite struct {
+	suite.Suite
+}
+
+func TestCalibrationTestSuite(t *testing.T) {
+	suite.Run(t, new(CalibrationTestSuite))
+}
+
+func (t *CalibrationTestSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (t *CalibrationTestSuite) handler(options ...embedded.Option) *embedded.Rest {
+	r := t.Require()
+	var ds []embedded.DSSensor
+	for _, id := range []string{"28-1", "28-2", "28-3"} {
+		s, err := ds18b20.NewSensor(fakeOnewire{}, id, "w1")
+		r.Nil(err)
+		// Samples are taken from polled readings
+		cfg := s.GetConfig()
+		cfg.PollInterval = time.Millisecond
+		r.Nil(s.Configure(cfg))
+		ds = append(ds, s)
+	}
+	pt, err := max31865.NewSensor(max31865.WithReadWriteCloser(fakeSPI{}), max31865.WithRefRes(400.0), max31865.WithID("pt"))
+	r.Nil(err)
+	ptCfg := pt.GetConfig()
+	ptCfg.PollInterval = time.Millisecond
+	r.Nil(pt.Configure(ptCfg))
+
+	options = append([]embedded.Option{embedded.WithDS18B20(ds), embedded.WithPT([]embedded.PTSensor{pt})}, options...)
+	h, err := embedded.NewRest("", options...)
+	r.Nil(err)
+	return h
+}
+
+func (t *CalibrationTestSuite) config() embedded.CalibrationConfig {
+	return embedded.CalibrationConfig{
+		ID:        "calibration",
+		Reference: embedded.CalibrationSensor{ID: "28-1", SensorType: embedded.SensorDS18B20},
+		Targets:   []embedded.CalibrationSensor{{ID: "28-2", SensorType: embedded.SensorDS18B20}},
+		Model:     calibration.Offset,
+		Samples:   3,
+		Interval:  time.Millisecond,
+	}
+}
+
+// sample marks plateau and waits until it is collected
+func (t *CalibrationTestSuite) sample(h *embedded.Rest, id string) embedded.CalibrationStatus {
+	plateaus := 0
+	if status, err := h.Calibration.StatusBy(id); err == nil {
+		plateaus = len(status.Plateaus)
+	}
+	t.Require().Nil(h.Calibration.Command(id, embedded.CalibrationSample))
+	var status embedded.CalibrationStatus
+	t.Require().Eventually(func() bool {
+		status, _ = h.Calibration.StatusBy(id)
+		return status.State == embedded.CalibrationIdle
+	}, time.Second, time.Millisecond)
+	t.Require().Empty(status.Fault)
+	t.Require().Len(status.Plateaus, plateaus+1)
+	return status
+}
+
+func (t *CalibrationTestSuite) TestCreate_Verify() {
+	h := t.handler()
+	defer h.Close()
+
+	args := []struct {
+		name string
+		cfg  func(cfg *embedded.CalibrationConfig)
+		err  error
+	}{
+		{
+			name: "no targets",
+			cfg:  func(cfg *embedded.CalibrationConfig) { cfg.Targets = nil },
+			err:  embedded.ErrNoTargets,
+		},
+		{
+			name: "unknown sensor type",
+			cfg:  func(cfg *embedded.CalibrationConfig) { cfg.Reference.SensorType = "k-type" },
+			err:  embedded.ErrUnknownSensorType,
+		},
+		{
+			name: "unknown target",
+			cfg:  func(cfg *embedded.CalibrationConfig) { cfg.Targets[0].ID = "28-4" },
+			err:  embedded.ErrNoSuchID,
+		},
+		{
+			name: "reference is target",
+			cfg:  func(cfg *embedded.CalibrationConfig) { cfg.Targets = append(cfg.Targets, cfg.Reference) },
+			err:  embedded.ErrReferenceIsTarget,
+		},
+		{
+			name: "unknown model",
+			cfg:  func(cfg *embedded.CalibrationConfig) { cfg.Model = "spline" },
+			err:  calibration.ErrUnknownModel,
+		},
+		{
+			name: "wrong degree",
+			cfg: func(cfg *embedded.CalibrationConfig) {
+				cfg.Model, cfg.Degree = calibration.Polynomial, -1
+			},
+			err: calibration.ErrDegree,
+		},
+	}
+	for _, arg := range args {
+		cfg := t.config()
+		arg.cfg(&cfg)
+		_, err := h.Calibration.Create(cfg)
+		t.ErrorContains(err, arg.err.Error(), arg.name)
+	}
+
+	// Spare capacity of Targets belongs to caller
+	targets := make([]embedded.CalibrationSensor, 1, 2)
+	targets[0] = embedded.CalibrationSensor{ID: "28-1", SensorType: embedded.SensorDS18B20}
+	created, err := h.Calibration.Create(embedded.CalibrationConfig{
+		ID:        "calibration",
+		Reference: embedded.CalibrationSensor{ID: "pt", SensorType: embedded.SensorPT100},
+		Targets:   targets,
+	})
+	t.Nil(err)
+	t.Zero(targets[:2][1])
+	t.Equal(calibration.TwoPoint, created.Model)
+	t.EqualValues(10, created.Samples)
+	t.Equal(time.Second, created.Interval)
+
+	_, err = h.Calibration.Create(created)
+	t.ErrorContains(err, embedded.ErrIDAlreadyExists.Error())
+
+	// Target can't take part in another calibration
+	cfg := t.config()
+	cfg.ID = "other"
+	_, err = h.Calibration.Create(cfg)
+	t.ErrorContains(err, embedded.ErrSensorCalibrated.Error())
+
+	// Reference can't be calibrated, while it is used by another calibration
+	cfg.Reference = embedded.CalibrationSensor{ID: "28-2", SensorType: embedded.SensorDS18B20}
+	cfg.Targets = []embedded.CalibrationSensor{{ID: "pt", SensorType: embedded.SensorPT100}}
+	_, err = h.Calibration.Create(cfg)
+	t.ErrorContains(err, embedded.ErrSensorCalibrated.Error())
+
+	// But it can be shared as reference
+	cfg.Reference = embedded.CalibrationSensor{ID: "pt", SensorType: embedded.SensorPT100}
+	cfg.Targets = []embedded.CalibrationSensor{{ID: "28-3", SensorType: embedded.SensorDS18B20}}
+	_, err = h.Calibration.Create(cfg)
+	t.Nil(err)
+
+	t.ErrorContains(h.Calibration.Command(created.ID, "start"), embedded.ErrUnknownCommand.Error())
+	t.ErrorContains(h.Calibration.Command("unknown", embedded.CalibrationApply), embedded.ErrNoSuchID.Error())
+}
+
+func (t *CalibrationTestSuite) TestApply() {
+	r := t.Require()
+	path := filepath.Join(t.T().TempDir(), "calibration.json")
+	h := t.handler(embedded.WithCalibrationHistory(path))
+
+	// Reference reads 22.5, target has previous calibration
+	ref, err := h.DS.GetConfig("28-1")
+	r.Nil(err)
+	ref.Correction = 1
+	_, err = h.DS.SetConfig(ref)
+	r.Nil(err)
+	target, err := h.DS.GetConfig("28-2")
+	r.Nil(err)
+	target.Correction = 2
+	target.Calibration = calibration.Calibration{Model: calibration.Offset, Offset: -1.5}
+	_, err = h.DS.SetConfig(target)
+	r.Nil(err)
+
+	cfg := t.config()
+	cfg.Targets = append(cfg.Targets, embedded.CalibrationSensor{ID: "28-3", SensorType: embedded.SensorDS18B20})
+	_, err = h.Calibration.Create(cfg)
+	r.Nil(err)
+
+	// Target is read raw during calibration
+	during, err := h.DS.GetConfig("28-2")
+	r.Nil(err)
+	r.Equal(calibration.None, during.Calibration.Model)
+	r.Zero(during.Correction)
+
+	// Preview isn't available without plateau
+	status, err := h.Calibration.StatusBy(cfg.ID)
+	r.Nil(err)
+	r.Len(status.Preview, 2)
+	r.Contains(status.Preview[0].Error, calibration.ErrNotEnoughPoints.Error())
+	r.ErrorContains(h.Calibration.Command(cfg.ID, embedded.CalibrationApply), calibration.ErrNotEnoughPoints.Error())
+
+	status = t.sample(h, cfg.ID)
+	r.InDelta(22.5, status.Plateaus[0].Reference, 1e-9)
+	r.InDeltaSlice([]float64{21.5, 21.5}, status.Plateaus[0].Raw, 1e-9)
+	r.EqualValues(3, status.Plateaus[0].Samples)
+	for _, preview := range status.Preview {
+		r.Empty(preview.Error)
+		r.Equal(calibration.Offset, preview.Calibration.Model)
+		r.InDelta(1.0, preview.Calibration.Offset, 1e-9)
+		r.InDelta(0.0, preview.MaxError, 1e-9)
+	}
+
+	r.Nil(h.Calibration.Command(cfg.ID, embedded.CalibrationApply))
+	r.Empty(h.Calibration.Status())
+	applied, err := h.DS.GetConfig("28-2")
+	r.Nil(err)
+	r.Equal(calibration.Offset, applied.Calibration.Model)
+	r.InDelta(1.0, applied.Calibration.Offset, 1e-9)
+	r.Zero(applied.Correction)
+	actual, _, err := h.DS.Temperature(applied.SensorConfig)
+	r.Nil(err)
+	r.InDelta(22.5, actual, 1e-9)
+
+	// History is kept per sensor
+	history := h.Calibration.HistoryBy("28-2")
+	r.Len(history, 1)
+	r.Equal(cfg.Reference, history[0].Reference)
+	r.True(applied.Calibration.Equal(history[0].Calibration))
+	r.Equal(applied.Calibration.Stamp, history[0].Calibration.Stamp)
+	r.Len(history[0].Plateaus, 1)
+	r.Len(h.Calibration.History(), 2)
+	r.Empty(h.Calibration.HistoryBy("28-1"))
+
+	// History survives restart
+	h.Close()
+	_, err = os.Stat(path)
+	r.Nil(err)
+	h = t.handler(embedded.WithCalibrationHistory(path))
+	defer h.Close()
+	history = h.Calibration.HistoryBy("28-2")
+	r.Len(history, 1)
+	r.True(history[0].Calibration.Stamp.Equal(applied.Calibration.Stamp))
+}
+
+func (t *CalibrationTestSuite) TestSample_PolledReadings() {
+	r := t.Require()
+	now := time.Now()
+	var sensors []embedded.DSSensor
+	for id, temperatures := range map[string][]float64{"ref": {99, 22, 23}, "target": {99, 21, 21}} {
+		m := new(DS18B20SensorMock)
+		m.On("ID").Return(id)
+		m.On("GetConfig").Return(ds18b20.SensorConfig{ID: id})
+		m.On("Configure", mock.Anything).Return(nil)
+		m.On("Poll").Return()
+		m.On("Close").Return()
+		// First reading is older than plateau, last one isn't updated anymore
+		stamps := []time.Time{now.Add(-time.Hour), now.Add(time.Hour), now.Add(2 * time.Hour)}
+		for i, temperature := range temperatures {
+			m.On("Latest").Return(ds18b20.Readings{ID: id, Temperature: temperature, Stamp: stamps[i]}).Once()
+		}
+		m.On("Latest").Return(ds18b20.Readings{ID: id, Temperature: 99, Stamp: stamps[2]})
+		sensors = append(sensors, m)
+	}
+	h, err := embedded.NewRest("", embedded.WithDS18B20(sensors))
+	r.Nil(err)
+	defer h.Close()
+
+	cfg := embedded.CalibrationConfig{
+		ID:        "calibration",
+		Reference: embedded.CalibrationSensor{ID: "ref", SensorType: embedded.SensorDS18B20},
+		Targets:   []embedded.CalibrationSensor{{ID: "target", SensorType: embedded.SensorDS18B20}},
+		Model:     calibration.Offset,
+		Samples:   2,
+		Interval:  time.Millisecond,
+	}
+	_, err = h.Calibration.Create(cfg)
+	r.Nil(err)
+	status := t.sample(h, cfg.ID)
+	r.EqualValues(2, status.Plateaus[0].Samples)
+	r.InDelta(22.5, status.Plateaus[0].Reference, 1e-9)
+	r.InDeltaSlice([]float64{21}, status.Plateaus[0].Raw, 1e-9)
+
+	// Sensors are started by session, but they aren't converted nor averaged by it
+	for _, sensor := range sensors {
+		m := sensor.(*DS18B20SensorMock)
+		m.AssertCalled(t.T(), "Poll")
+		m.AssertNotCalled(t.T(), "Temperature")
+		m.AssertNotCalled(t.T(), "Average")
+	}
+}
+
+func (t *CalibrationTestSuite) TestCancel() {
+	r := t.Require()
+	h := t.handler()
+	defer h.Close()
+
+	target, err := h.DS.GetConfig("28-2")
+	r.Nil(err)
+	target.Correction = 2
+	target.Calibration = calibration.Calibration{Model: calibration.Offset, Offset: -1.5}
+	target, err = h.DS.SetConfig(target)
+	r.Nil(err)
+
+	// Second sample is taken after an hour, so session keeps sampling
+	cfg := t.config()
+	cfg.Interval = time.Hour
+	_, err = h.Calibration.Create(cfg)
+	r.Nil(err)
+	r.Nil(h.Calibration.Command(cfg.ID, embedded.CalibrationSample))
+	status, err := h.Calibration.StatusBy(cfg.ID)
+	r.Nil(err)
+	r.Equal(embedded.CalibrationSampling, status.State)
+	r.ErrorContains(h.Calibration.Command(cfg.ID, embedded.CalibrationSample), embedded.ErrCalibrationSampling.Error())
+	r.ErrorContains(h.Calibration.Command(cfg.ID, embedded.CalibrationApply), embedded.ErrCalibrationSampling.Error())
+
+	r.Nil(h.Calibration.Command(cfg.ID, embedded.CalibrationCancel))
+	r.Empty(h.Calibration.Status())
+	restored, err := h.DS.GetConfig("28-2")
+	r.Nil(err)
+	r.True(target.Calibration.Equal(restored.Calibration))
+	r.Equal(target.Calibration.Stamp, restored.Calibration.Stamp)
+	r.InDelta(2.0, restored.Correction, 1e-9)
+	r.Empty(h.Calibration.History())
+}
+
+func (t *CalibrationTestSuite) TestRestAPI_PTReference() {
+	r := t.Require()
+	h := t.handler()
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewCalibrationClient(srv.URL, time.Second)
+
+	pt, err := h.PT.GetConfig("pt")
+	r.Nil(err)
+	pt.Correction = 0.5
+	_, err = h.PT.SetConfig(pt)
+	r.Nil(err)
+	// Same registers are read by sensor of handler
+	probe, err := max31865.NewSensor(max31865.WithReadWriteCloser(fakeSPI{}), max31865.WithRefRes(400.0), max31865.WithID("probe"))
+	r.Nil(err)
+	reference, _, err := probe.Temperature()
+	r.Nil(err)
+	reference += pt.Correction
+
+	cfg := t.config()
+	cfg.Reference = embedded.CalibrationSensor{ID: "pt", SensorType: embedded.SensorPT100}
+	created, err := client.Create(cfg)
+	r.Nil(err)
+	r.Equal(cfg, created)
+
+	_, err = client.Create(cfg)
+	r.ErrorContains(err, embedded.ErrIDAlreadyExists.Error())
+
+	_, err = client.Command(cfg.ID, embedded.CalibrationSample)
+	r.Nil(err)
+	var status []embedded.CalibrationStatus
+	r.Eventually(func() bool {
+		status, err = client.Status()
+		return err == nil && len(status) == 1 && len(status[0].Plateaus) == 1
+	}, time.Second, time.Millisecond)
+	r.InDelta(reference, status[0].Plateaus[0].Reference, 1e-9)
+	r.InDelta(reference-21.5, status[0].Preview[0].Calibration.Offset, 1e-9)
+
+	_, err = client.Command(cfg.ID, embedded.CalibrationApply)
+	r.Nil(err)
+	history, err := client.History()
+	r.Nil(err)
+	r.Len(history, 1)
+	r.Equal(cfg.Reference, history[0].Reference)
+	r.InDelta(reference-21.5, history[0].Calibration.Offset, 1e-9)
+
+	_, err = client.Command(cfg.ID, embedded.CalibrationApply)
+	r.ErrorContains(err, embedded.ErrNoSuchID.Error())
+}
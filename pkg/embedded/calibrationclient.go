@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type CalibrationClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewCalibrationClient(addr string, timeout time.Duration) *CalibrationClient {
+	return &CalibrationClient{addr: addr, timeout: timeout}
+}
+
+func (c *CalibrationClient) Create(cfg CalibrationConfig) (CalibrationConfig, error) {
+	return restclient.Post[CalibrationConfig, *Error](c.addr+RoutesCreateCalibration, c.timeout, cfg)
+}
+
+func (c *CalibrationClient) Command(id, command string) (CalibrationCommand, error) {
+	return restclient.Put[CalibrationCommand, *Error](c.addr+RoutesCommandCalibration, c.timeout, CalibrationCommand{ID: id, Command: command})
+}
+
+func (c *CalibrationClient) Status() ([]CalibrationStatus, error) {
+	return restclient.Get[[]CalibrationStatus, *Error](c.addr+RoutesGetCalibrations, c.timeout)
+}
+
+func (c *CalibrationClient) History() ([]CalibrationRecord, error) {
+	return restclient.Get[[]CalibrationRecord, *Error](c.addr+RoutesGetCalibrationHistory, c.timeout)
+}
+
+type CalibrationRPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.CalibratorClient
+}
+
+func NewCalibrationRPCClient(addr string, timeout time.Duration) (*CalibrationRPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &CalibrationRPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewCalibratorClient(conn)}, nil
+}
+
+func (g *CalibrationRPCClient) Create(cfg CalibrationConfig) (CalibrationConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CalibrationCreate(ctx, calibrationConfigToRPC(&cfg))
+	if err != nil {
+		return CalibrationConfig{}, err
+	}
+	return rpcToCalibrationConfig(got), nil
+}
+
+func (g *CalibrationRPCClient) Command(id, command string) (CalibrationCommand, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CalibrationExecute(ctx, &embeddedproto.CalibrationCommand{ID: id, Command: command})
+	if err != nil {
+		return CalibrationCommand{}, err
+	}
+	return CalibrationCommand{ID: got.ID, Command: got.Command}, nil
+}
+
+func (g *CalibrationRPCClient) Status() ([]CalibrationStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CalibrationGetStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	status := make([]CalibrationStatus, len(got.Status))
+	for i, elem := range got.Status {
+		status[i] = rpcToCalibrationStatus(elem)
+	}
+	return status, nil
+}
+
+func (g *CalibrationRPCClient) History() ([]CalibrationRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CalibrationGetHistory(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]CalibrationRecord, len(got.Records))
+	for i, elem := range got.Records {
+		records[i] = rpcToCalibrationRecord(elem)
+	}
+	return records, nil
+}
+
+func (g *CalibrationRPCClient) Close() {
+	_ = g.conn.Close()
+}
@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/gpio"
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/a-clap/embedded/pkg/max31865"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// ConcurrentSuite uses real devices, so race detector sees their state
+type ConcurrentSuite struct {
+	suite.Suite
+}
+
+// fakeHeating accepts every state of output
+type fakeHeating struct{}
+
+// fakeOnewire returns 21.5 degrees for each sensor
+type fakeOnewire struct{}
+
+// fakeSPI returns registers of MAX31865 measuring 0 degrees
+type fakeSPI struct{}
+
+const (
+	concurrentClients  = 8
+	concurrentRequests = 20
+)
+
+func TestConcurrentSuite(t *testing.T) {
+	suite.Run(t, new(ConcurrentSuite))
+}
+
+func (t *ConcurrentSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (t *ConcurrentSuite) TestRestAPI_ConcurrentClients() {
+	r := t.Require()
+
+	heaters := make(map[string]embedded.Heater)
+	for _, id := range []string{"first", "second"} {
+		h, err := heater.New(heater.WithHeating(fakeHeating{}), heater.WitTimeTicker(), heater.WithRampRate(100))
+		r.Nil(err)
+		heaters[id] = h
+	}
+
+	var ds []embedded.DSSensor
+	for _, id := range []string{"28-1", "28-2"} {
+		s, err := ds18b20.NewSensor(fakeOnewire{}, id, "w1")
+		r.Nil(err)
+		ds = append(ds, s)
+	}
+
+	pt, err := max31865.NewSensor(max31865.WithReadWriteCloser(fakeSPI{}), max31865.WithRefRes(400.0), max31865.WithID("pt"))
+	r.Nil(err)
+
+	gp := new(GPIOMock)
+	gp.On("ID").Return("gpio")
+	gp.On("GetConfig").Return(gpio.Config{ID: "gpio"}, nil)
+	gp.On("Configure", mock.Anything).Return(nil)
+
+	h, err := embedded.NewRest("",
+		embedded.WithHeaters(heaters),
+		embedded.WithDS18B20(ds),
+		embedded.WithPT([]embedded.PTSensor{pt}),
+		embedded.WithGPIOs([]embedded.GPIO{gp}))
+	r.Nil(err)
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	// Each client changes all devices, gin serves them concurrently
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentClients*concurrentRequests*8)
+	for i := 0; i < concurrentClients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			hc := embedded.NewHeaterClient(srv.URL, time.Second)
+			dc := embedded.NewDS18B20Client(srv.URL, time.Second)
+			pc := embedded.NewPTClient(srv.URL, time.Second)
+			gc := embedded.NewGPIOClient(srv.URL, time.Second)
+			for j := 0; j < concurrentRequests; j++ {
+				enabled := (i+j)%2 == 0
+				report := func(_ any, err error) {
+					if err != nil {
+						errs <- err
+					}
+				}
+				report(hc.Configure(embedded.HeaterConfig{
+					ID:      []string{"first", "second"}[j%2],
+					Enabled: enabled,
+					Power:   uint(i * j % 101),
+				}))
+				report(hc.Get())
+				report(dc.Configure(embedded.DSSensorConfig{
+					Enabled: enabled,
+					SensorConfig: ds18b20.SensorConfig{
+						ID:           []string{"28-1", "28-2"}[j%2],
+						Name:         strconv.Itoa(i),
+						Resolution:   ds18b20.Resolution11Bit,
+						PollInterval: time.Duration(j%3+1) * time.Millisecond,
+						Samples:      uint(j%4 + 1),
+					},
+				}))
+				report(dc.Temperatures())
+				report(pc.Configure(embedded.PTSensorConfig{
+					Enabled: enabled,
+					SensorConfig: max31865.SensorConfig{
+						ID:           "pt",
+						Name:         strconv.Itoa(i),
+						PollInterval: time.Duration(j%3+1) * time.Millisecond,
+						Samples:      uint(j%4 + 1),
+					},
+				}))
+				report(pc.Temperatures())
+				report(gc.Configure(embedded.GPIOConfig{Config: gpio.Config{ID: "gpio"}}))
+				report(gc.Get())
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		r.Nil(err)
+	}
+
+	// After all, last write wins and reads are consistent with it
+	hc := embedded.NewHeaterClient(srv.URL, time.Second)
+	cfg, err := hc.Configure(embedded.HeaterConfig{ID: "first", Enabled: false, Power: 42})
+	r.Nil(err)
+	r.False(cfg.Enabled)
+	r.EqualValues(42, cfg.Power)
+	r.Zero(cfg.EffectivePower)
+
+	dc := embedded.NewDS18B20Client(srv.URL, time.Second)
+	dsCfg := embedded.DSSensorConfig{
+		Enabled: false,
+		SensorConfig: ds18b20.SensorConfig{
+			ID:           "28-1",
+			Name:         "final",
+			Resolution:   ds18b20.Resolution12Bit,
+			PollInterval: time.Millisecond,
+			Samples:      1,
+		},
+	}
+	got, err := dc.Configure(dsCfg)
+	r.Nil(err)
+	r.Equal(dsCfg, got)
+
+	for _, h := range heaters {
+		h.Disable()
+	}
+	_, err = embedded.NewPTClient(srv.URL, time.Second).Configure(embedded.PTSensorConfig{SensorConfig: max31865.SensorConfig{ID: "pt"}})
+	r.Nil(err)
+	_, err = dc.Configure(embedded.DSSensorConfig{SensorConfig: ds18b20.SensorConfig{ID: "28-2", Resolution: ds18b20.Resolution11Bit}})
+	r.Nil(err)
+}
+
+func (t *ConcurrentSuite) TestHeaterOwner_Concurrent() {
+	r := t.Require()
+	heat, err := heater.New(heater.WithHeating(fakeHeating{}), heater.WitTimeTicker(), heater.WithRampRate(100))
+	r.Nil(err)
+	ds, err := ds18b20.NewSensor(fakeOnewire{}, "28-1", "w1")
+	r.Nil(err)
+	h, err := embedded.NewRest("", embedded.WithHeaters(map[string]embedded.Heater{"heater": heat}), embedded.WithDS18B20([]embedded.DSSensor{ds}))
+	r.Nil(err)
+	defer h.Close()
+
+	owners := []string{"first", "second"}
+	for _, id := range owners {
+		_, err := h.PID.Create(embedded.PIDConfig{ID: id, HeaterID: "heater", SensorID: "28-1", SensorType: embedded.SensorDS18B20, Interval: time.Millisecond})
+		r.Nil(err)
+		_, err = h.Program.Create(embedded.ProgramConfig{ID: id, HeaterID: "heater", SensorID: "28-1", SensorType: embedded.SensorDS18B20,
+			Interval: time.Millisecond, Steps: []embedded.ProgramStep{{Type: embedded.ProgramStepPower, Power: 10, Hold: time.Hour}}})
+		r.Nil(err)
+	}
+
+	// Programs and PIDs race for one heater, exactly one of them gets it
+	for i := 0; i < concurrentRequests; i++ {
+		var wg sync.WaitGroup
+		var mtx sync.Mutex
+		var pids, programs []string
+		for _, id := range owners {
+			wg.Add(2)
+			go func(id string) {
+				defer wg.Done()
+				if h.PID.Enable(id, true) == nil {
+					mtx.Lock()
+					pids = append(pids, id)
+					mtx.Unlock()
+				}
+			}(id)
+			go func(id string) {
+				defer wg.Done()
+				if h.Program.Command(id, embedded.ProgramStart) == nil {
+					mtx.Lock()
+					programs = append(programs, id)
+					mtx.Unlock()
+				}
+			}(id)
+		}
+		wg.Wait()
+		r.Len(append(pids, programs...), 1)
+
+		for _, id := range pids {
+			r.Nil(h.PID.Enable(id, false))
+		}
+		for _, id := range programs {
+			r.Nil(h.Program.Command(id, embedded.ProgramAbort))
+		}
+	}
+}
+
+func (fakeHeating) Open() error {
+	return nil
+}
+
+func (fakeHeating) Set(bool) error {
+	return nil
+}
+
+func (fakeOnewire) ReadFile(name string) ([]byte, error) {
+	if path.Base(name) == "resolution" {
+		return []byte("11"), nil
+	}
+	return []byte("21500"), nil
+}
+
+func (fakeOnewire) WriteFile(string, []byte) error {
+	return nil
+}
+
+func (fakeSPI) ReadWrite(write []byte) ([]byte, error) {
+	regs := []byte{0x0, 0xd1, 0x40, 0x00, 0xFF, 0xFF, 0x0, 0x0, 0x0}
+	read := make([]byte, len(write))
+	copy(read, regs)
+	return read, nil
+}
+
+func (fakeSPI) Close() error {
+	return nil
+}
@@ -6,24 +6,56 @@
 package embedded
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
 	"github.com/a-clap/embedded/pkg/ds18b20"
 	"github.com/a-clap/embedded/pkg/gpio"
 	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/a-clap/embedded/pkg/iio"
+	"github.com/a-clap/embedded/pkg/max31856"
 	"github.com/a-clap/embedded/pkg/max31865"
 	"github.com/a-clap/logging"
 )
 
 type Config struct {
-	Heaters []ConfigHeater  `mapstructure:"heaters"`
-	DS18B20 []ConfigDS18B20 `mapstructure:"ds18b20"`
-	PT100   []ConfigPT100   `mapstructure:"pt_100"`
-	GPIO    []ConfigGPIO    `mapstructure:"gpio"`
+	Heaters      []ConfigHeater       `mapstructure:"heaters"`
+	HeaterGroups []ConfigHeaterGroup  `mapstructure:"heater_groups"`
+	DS18B20      []ConfigDS18B20      `mapstructure:"ds18b20"`
+	PT100        []ConfigPT100        `mapstructure:"pt_100"`
+	Thermocouple []ConfigThermocouple `mapstructure:"thermocouple"`
+	IIO          []ConfigIIO          `mapstructure:"iio"`
+	Counters     []ConfigCounter      `mapstructure:"counters"`
+	GPIO         []ConfigGPIO         `mapstructure:"gpio"`
+	// ProgramsFile keeps uploaded programs and their progress, optional
+	ProgramsFile string `mapstructure:"programs_file"`
+	// DS18B20InventoryFile keeps IDs of sensors found on buses, optional
+	DS18B20InventoryFile string `mapstructure:"ds18b20_inventory_file"`
+	// CalibrationHistoryFile keeps calibrations applied by guided calibration, optional
+	CalibrationHistoryFile string `mapstructure:"calibration_history_file"`
 }
 
 type ConfigHeater struct {
-	ID          string           `mapstructure:"hardware_id"`
-	Pin         gpio.Pin         `mapstructure:"gpio_pin"`
-	ActiveLevel gpio.ActiveLevel `mapstructure:"active_level"`
+	ID          string                  `mapstructure:"hardware_id"`
+	Pin         gpio.Pin                `mapstructure:"gpio_pin"`
+	ActiveLevel gpio.ActiveLevel        `mapstructure:"active_level"`
+	Modulation  heater.ModulationConfig `mapstructure:"modulation"`
+	// ZeroCrossPin is optional, heaters on the same pin share one detector
+	ZeroCrossPin gpio.Pin `mapstructure:"zero_cross_pin"`
+	// MaxFailures disables heater after that many consecutive failures, 0 means never
+	MaxFailures uint `mapstructure:"max_failures"`
+	// RatedWatts is power of heater at 100 %, optional - used to report energy
+	RatedWatts float64 `mapstructure:"rated_watts"`
+	// RampRate limits increase of power in %/s to avoid inrush current, 0 means no limit
+	RampRate float64 `mapstructure:"ramp_rate"`
+}
+
+// ConfigHeaterGroup limits total power of heaters, order of Heaters is priority
+type ConfigHeaterGroup struct {
+	ID                 string   `mapstructure:"id"`
+	Heaters            []string `mapstructure:"heaters"`
+	heater.GroupConfig `mapstructure:",squash"`
 }
 
 type ConfigDS18B20 struct {
@@ -32,6 +64,14 @@ type ConfigDS18B20 struct {
 	PollTimeMillis uint               `mapstructure:"poll_time_millis"`
 	Resolution     ds18b20.Resolution `mapstructure:"resolution"`
 	Samples        uint               `mapstructure:"samples"`
+	// RescanMillis is period of looking for new and vanished sensors, 0 means only at start
+	RescanMillis uint `mapstructure:"rescan_millis"`
+	// ReadMode is "temperature" (default) or "w1_slave", which checks CRC and works on older kernels
+	ReadMode ds18b20.ReadMode `mapstructure:"read_mode"`
+	// BulkRead converts temperature on all sensors at once, every PollTimeMillis
+	BulkRead bool `mapstructure:"bulk_read"`
+	// Alarms enables TH and TL registers of sensors (alarm_low and alarm_high)
+	Alarms bool `mapstructure:"alarms"`
 }
 
 type ConfigPT100 struct {
@@ -41,6 +81,59 @@ type ConfigPT100 struct {
 	RRef     float64         `mapstructure:"r_ref"`
 	Wiring   max31865.Wiring `mapstructure:"wiring"`
 	ReadyPin gpio.Pin        `mapstructure:"ready_pin"`
+	// RTD selects built-in curve (pt100, pt1000, ni100, ni120), CVD with non-zero A overrides it with custom coefficients
+	RTD            max31865.RTD `mapstructure:"rtd"`
+	CVD            max31865.CVD `mapstructure:"cvd"`
+	LeadResistance float64      `mapstructure:"lead_resistance"`
+	// Filter is mains frequency (50 or 60), Mode is continuous or one_shot
+	Filter max31865.Filter `mapstructure:"filter"`
+	Mode   max31865.Mode   `mapstructure:"mode"`
+	// FaultLow and FaultHigh are fault thresholds in Celsius, disabled when equal
+	FaultLow  float64 `mapstructure:"fault_low"`
+	FaultHigh float64 `mapstructure:"fault_high"`
+	// FaultDetectMillis is period of automatic fault detection cycle, 0 disables it
+	FaultDetectMillis uint `mapstructure:"fault_detect_millis"`
+}
+
+type ConfigThermocouple struct {
+	Path string `mapstructure:"path"`
+	Name string `mapstructure:"name"`
+	// Type is thermocouple type (B, E, J, K, N, R, S, T), K by default
+	Type max31856.Type `mapstructure:"type"`
+	// Filter is mains frequency (50 or 60), Averaging is number of samples averaged by Max (1, 2, 4, 8, 16)
+	Filter    max31856.Filter `mapstructure:"filter"`
+	Averaging uint            `mapstructure:"averaging"`
+	// ReadyPin is optional, it is needed for a_sync_poll
+	ReadyPin gpio.Pin `mapstructure:"ready_pin"`
+}
+
+// ConfigIIO is voltage channel of IIO device, e.g. ADS1115
+type ConfigIIO struct {
+	// Path is directory of device, e.g. /sys/bus/iio/devices/iio:device0
+	Path    string `mapstructure:"path"`
+	Channel uint   `mapstructure:"channel"`
+	// ID is optional, by default it is device:voltageX
+	ID             string `mapstructure:"id"`
+	Name           string `mapstructure:"name"`
+	PollTimeMillis uint   `mapstructure:"poll_time_millis"`
+	Samples        uint   `mapstructure:"samples"`
+	// Value = Gain * Voltage + Offset, in Unit. Gain 0 means volts are reported
+	Gain   float64 `mapstructure:"gain"`
+	Offset float64 `mapstructure:"offset"`
+	Unit   string  `mapstructure:"unit"`
+}
+
+// ConfigCounter is pulse counter on gpio, e.g. hall-effect flow meter
+type ConfigCounter struct {
+	ID   string   `mapstructure:"id"`
+	Name string   `mapstructure:"name"`
+	Pin  gpio.Pin `mapstructure:"pin"`
+	// Edge is rising (default), falling or both
+	Edge counter.Edge `mapstructure:"edge"`
+	// PulsesPerLitre is K-factor of flow meter, 450 by default
+	PulsesPerLitre float64 `mapstructure:"pulses_per_litre"`
+	// WindowMillis is period, over which frequency and flow are calculated, 1000 by default
+	WindowMillis uint `mapstructure:"window_millis"`
 }
 
 type ConfigGPIO struct {
@@ -51,15 +144,37 @@ type ConfigGPIO struct {
 	Value       bool             `mapstructure:"value"`
 }
 
-func parseHeaters(config []ConfigHeater) (Option, []error) {
-	logger.Debug("parseHeaters", logging.Reflect("ConfigHeater", config))
+func parseHeaters(config []ConfigHeater, groupsConfig []ConfigHeaterGroup) ([]Option, []error) {
+	logger.Debug("parseHeaters", logging.Reflect("ConfigHeater", config), logging.Reflect("ConfigHeaterGroup", groupsConfig))
 
 	heaters := make(map[string]Heater, len(config))
+	created := make(map[string]*heater.Heater, len(config))
+	zeroCrosses := make(map[gpio.Pin]*heater.ZeroCross)
 	var errs []error
 	for _, maybeHeater := range config {
+		ticker := heater.WitTimeTicker()
+		if maybeHeater.ZeroCrossPin.Chip != "" {
+			z, ok := zeroCrosses[maybeHeater.ZeroCrossPin]
+			if !ok {
+				var err error
+				z, err = heater.NewGpioZeroCross(maybeHeater.ZeroCrossPin, maybeHeater.ID+"_zero_cross")
+				if err != nil {
+					logger.Error("failed to create ZeroCross ", logging.Reflect("config", maybeHeater), logging.String("error", err.Error()))
+					errs = append(errs, err)
+					continue
+				}
+				zeroCrosses[maybeHeater.ZeroCrossPin] = z
+			}
+			ticker = heater.WithZeroCrossTicker(z)
+		}
+
 		h, err := heater.New(
 			heater.WithGpioHeating(maybeHeater.Pin, maybeHeater.ID, maybeHeater.ActiveLevel),
-			heater.WitTimeTicker(),
+			ticker,
+			heater.WithModulation(maybeHeater.Modulation),
+			heater.WithMaxFailures(maybeHeater.MaxFailures),
+			heater.WithRatedWatts(maybeHeater.RatedWatts),
+			heater.WithRampRate(maybeHeater.RampRate),
 		)
 		if err != nil {
 			logger.Error("failed to create Heater ", logging.Reflect("config", maybeHeater), logging.String("error", err.Error()))
@@ -67,43 +182,89 @@ func parseHeaters(config []ConfigHeater) (Option, []error) {
 			continue
 		}
 		heaters[maybeHeater.ID] = h
+		created[maybeHeater.ID] = h
 	}
-	return WithHeaters(heaters), errs
+
+	groups := make(map[string]HeaterGroup, len(groupsConfig))
+	for _, groupConfig := range groupsConfig {
+		g, err := heater.NewGroup(groupConfig.GroupConfig)
+		if err != nil {
+			logger.Error("failed to create HeaterGroup ", logging.Reflect("config", groupConfig), logging.String("error", err.Error()))
+			errs = append(errs, err)
+			continue
+		}
+		for _, id := range groupConfig.Heaters {
+			h, ok := created[id]
+			if !ok {
+				err = fmt.Errorf("parseHeaters {Group: %v, Heater: %v}: %w", groupConfig.ID, id, ErrNoSuchID)
+			} else {
+				err = g.Add(id, h)
+			}
+			if err != nil {
+				logger.Error("failed to add Heater to HeaterGroup ", logging.String("group", groupConfig.ID), logging.String("error", err.Error()))
+				errs = append(errs, err)
+			}
+		}
+		groups[groupConfig.ID] = g
+	}
+	return []Option{WithHeaters(heaters), WithHeaterGroups(groups)}, errs
 }
 
-func parseDS18B20(config []ConfigDS18B20) (Option, []error) {
+func parseDS18B20(config []ConfigDS18B20) ([]Option, []error) {
 	logger.Debug("parseDS18B20", logging.Reflect("ConfigDS18B20", config))
 
-	sensors := make([]DSSensor, 0, len(config))
+	opts := make([]Option, 0, len(config))
 	var errs []error
+	var switches []GPIO
 	for _, busConfig := range config {
-		bus, err := ds18b20.NewBus(ds18b20.WithOnewireOnPath(busConfig.Path))
-		if err != nil {
+		mode := busConfig.ReadMode
+		if mode == "" {
+			mode = ds18b20.ReadTemperature
+		}
+		if mode != ds18b20.ReadTemperature && mode != ds18b20.ReadW1Slave {
+			err := fmt.Errorf("parseDS18B20 {Path: %v, ReadMode: %v}: %w", busConfig.Path, mode, ds18b20.ErrUnknownReadMode)
 			logger.Error("failed to create DSBus ", logging.String("path", busConfig.Path), logging.String("error", err.Error()))
 			errs = append(errs, err)
 			continue
 		}
-
-		discovered, discoverErrs := bus.Discover()
-		if discoverErrs != nil {
-			discErrs := make([]logging.Field, len(discoverErrs)+1)
-			discErrs[0] = logging.String("path", busConfig.Path)
-			for i, err := range discoverErrs {
-				discErrs[i+1] = logging.String("error", err.Error())
+		sensorOpts := []ds18b20.SensorOption{ds18b20.WithReadMode(mode)}
+		if busConfig.Alarms {
+			sensorOpts = append(sensorOpts, ds18b20.WithAlarms())
+		}
+		busOpts := []ds18b20.BusOption{ds18b20.WithOnewireOnPath(busConfig.Path), ds18b20.WithSensorOptions(sensorOpts...)}
+		if busConfig.BulkRead {
+			if busConfig.PollTimeMillis == 0 {
+				err := fmt.Errorf("parseDS18B20 {Path: %v}: %w", busConfig.Path, ErrBulkReadInterval)
+				logger.Error("failed to create DSBus ", logging.String("path", busConfig.Path), logging.String("error", err.Error()))
+				errs = append(errs, err)
+				continue
 			}
-			logger.Error("error on discover", discErrs...)
-			errs = append(errs, discoverErrs...)
+			busOpts = append(busOpts, ds18b20.WithBulkRead(time.Duration(busConfig.PollTimeMillis)*time.Millisecond))
+		}
+		bus, err := ds18b20.NewBus(busOpts...)
+		if err != nil {
+			logger.Error("failed to create DSBus ", logging.String("path", busConfig.Path), logging.String("error", err.Error()))
+			errs = append(errs, err)
 			continue
 		}
-		if len(discovered) == 0 {
-			logger.Debug("Not found any sensors ", logging.String("path", busConfig.Path))
+		// Sensors are discovered by DSHandler on Open and then on each rescan.
+		// Path is used as name of bus, as it is unique
+		rescan := time.Duration(busConfig.RescanMillis) * time.Millisecond
+		opts = append(opts, WithDS18B20Bus(busConfig.Path, onewireBus{Bus: bus, alarms: busConfig.Alarms}, rescan))
+
+		// DS2413 are exposed by GPIOHandler, they are looked for only once
+		found, switchErrs := bus.Switches()
+		for _, err := range switchErrs {
+			logger.Error("failed to create DS2413 ", logging.String("path", busConfig.Path), logging.String("error", err.Error()))
 		}
-		for _, s := range discovered {
-			logger.Debug("New DSSensor", logging.String("ID", s.ID()))
-			sensors = append(sensors, s)
+		for _, sw := range found {
+			switches = append(switches, NewDS2413GPIOs(sw)...)
 		}
 	}
-	return WithDS18B20(sensors), errs
+	if len(switches) > 0 {
+		opts = append(opts, WithGPIOs(switches))
+	}
+	return opts, errs
 }
 
 func parsePT100(config []ConfigPT100) (Option, []error) {
@@ -112,7 +273,7 @@ func parsePT100(config []ConfigPT100) (Option, []error) {
 	pts := make([]PTSensor, 0, len(config))
 	var errs []error
 	for _, cfg := range config {
-		s, err := max31865.NewSensor(
+		opts := []max31865.Option{
 			max31865.WithSpidev(cfg.Path),
 			max31865.WithID(cfg.Path),
 			max31865.WithName(cfg.Name),
@@ -120,7 +281,21 @@ func parsePT100(config []ConfigPT100) (Option, []error) {
 			max31865.WithRefRes(cfg.RRef),
 			max31865.WithWiring(cfg.Wiring),
 			max31865.WithReadyPin(cfg.ReadyPin, cfg.Path),
-		)
+			max31865.WithFilter(cfg.Filter),
+			max31865.WithMode(cfg.Mode),
+			max31865.WithFaultThresholds(cfg.FaultLow, cfg.FaultHigh),
+			max31865.WithFaultDetectInterval(time.Duration(cfg.FaultDetectMillis) * time.Millisecond),
+			max31865.WithLeadResistance(cfg.LeadResistance),
+		}
+		if cfg.CVD.A != 0 {
+			if cfg.CVD.R0 == 0 {
+				cfg.CVD.R0 = cfg.RNominal
+			}
+			opts = append(opts, max31865.WithConversion(cfg.CVD))
+		} else if cfg.RTD != "" {
+			opts = append(opts, max31865.WithRTD(cfg.RTD))
+		}
+		s, err := max31865.NewSensor(opts...)
 
 		if err != nil {
 			logger.Error("failed to create PT100", logging.Reflect("config", cfg), logging.String("error", err.Error()))
@@ -134,6 +309,101 @@ func parsePT100(config []ConfigPT100) (Option, []error) {
 	return WithPT(pts), errs
 }
 
+func parseThermocouple(config []ConfigThermocouple) (Option, []error) {
+	logger.Debug("parseThermocouple", logging.Reflect("ConfigThermocouple", config))
+
+	tcs := make([]TCSensor, 0, len(config))
+	var errs []error
+	for _, cfg := range config {
+		opts := []max31856.Option{
+			max31856.WithSpidev(cfg.Path),
+			max31856.WithID(cfg.Path),
+			max31856.WithName(cfg.Name),
+			max31856.WithType(cfg.Type),
+			max31856.WithFilter(cfg.Filter),
+			max31856.WithAveraging(cfg.Averaging),
+		}
+		if cfg.ReadyPin.Chip != "" {
+			opts = append(opts, max31856.WithReadyPin(cfg.ReadyPin, cfg.Path))
+		}
+		s, err := max31856.NewSensor(opts...)
+		if err != nil {
+			logger.Error("failed to create thermocouple", logging.Reflect("config", cfg), logging.String("error", err.Error()))
+			errs = append(errs, err)
+			continue
+		}
+
+		tcs = append(tcs, s)
+	}
+
+	return WithTC(tcs), errs
+}
+
+func parseIIO(config []ConfigIIO) (Option, []error) {
+	logger.Debug("parseIIO", logging.Reflect("ConfigIIO", config))
+
+	sensors := make([]IIOSensor, 0, len(config))
+	var errs []error
+	for _, cfg := range config {
+		var opts []iio.Option
+		if cfg.ID != "" {
+			opts = append(opts, iio.WithID(cfg.ID))
+		}
+		if cfg.Name != "" {
+			opts = append(opts, iio.WithName(cfg.Name))
+		}
+		if cfg.PollTimeMillis != 0 {
+			opts = append(opts, iio.WithPollInterval(time.Duration(cfg.PollTimeMillis)*time.Millisecond))
+		}
+		if cfg.Samples != 0 {
+			opts = append(opts, iio.WithSamples(cfg.Samples))
+		}
+		if cfg.Gain != 0 {
+			opts = append(opts, iio.WithScaling(iio.Scaling{Gain: cfg.Gain, Offset: cfg.Offset, Unit: cfg.Unit}))
+		}
+		s, err := iio.NewSensor(iio.Sysfs(), cfg.Path, cfg.Channel, opts...)
+		if err != nil {
+			logger.Error("failed to create IIO sensor", logging.Reflect("config", cfg), logging.String("error", err.Error()))
+			errs = append(errs, err)
+			continue
+		}
+
+		sensors = append(sensors, s)
+	}
+
+	return WithIIO(sensors), errs
+}
+
+func parseCounters(config []ConfigCounter) (Option, []error) {
+	logger.Debug("parseCounters", logging.Reflect("ConfigCounter", config))
+
+	counters := make([]PulseCounter, 0, len(config))
+	var errs []error
+	for _, cfg := range config {
+		opts := []counter.Option{
+			counter.WithID(cfg.ID),
+			counter.WithName(cfg.Name),
+			counter.WithGpio(cfg.Pin, cfg.Edge),
+		}
+		if cfg.PulsesPerLitre != 0 {
+			opts = append(opts, counter.WithPulsesPerLitre(cfg.PulsesPerLitre))
+		}
+		if cfg.WindowMillis != 0 {
+			opts = append(opts, counter.WithWindow(time.Duration(cfg.WindowMillis)*time.Millisecond))
+		}
+		c, err := counter.New(opts...)
+		if err != nil {
+			logger.Error("failed to create counter", logging.Reflect("config", cfg), logging.String("error", err.Error()))
+			errs = append(errs, err)
+			continue
+		}
+
+		counters = append(counters, c)
+	}
+
+	return WithCounters(counters), errs
+}
+
 func parseGPIO(config []ConfigGPIO) (Option, []error) {
 	logger.Debug("parseGPIO", logging.Reflect("ConfigGPIO", config))
 
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"github.com/a-clap/embedded/pkg/counter"
+)
+
+// PulseCounter counts pulses of flow meter
+type PulseCounter interface {
+	ID() string
+	Configure(config counter.Config) error
+	GetConfig() counter.Config
+	Status() counter.Status
+	Reset()
+	Close() error
+}
+
+type CounterError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *CounterError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+// CounterHandler is responsible for handling pulse counters
+type CounterHandler struct {
+	counters map[string]PulseCounter
+}
+
+// Get returns configs of all counters
+func (c *CounterHandler) Get() []counter.Config {
+	configs := make([]counter.Config, 0, len(c.counters))
+	for _, cnt := range c.counters {
+		configs = append(configs, cnt.GetConfig())
+	}
+	return configs
+}
+
+func (c *CounterHandler) SetConfig(cfg counter.Config) (counter.Config, error) {
+	cnt, err := c.by(cfg.ID)
+	if err != nil {
+		return counter.Config{}, &CounterError{ID: cfg.ID, Op: "SetConfig.by", Err: err.Error()}
+	}
+	if err := cnt.Configure(cfg); err != nil {
+		return counter.Config{}, &CounterError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
+	}
+	return cnt.GetConfig(), nil
+}
+
+func (c *CounterHandler) GetConfig(id string) (counter.Config, error) {
+	cnt, err := c.by(id)
+	if err != nil {
+		return counter.Config{}, &CounterError{ID: id, Op: "GetConfig.by", Err: err.Error()}
+	}
+	return cnt.GetConfig(), nil
+}
+
+// Status returns count, frequency, flow and totalizer of all counters
+func (c *CounterHandler) Status() []counter.Status {
+	status := make([]counter.Status, 0, len(c.counters))
+	for _, cnt := range c.counters {
+		status = append(status, cnt.Status())
+	}
+	return status
+}
+
+// Reset starts new totalizer period, count since start is kept
+func (c *CounterHandler) Reset(id string) (counter.Status, error) {
+	cnt, err := c.by(id)
+	if err != nil {
+		return counter.Status{}, &CounterError{ID: id, Op: "Reset.by", Err: err.Error()}
+	}
+	cnt.Reset()
+	return cnt.Status(), nil
+}
+
+func (c *CounterHandler) by(id string) (PulseCounter, error) {
+	cnt, ok := c.counters[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return cnt, nil
+}
+
+func (c *CounterHandler) Open() {
+}
+
+func (c *CounterHandler) Close() []error {
+	var errs []error
+	for id, cnt := range c.counters {
+		if err := cnt.Close(); err != nil {
+			errs = append(errs, &CounterError{ID: id, Op: "Close", Err: err.Error()})
+		}
+	}
+	return errs
+}
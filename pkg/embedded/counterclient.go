@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type CounterClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewCounterClient(addr string, timeout time.Duration) *CounterClient {
+	return &CounterClient{addr: addr, timeout: timeout}
+}
+
+func (c *CounterClient) Get() ([]counter.Config, error) {
+	return restclient.Get[[]counter.Config, *Error](c.addr+RoutesGetCounters, c.timeout)
+}
+
+func (c *CounterClient) Configure(setConfig counter.Config) (counter.Config, error) {
+	return restclient.Put[counter.Config, *Error](c.addr+RoutesConfigCounter, c.timeout, setConfig)
+}
+
+func (c *CounterClient) Status() ([]counter.Status, error) {
+	return restclient.Get[[]counter.Status, *Error](c.addr+RoutesGetCounterStatus, c.timeout)
+}
+
+func (c *CounterClient) Reset(id string) (counter.Status, error) {
+	return restclient.Put[counter.Status, *Error](c.addr+RoutesResetCounter, c.timeout, counter.Status{ID: id})
+}
+
+type CounterRPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.CounterClient
+}
+
+func NewCounterRPCClient(addr string, timeout time.Duration) (*CounterRPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &CounterRPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewCounterClient(conn)}, nil
+}
+
+func (g *CounterRPCClient) Get() ([]counter.Config, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CounterGet(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	confs := make([]counter.Config, len(got.Configs))
+	for i, elem := range got.Configs {
+		confs[i] = rpcToCounterConfig(elem)
+	}
+	return confs, nil
+}
+
+func (g *CounterRPCClient) Configure(setConfig counter.Config) (counter.Config, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	set := counterConfigToRPC(&setConfig)
+	got, err := g.client.CounterConfigure(ctx, set)
+	if err != nil {
+		return counter.Config{}, err
+	}
+	return rpcToCounterConfig(got), nil
+}
+
+func (g *CounterRPCClient) Status() ([]counter.Status, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CounterGetStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	status := make([]counter.Status, len(got.Status))
+	for i, elem := range got.Status {
+		status[i] = rpcToCounterStatus(elem)
+	}
+	return status, nil
+}
+
+func (g *CounterRPCClient) Reset(id string) (counter.Status, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.CounterReset(ctx, &embeddedproto.CounterID{ID: id})
+	if err != nil {
+		return counter.Status{}, err
+	}
+	return rpcToCounterStatus(got), nil
+}
+
+func (g *CounterRPCClient) Close() {
+	_ = g.conn.Close()
+}
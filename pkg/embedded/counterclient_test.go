@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CounterClientSuite struct {
+	suite.Suite
+}
+
+// CounterMock implements embedded.PulseCounter
+type CounterMock struct {
+	mock.Mock
+}
+
+func TestCounterClient(t *testing.T) {
+	suite.Run(t, new(CounterClientSuite))
+}
+
+func (p *CounterClientSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (p *CounterClientSuite) Test_Status() {
+	t := p.Require()
+	started := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	args := []counter.Status{
+		{
+			ID:              "water",
+			Started:         started,
+			Count:           900,
+			LastPulse:       started.Add(time.Minute),
+			Frequency:       7.5,
+			Flow:            1,
+			Reset:           started.Add(30 * time.Second),
+			CountSinceReset: 450,
+			Total:           1,
+		},
+		{
+			ID:      "coolant",
+			Started: started,
+		},
+	}
+
+	var counters []embedded.PulseCounter
+	for _, elem := range args {
+		m := new(CounterMock)
+		m.On("ID").Return(elem.ID)
+		m.On("Status").Return(elem)
+		counters = append(counters, m)
+	}
+
+	h, _ := embedded.NewRest("", embedded.WithCounters(counters))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewCounterClient(srv.URL, 1*time.Second)
+	s, err := client.Status()
+	t.Nil(err)
+	t.ElementsMatch(args, s)
+}
+
+func (p *CounterClientSuite) Test_Configure() {
+	t := p.Require()
+	cfg := counter.Config{
+		ID:             "water",
+		Name:           "inlet",
+		PulsesPerLitre: 450,
+		Window:         time.Second,
+	}
+
+	m := new(CounterMock)
+	m.On("ID").Return(cfg.ID)
+	m.On("GetConfig").Return(cfg).Once()
+
+	h, _ := embedded.NewRest("", embedded.WithCounters([]embedded.PulseCounter{m}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewCounterClient(srv.URL, 1*time.Second)
+	s, err := client.Get()
+	t.Nil(err)
+	t.Equal([]counter.Config{cfg}, s)
+
+	// Expected error - counter doesn't exist
+	_, err = client.Configure(counter.Config{})
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesConfigCounter)
+
+	errSet := errors.New("hello world")
+	m.On("Configure", mock.Anything).Return(errSet).Once()
+	_, err = client.Configure(cfg)
+	t.ErrorContains(err, errSet.Error())
+
+	// All good now
+	cfg.PulsesPerLitre = 98
+	cfg.Window = 5 * time.Second
+	m.On("Configure", cfg).Return(nil).Once()
+	m.On("GetConfig").Return(cfg).Once()
+	got, err := client.Configure(cfg)
+	t.Nil(err)
+	t.Equal(cfg, got)
+}
+
+func (p *CounterClientSuite) Test_Reset() {
+	t := p.Require()
+	status := counter.Status{
+		ID:              "water",
+		Count:           900,
+		Reset:           time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC),
+		CountSinceReset: 0,
+		Total:           0,
+	}
+
+	m := new(CounterMock)
+	m.On("ID").Return(status.ID)
+
+	h, _ := embedded.NewRest("", embedded.WithCounters([]embedded.PulseCounter{m}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewCounterClient(srv.URL, 1*time.Second)
+
+	// Expected error - counter doesn't exist
+	_, err := client.Reset("coolant")
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesResetCounter)
+
+	m.On("Reset").Return().Once()
+	m.On("Status").Return(status).Once()
+	got, err := client.Reset(status.ID)
+	t.Nil(err)
+	t.Equal(status, got)
+	m.AssertExpectations(p.T())
+}
+
+func (p *CounterClientSuite) Test_NotImplemented() {
+	t := p.Require()
+	h, _ := embedded.NewRest("")
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewCounterClient(srv.URL, 1*time.Second)
+
+	s, err := client.Get()
+	t.Nil(s)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetCounters)
+
+	_, err = client.Configure(counter.Config{})
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesConfigCounter)
+
+	status, err := client.Status()
+	t.Nil(status)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetCounterStatus)
+}
+
+func (m *CounterMock) ID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *CounterMock) Configure(config counter.Config) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
+func (m *CounterMock) GetConfig() counter.Config {
+	args := m.Called()
+	return args.Get(0).(counter.Config)
+}
+
+func (m *CounterMock) Status() counter.Status {
+	args := m.Called()
+	return args.Get(0).(counter.Status)
+}
+
+func (m *CounterMock) Reset() {
+	m.Called()
+}
+
+func (m *CounterMock) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -6,6 +6,8 @@
 package embedded
 
 import (
+	"sync"
+
 	"github.com/a-clap/embedded/pkg/ds18b20"
 )
 
@@ -32,14 +34,21 @@ type DSSensor interface {
 	Poll()
 	Temperature() (actual, average float64, err error)
 	GetReadings() []ds18b20.Readings
+	Latest() ds18b20.Readings
 	Average() float64
 	Configure(config ds18b20.SensorConfig) error
 	GetConfig() ds18b20.SensorConfig
+	Save() error
+	Restore() error
 	Close()
 }
 
 type DSSensorConfig struct {
 	Enabled bool `json:"enabled"`
+	// Missing is read-only, config of missing sensor is applied when it returns
+	Missing bool `json:"missing"`
+	// Persist saves resolution and alarms in EEPROM of sensor, after config is applied
+	Persist bool `json:"persist"`
 	ds18b20.SensorConfig
 }
 
@@ -47,24 +56,52 @@ type DSTemperature struct {
 	Readings []ds18b20.Readings `json:"readings"`
 }
 
+// dsSensor serializes changes of DSSensor, mtx protects cfg.
+// DSSensor is nil, if sensor was expected on bus, but it wasn't seen yet.
 type dsSensor struct {
 	DSSensor
+	mtx sync.Mutex
 	cfg DSSensorConfig
+	bus string
+	// pending means cfg was set, while sensor was missing
+	pending bool
 }
 
+// DSHandler handles sensors provided with WithDS18B20 and sensors found on buses.
+// mtx protects sensors, which may be changed by rescans, and events.
 type DSHandler struct {
-	sensors map[string]*dsSensor
+	mtx       sync.Mutex
+	sensors   map[string]*dsSensor
+	buses     []*dsBus
+	inventory string
+	expected  map[string]string
+	events    []DSEvent
+	done      chan struct{}
+	wg        sync.WaitGroup
 }
 
+// GetTemperatures returns collected readings of each sensor, sensors on bus with bulk read are returned as one batch per conversion
 func (d *DSHandler) GetTemperatures() []DSTemperature {
-	sensors := make([]DSTemperature, 0, len(d.sensors))
+	all := d.all()
+	sensors := make([]DSTemperature, 0, len(all))
 	
-	for _, s := range d.sensors {
+	for _, s := range all {
+		if s.isMissing() || d.bulkBus(s.bus) {
+			continue
+		}
 		tmp := DSTemperature{
 			Readings: s.GetReadings(),
 		}
 		sensors = append(sensors, tmp)
 	}
+	// Single batch of bulk read keeps readings of all sensors on bus
+	for _, b := range d.buses {
+		if bb, ok := b.bulk(); ok {
+			for _, batch := range bb.GetReadings() {
+				sensors = append(sensors, DSTemperature{Readings: batch.Readings})
+			}
+		}
+	}
 	
 	return sensors
 }
@@ -73,6 +110,9 @@ func (d *DSHandler) Temperature(cfg ds18b20.SensorConfig) (float64, float64, err
 	if err != nil {
 		return 0, 0, &DSError{ID: cfg.ID, Op: "Temperature", Err: err.Error()}
 	}
+	if ds.isMissing() {
+		return 0, 0, &DSError{ID: cfg.ID, Op: "Temperature", Err: ErrSensorMissing.Error()}
+	}
 	actual, average, err := ds.Temperature()
 	if err != nil {
 		err = &DSError{ID: cfg.ID, Op: "Temperature", Err: err.Error()}
@@ -86,11 +126,25 @@ func (d *DSHandler) SetConfig(cfg DSSensorConfig) (newConfig DSSensorConfig, err
 		err = &DSError{ID: cfg.ID, Op: "SetConfig.sensoryBy", Err: err.Error()}
 		return
 	}
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+	
+	if ds.missing() {
+		// Hardware isn't there, config is applied on return
+		ds.cfg.Enabled, ds.cfg.Persist, ds.cfg.SensorConfig, ds.pending = cfg.Enabled, cfg.Persist, cfg.SensorConfig, true
+		return ds.cfg, nil
+	}
 	
 	if err = ds.Configure(cfg.SensorConfig); err != nil {
 		err = &DSError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
 		return
 	}
+	if cfg.Persist {
+		if err = ds.Save(); err != nil {
+			err = &DSError{ID: cfg.ID, Op: "SetConfig.Save", Err: err.Error()}
+			return
+		}
+	}
 	
 	if cfg.Enabled != ds.cfg.Enabled {
 		if cfg.Enabled {
@@ -101,7 +155,24 @@ func (d *DSHandler) SetConfig(cfg DSSensorConfig) (newConfig DSSensorConfig, err
 	}
 	ds.cfg.Enabled = cfg.Enabled
 	
-	return d.GetConfig(cfg.ID)
+	return ds.config(), nil
+}
+
+// Restore loads resolution and alarms of sensor from its EEPROM
+func (d *DSHandler) Restore(id string) (DSSensorConfig, error) {
+	s, err := d.sensorBy(id)
+	if err != nil {
+		return DSSensorConfig{}, &DSError{ID: id, Op: "Restore.sensorBy", Err: err.Error()}
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.missing() {
+		return DSSensorConfig{}, &DSError{ID: id, Op: "Restore", Err: ErrSensorMissing.Error()}
+	}
+	if err := s.Restore(); err != nil {
+		return DSSensorConfig{}, &DSError{ID: id, Op: "Restore", Err: err.Error()}
+	}
+	return s.config(), nil
 }
 
 func (d *DSHandler) GetConfig(id string) (DSSensorConfig, error) {
@@ -109,30 +180,98 @@ func (d *DSHandler) GetConfig(id string) (DSSensorConfig, error) {
 	if err != nil {
 		return DSSensorConfig{}, &DSError{ID: id, Op: "GetConfig", Err: err.Error()}
 	}
-	s.cfg.SensorConfig = s.GetConfig()
-	return s.cfg, nil
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.config(), nil
 }
 
 func (d *DSHandler) sensorBy(id string) (*dsSensor, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
 	if s, ok := d.sensors[id]; ok {
 		return s, nil
 	}
 	return nil, ErrNoSuchID
 }
 
+// all returns snapshot of sensors
+func (d *DSHandler) all() []*dsSensor {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	sensors := make([]*dsSensor, 0, len(d.sensors))
+	for _, s := range d.sensors {
+		sensors = append(sensors, s)
+	}
+	return sensors
+}
+
 func (d *DSHandler) GetSensors() []DSSensorConfig {
-	onewireSensors := make([]DSSensorConfig, 0, len(d.sensors))
-	for _, v := range d.sensors {
+	all := d.all()
+	onewireSensors := make([]DSSensorConfig, 0, len(all))
+	for _, v := range all {
+		v.mtx.Lock()
 		onewireSensors = append(onewireSensors, v.cfg)
+		v.mtx.Unlock()
 	}
 	return onewireSensors
 }
 
+// Open scans buses and starts periodic rescans
 func (d *DSHandler) Open() {
+	if len(d.buses) == 0 {
+		return
+	}
+	d.load()
+	for _, b := range d.buses {
+		d.scan(b)
+	}
+	d.boot()
+
+	d.done = make(chan struct{})
+	for _, b := range d.buses {
+		if b.rescan > 0 {
+			d.wg.Add(1)
+			go d.rescan(b)
+		}
+	}
 }
 
 func (d *DSHandler) Close() {
-	for _, sensor := range d.sensors {
-		sensor.Close()
+	if d.done != nil {
+		close(d.done)
+		d.wg.Wait()
+	}
+	for _, sensor := range d.all() {
+		sensor.mtx.Lock()
+		if sensor.DSSensor != nil {
+			sensor.Close()
+		}
+		sensor.mtx.Unlock()
 	}
 }
+
+// config refreshes and returns snapshot of config, s.mtx must be locked
+func (s *dsSensor) config() DSSensorConfig {
+	if !s.missing() {
+		s.cfg.SensorConfig = s.GetConfig()
+	}
+	return s.cfg
+}
+
+// enabled returns true, if sensor is polling
+func (s *dsSensor) enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.cfg.Enabled && !s.cfg.Missing
+}
+
+// missing returns true, if sensor is not present on bus, s.mtx must be locked
+func (s *dsSensor) missing() bool {
+	return s.cfg.Missing
+}
+
+func (s *dsSensor) isMissing() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.missing()
+}
@@ -309,6 +309,55 @@ func (t *DS18B20TestSuite) TestDSConfig_EnableDisable() {
 	r.Nil(err)
 
 }
+func (t *DS18B20TestSuite) TestDSConfig_PersistRestore() {
+	cfg := ds18b20.SensorConfig{
+		ID:           "2",
+		Resolution:   ds18b20.Resolution12Bit,
+		PollInterval: 3,
+		Samples:      4,
+		AlarmLow:     10,
+		AlarmHigh:    90,
+		Parasitic:    true,
+	}
+	restored := cfg
+	restored.Resolution, restored.AlarmLow, restored.AlarmHigh = ds18b20.Resolution9Bit, -55, 125
+
+	m := new(DS18B20SensorMock)
+	m.On("ID").Return(cfg.ID)
+	m.On("GetConfig").Return(cfg).Times(3)
+	m.On("GetConfig").Return(restored)
+	m.On("Configure", cfg).Return(nil)
+	m.On("Save").Return(nil).Once()
+	m.On("Restore").Return(nil).Once()
+	m.On("Close").Return(nil)
+	t.mock = []*DS18B20SensorMock{m}
+
+	h, err := embedded.NewRest("", embedded.WithDS18B20(t.sensors()))
+	r := t.Require()
+	r.Nil(err)
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewDS18B20Client(srv.URL, time.Second)
+
+	// Without Persist, config is only applied
+	got, err := client.Configure(embedded.DSSensorConfig{SensorConfig: cfg})
+	r.Nil(err)
+	r.True(got.Parasitic)
+	m.AssertNotCalled(t.T(), "Save")
+
+	_, err = client.Configure(embedded.DSSensorConfig{Persist: true, SensorConfig: cfg})
+	r.Nil(err)
+	m.AssertNumberOfCalls(t.T(), "Save", 1)
+
+	got, err = client.Restore(cfg.ID)
+	r.Nil(err)
+	r.Equal(restored, got.SensorConfig)
+	m.AssertNumberOfCalls(t.T(), "Restore", 1)
+
+	_, err = client.Restore("unknown")
+	r.ErrorContains(err, embedded.ErrNoSuchID.Error())
+}
+
 func (t *DS18B20TestSuite) TestDSConfig() {
 	args := []struct {
 		name     string
@@ -440,6 +489,11 @@ func (m *DS18B20SensorMock) Temperature() (actual, average float64, err error) {
 	return args.Get(0).(float64), args.Get(1).(float64), args.Error(2)
 }
 
+func (m *DS18B20SensorMock) Latest() ds18b20.Readings {
+	args := m.Called()
+	return args.Get(0).(ds18b20.Readings)
+}
+
 func (m *DS18B20SensorMock) Average() float64 {
 	return m.Called().Get(0).(float64)
 }
@@ -452,6 +506,14 @@ func (m *DS18B20SensorMock) GetConfig() ds18b20.SensorConfig {
 	return m.Called().Get(0).(ds18b20.SensorConfig)
 }
 
+func (m *DS18B20SensorMock) Save() error {
+	return m.Called().Error(0)
+}
+
+func (m *DS18B20SensorMock) Restore() error {
+	return m.Called().Error(0)
+}
+
 func (m *DS18B20SensorMock) Close() {
 	m.Called()
 }
@@ -0,0 +1,397 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/logging"
+)
+
+// Types of DSEvent
+const (
+	DSEventAdded    = "added"
+	DSEventRemoved  = "removed"
+	DSEventReturned = "returned"
+)
+
+// dsEventsMax is number of kept events, the oldest ones are dropped
+const dsEventsMax = 100
+
+var (
+	ErrSensorMissing    = errors.New("sensor is missing on bus")
+	ErrBulkReadInterval = errors.New("bulk read requires poll_time_millis")
+)
+
+// DSBus is onewire bus, which is scanned for new and vanished sensors
+type DSBus interface {
+	IDs() ([]string, error)
+	NewSensor(id string) (DSSensor, error)
+}
+
+// DSBulkBus converts temperature of all polled sensors at once, readings are returned in batches
+type DSBulkBus interface {
+	DSBus
+	BulkRead() bool
+	GetReadings() []ds18b20.BulkReadings
+}
+
+// DSAlarmBus creates sensors with alarms (see ds18b20.WithAlarms)
+type DSAlarmBus interface {
+	DSBus
+	AlarmsEnabled() bool
+}
+
+// DSAlarmSensor reports, whether last conversion was out of TL and TH
+type DSAlarmSensor interface {
+	AlarmsEnabled() bool
+	InAlarm() bool
+}
+
+// DSDeviceBus lists all devices on bus, including ones without driver
+type DSDeviceBus interface {
+	DSBus
+	Devices() ([]ds18b20.Device, error)
+}
+
+// DSDevice is device found on bus
+type DSDevice struct {
+	Bus string `json:"bus"`
+	ds18b20.Device
+}
+
+// DSEvent reports change of sensors on bus.
+// Sensor which was expected (see WithDS18B20Inventory), but is not found at boot, is reported as removed.
+type DSEvent struct {
+	ID    string    `json:"id"`
+	Bus   string    `json:"bus"`
+	Type  string    `json:"type"`
+	Stamp time.Time `json:"stamp"`
+}
+
+type dsBus struct {
+	DSBus
+	name   string
+	rescan time.Duration
+}
+
+// onewireBus adapts ds18b20.Bus to DSBus, alarms is set if sensors are created with ds18b20.WithAlarms
+type onewireBus struct {
+	*ds18b20.Bus
+	alarms bool
+}
+
+// dsInventory is stored in inventory file
+type dsInventory struct {
+	ID  string `json:"id"`
+	Bus string `json:"bus"`
+}
+
+func (o onewireBus) NewSensor(id string) (DSSensor, error) {
+	s, err := o.Bus.NewSensor(id)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (o onewireBus) AlarmsEnabled() bool {
+	return o.alarms
+}
+
+// Alarms returns IDs of sensors in alarm, on each bus with enabled alarms.
+// State is taken from last conversion, so sensors which aren't polled or don't have alarms are skipped.
+func (d *DSHandler) Alarms() ([]string, error) {
+	var ids []string
+	for _, s := range d.all() {
+		if !d.alarmBus(s.bus) {
+			continue
+		}
+		s.mtx.Lock()
+		as, ok := s.DSSensor.(DSAlarmSensor)
+		polled, id := s.cfg.Enabled && !s.missing(), s.cfg.ID
+		s.mtx.Unlock()
+		if ok && polled && as.AlarmsEnabled() && as.InAlarm() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Devices returns devices found on each bus, which is able to list them
+func (d *DSHandler) Devices() ([]DSDevice, error) {
+	var devices []DSDevice
+	var errs []string
+	for _, b := range d.buses {
+		db, ok := b.DSBus.(DSDeviceBus)
+		if !ok {
+			continue
+		}
+		found, err := db.Devices()
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		for _, dev := range found {
+			devices = append(devices, DSDevice{Bus: b.name, Device: dev})
+		}
+	}
+	if errs != nil {
+		return devices, &DSError{Op: "Devices", Err: strings.Join(errs, ", ")}
+	}
+	return devices, nil
+}
+
+// alarmsEnabled returns true, if any bus can check alarms
+func (d *DSHandler) alarmsEnabled() bool {
+	for _, b := range d.buses {
+		if _, ok := b.alarm(); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Events returns recent changes of sensors on buses
+func (d *DSHandler) Events() []DSEvent {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	events := make([]DSEvent, len(d.events))
+	copy(events, d.events)
+	return events
+}
+
+// bulk returns true, if sensors on bus are read by DSBulkBus
+func (b *dsBus) bulk() (DSBulkBus, bool) {
+	bb, ok := b.DSBus.(DSBulkBus)
+	if !ok || !bb.BulkRead() {
+		return nil, false
+	}
+	return bb, true
+}
+
+// alarm returns true, if bus can check alarms
+func (b *dsBus) alarm() (DSAlarmBus, bool) {
+	ab, ok := b.DSBus.(DSAlarmBus)
+	if !ok || !ab.AlarmsEnabled() {
+		return nil, false
+	}
+	return ab, true
+}
+
+// alarmBus returns true, if sensors on bus with name are created with alarms
+func (d *DSHandler) alarmBus(name string) bool {
+	for _, b := range d.buses {
+		if _, ok := b.alarm(); ok && b.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// bulkBus returns true, if sensors on bus with name are read in batches
+func (d *DSHandler) bulkBus(name string) bool {
+	for _, b := range d.buses {
+		if _, ok := b.bulk(); ok && b.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *DSHandler) rescan(b *dsBus) {
+	defer d.wg.Done()
+	ticker := time.NewTicker(b.rescan)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+			d.scan(b)
+		}
+	}
+}
+
+// scan adds new sensors found on bus, marks vanished ones as missing and restores returned ones
+func (d *DSHandler) scan(b *dsBus) {
+	ids, err := b.IDs()
+	if err != nil {
+		logger.Error("DS scan", logging.String("bus", b.name), logging.String("error", err.Error()))
+		return
+	}
+
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if d.sensors == nil {
+		d.sensors = make(map[string]*dsSensor)
+	}
+	if d.expected == nil {
+		d.expected = make(map[string]string)
+	}
+
+	present := make(map[string]struct{}, len(ids))
+	changed := false
+	for _, id := range ids {
+		present[id] = struct{}{}
+		s, ok := d.sensors[id]
+		if !ok {
+			sensor, err := b.NewSensor(id)
+			if err != nil {
+				logger.Error("DS scan", logging.String("bus", b.name), logging.String("ID", id), logging.String("error", err.Error()))
+				continue
+			}
+			d.sensors[id] = &dsSensor{DSSensor: sensor, bus: b.name, cfg: DSSensorConfig{SensorConfig: sensor.GetConfig()}}
+			if _, ok := d.expected[id]; !ok {
+				d.expected[id] = b.name
+				d.event(id, b.name, DSEventAdded)
+				changed = true
+			}
+			continue
+		}
+		if s.bus != b.name {
+			// Sensor provided with WithDS18B20 or found on other bus
+			continue
+		}
+		s.mtx.Lock()
+		if s.missing() {
+			if err := s.restore(b); err != nil {
+				logger.Error("DS restore", logging.String("bus", b.name), logging.String("ID", id), logging.String("error", err.Error()))
+			} else {
+				d.event(id, b.name, DSEventReturned)
+			}
+		}
+		s.mtx.Unlock()
+	}
+
+	for id, s := range d.sensors {
+		if _, ok := present[id]; ok || s.bus != b.name {
+			continue
+		}
+		s.mtx.Lock()
+		if !s.missing() {
+			s.lose()
+			d.event(id, b.name, DSEventRemoved)
+		}
+		s.mtx.Unlock()
+	}
+
+	if changed {
+		d.save()
+	}
+}
+
+// boot marks sensors, which are expected, but weren't found during first scan
+func (d *DSHandler) boot() {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if d.sensors == nil {
+		d.sensors = make(map[string]*dsSensor)
+	}
+	for id, bus := range d.expected {
+		if _, ok := d.sensors[id]; ok {
+			continue
+		}
+		d.sensors[id] = &dsSensor{
+			bus: bus,
+			cfg: DSSensorConfig{Missing: true, SensorConfig: ds18b20.SensorConfig{Name: id, ID: id}},
+		}
+		d.event(id, bus, DSEventRemoved)
+	}
+}
+
+// event records change, d.mtx must be locked
+func (d *DSHandler) event(id, bus, typ string) {
+	logger.Debug("DS event", logging.String("ID", id), logging.String("bus", bus), logging.String("type", typ))
+	d.events = append(d.events, DSEvent{ID: id, Bus: bus, Type: typ, Stamp: time.Now()})
+	if len(d.events) > dsEventsMax {
+		d.events = d.events[len(d.events)-dsEventsMax:]
+	}
+}
+
+// load reads expected sensors from inventory file, lack of file is not an error
+func (d *DSHandler) load() {
+	d.expected = make(map[string]string)
+	if d.inventory == "" {
+		return
+	}
+	buf, err := os.ReadFile(d.inventory)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Error("DS inventory load", logging.String("path", d.inventory), logging.String("error", err.Error()))
+		}
+		return
+	}
+	var inventory []dsInventory
+	if err := json.Unmarshal(buf, &inventory); err != nil {
+		logger.Error("DS inventory load", logging.String("path", d.inventory), logging.String("error", err.Error()))
+		return
+	}
+	for _, elem := range inventory {
+		d.expected[elem.ID] = elem.Bus
+	}
+}
+
+// save writes expected sensors to inventory file, d.mtx must be locked
+func (d *DSHandler) save() {
+	if d.inventory == "" {
+		return
+	}
+	inventory := make([]dsInventory, 0, len(d.expected))
+	for id, bus := range d.expected {
+		inventory = append(inventory, dsInventory{ID: id, Bus: bus})
+	}
+	sort.Slice(inventory, func(i, j int) bool { return inventory[i].ID < inventory[j].ID })
+	if err := writeJSON(d.inventory, inventory); err != nil {
+		logger.Error("DS inventory save", logging.String("path", d.inventory), logging.String("error", err.Error()))
+	}
+}
+
+// lose stops polling of vanished sensor, Enabled is kept, so polling is resumed on return. s.mtx must be locked
+func (s *dsSensor) lose() {
+	if s.cfg.Enabled {
+		s.Close()
+	}
+	s.cfg.Missing = true
+}
+
+// restore applies config to returned sensor, s.mtx must be locked
+func (s *dsSensor) restore(b *dsBus) error {
+	if s.DSSensor == nil {
+		// Expected sensor shows up for the first time
+		sensor, err := b.NewSensor(s.cfg.ID)
+		if err != nil {
+			return err
+		}
+		s.DSSensor = sensor
+		if !s.pending {
+			s.cfg.SensorConfig = sensor.GetConfig()
+		}
+	}
+	if s.pending {
+		if err := s.Configure(s.cfg.SensorConfig); err != nil {
+			return err
+		}
+		if s.cfg.Persist {
+			if err := s.Save(); err != nil {
+				return err
+			}
+		}
+		s.pending, s.cfg.Persist = false, false
+	}
+	s.cfg.Missing = false
+	if s.cfg.Enabled {
+		s.Poll()
+	}
+	return nil
+}
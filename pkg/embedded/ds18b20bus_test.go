@@ -0,0 +1,449 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type DSBusSuite struct {
+	suite.Suite
+}
+
+// fakeDSBus returns sensors, which can be plugged and unplugged during test
+type fakeDSBus struct {
+	mtx sync.Mutex
+	ids []string
+}
+
+// fakeBulkBus returns prepared batches of readings
+type fakeBulkBus struct {
+	fakeDSBus
+	batches []ds18b20.BulkReadings
+}
+
+// fakeAlarmBus creates sensors with alarms, TL and TH are taken from alarms by ID
+type fakeAlarmBus struct {
+	fakeDSBus
+	alarms map[string]string
+}
+
+// alarmOnewire returns 21.5 degrees and prepared TL and TH
+type alarmOnewire struct {
+	fakeOnewire
+	alarms string
+}
+
+// fakeDeviceBus lists prepared devices
+type fakeDeviceBus struct {
+	fakeDSBus
+	devices []ds18b20.Device
+	err     error
+}
+
+const (
+	dsRescan  = 5 * time.Millisecond
+	dsWait    = time.Second
+	dsWaitTic = time.Millisecond
+)
+
+func TestDSBusSuite(t *testing.T) {
+	suite.Run(t, new(DSBusSuite))
+}
+
+func (t *DSBusSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (t *DSBusSuite) TestHotPlug() {
+	r := t.Require()
+	bus := &fakeDSBus{ids: []string{"28-1"}}
+	inventory := filepath.Join(t.T().TempDir(), "inventory.json")
+
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, dsRescan), embedded.WithDS18B20Inventory(inventory))
+	r.Nil(err)
+	defer h.Close()
+	ds := h.DS
+
+	// Sensor found at boot
+	r.Len(ds.GetSensors(), 1)
+	r.Equal([]string{embedded.DSEventAdded}, types(ds.Events()))
+
+	// New sensor plugged in
+	bus.set("28-1", "28-2")
+	r.Eventually(func() bool { return len(ds.GetSensors()) == 2 }, dsWait, dsWaitTic)
+	r.Equal([]string{embedded.DSEventAdded, embedded.DSEventAdded}, types(ds.Events()))
+
+	cfg := embedded.DSSensorConfig{
+		Enabled: true,
+		SensorConfig: ds18b20.SensorConfig{
+			ID:           "28-1",
+			Name:         "mash",
+			Resolution:   ds18b20.Resolution11Bit,
+			PollInterval: time.Millisecond,
+			Samples:      1,
+		},
+	}
+	_, err = ds.SetConfig(cfg)
+	r.Nil(err)
+
+	// Sensor unplugged keeps its config
+	bus.set("28-2")
+	r.Eventually(func() bool { return missing(ds, "28-1") }, dsWait, dsWaitTic)
+	got, err := ds.GetConfig("28-1")
+	r.Nil(err)
+	r.True(got.Enabled)
+	r.Equal("mash", got.Name)
+	_, _, err = ds.Temperature(ds18b20.SensorConfig{ID: "28-1"})
+	r.ErrorContains(err, embedded.ErrSensorMissing.Error())
+	// Temperatures are not reported for missing sensor
+	r.Len(ds.GetTemperatures(), 1)
+
+	// Config of missing sensor is applied on return
+	cfg.Name = "boil"
+	got, err = ds.SetConfig(cfg)
+	r.Nil(err)
+	r.True(got.Missing)
+	r.Equal("boil", got.Name)
+
+	bus.set("28-1", "28-2")
+	r.Eventually(func() bool { return !missing(ds, "28-1") }, dsWait, dsWaitTic)
+	got, err = ds.GetConfig("28-1")
+	r.Nil(err)
+	r.True(got.Enabled)
+	r.Equal("boil", got.Name)
+	// Polling is resumed
+	r.Eventually(func() bool {
+		_, _, err := ds.Temperature(ds18b20.SensorConfig{ID: "28-1"})
+		return err == nil
+	}, dsWait, dsWaitTic)
+
+	r.Equal([]string{
+		embedded.DSEventAdded,
+		embedded.DSEventAdded,
+		embedded.DSEventRemoved,
+		embedded.DSEventReturned,
+	}, types(ds.Events()))
+
+	// Inventory keeps every seen sensor
+	buf, err := os.ReadFile(inventory)
+	r.Nil(err)
+	var stored []map[string]string
+	r.Nil(json.Unmarshal(buf, &stored))
+	r.Equal([]map[string]string{{"id": "28-1", "bus": "w1"}, {"id": "28-2", "bus": "w1"}}, stored)
+}
+
+func (t *DSBusSuite) TestInventory_MissingAtBoot() {
+	r := t.Require()
+	inventory := filepath.Join(t.T().TempDir(), "inventory.json")
+	r.Nil(os.WriteFile(inventory, []byte(`[{"id":"28-1","bus":"w1"},{"id":"28-2","bus":"w1"}]`), 0o644))
+
+	bus := &fakeDSBus{ids: []string{"28-1"}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, dsRescan), embedded.WithDS18B20Inventory(inventory))
+	r.Nil(err)
+	defer h.Close()
+	ds := h.DS
+
+	// Expected sensor isn't reported as added, the absent one is flagged
+	events := ds.Events()
+	r.Len(events, 1)
+	r.Equal("28-2", events[0].ID)
+	r.Equal("w1", events[0].Bus)
+	r.Equal(embedded.DSEventRemoved, events[0].Type)
+	r.True(missing(ds, "28-2"))
+
+	// Config set before first appearance is applied
+	_, err = ds.SetConfig(embedded.DSSensorConfig{
+		SensorConfig: ds18b20.SensorConfig{ID: "28-2", Name: "hlt", Resolution: ds18b20.Resolution11Bit, Samples: 1},
+	})
+	r.Nil(err)
+
+	bus.set("28-1", "28-2")
+	r.Eventually(func() bool { return !missing(ds, "28-2") }, dsWait, dsWaitTic)
+	got, err := ds.GetConfig("28-2")
+	r.Nil(err)
+	r.Equal("hlt", got.Name)
+}
+
+func (t *DSBusSuite) TestEvents_Clients() {
+	r := t.Require()
+	bus := &fakeDSBus{ids: []string{"28-1"}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, dsRescan))
+	r.Nil(err)
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	bus.set()
+	r.Eventually(func() bool { return missing(h.DS, "28-1") }, dsWait, dsWaitTic)
+
+	events, err := embedded.NewDS18B20Client(srv.URL, time.Second).Events()
+	r.Nil(err)
+	r.Equal([]string{embedded.DSEventAdded, embedded.DSEventRemoved}, types(events))
+	r.Equal("28-1", events[1].ID)
+
+	// Without buses events are not available
+	empty, err := embedded.NewRest("")
+	r.Nil(err)
+	emptySrv := httptest.NewServer(empty.Router)
+	defer emptySrv.Close()
+	_, err = embedded.NewDS18B20Client(emptySrv.URL, time.Second).Events()
+	r.ErrorContains(err, embedded.ErrNotImplemented.Error())
+}
+
+func (t *DSBusSuite) TestBulkRead_Batches() {
+	r := t.Require()
+	stamp := time.Now()
+	batch := ds18b20.BulkReadings{
+		Stamp: stamp,
+		Readings: []ds18b20.Readings{
+			{ID: "28-1", Temperature: 21.5, Average: 21.5, Stamp: stamp},
+			{ID: "28-2", Temperature: 64.25, Average: 64.25, Stamp: stamp},
+		},
+	}
+	bus := &fakeBulkBus{fakeDSBus: fakeDSBus{ids: []string{"28-1", "28-2"}}, batches: []ds18b20.BulkReadings{batch}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, 0))
+	r.Nil(err)
+	defer h.Close()
+
+	// Single entry per conversion, instead of entry per sensor
+	r.Equal([]embedded.DSTemperature{{Readings: batch.Readings}}, h.DS.GetTemperatures())
+	r.Empty(h.DS.GetTemperatures())
+}
+
+func (t *DSBusSuite) TestAlarms_Clients() {
+	r := t.Require()
+	first := &fakeAlarmBus{fakeDSBus: fakeDSBus{ids: []string{"28-1", "28-2"}}, alarms: map[string]string{"28-1": "-10 30", "28-2": "-10 20"}}
+	second := &fakeAlarmBus{fakeDSBus: fakeDSBus{ids: []string{"28-3"}}, alarms: map[string]string{"28-3": "25 30"}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", first, 0), embedded.WithDS18B20Bus("w2", second, 0))
+	r.Nil(err)
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewDS18B20Client(srv.URL, time.Second)
+
+	// Sensors, which aren't polled, have no conversion yet
+	alarms, err := client.Alarms()
+	r.Nil(err)
+	r.Empty(alarms)
+
+	for _, id := range []string{"28-1", "28-2", "28-3"} {
+		cfg, err := h.DS.GetConfig(id)
+		r.Nil(err)
+		cfg.Enabled, cfg.PollInterval = true, time.Millisecond
+		_, err = client.Configure(cfg)
+		r.Nil(err)
+	}
+	r.Eventually(func() bool {
+		alarms, err = client.Alarms()
+		return err == nil && len(alarms) == 2
+	}, time.Second, time.Millisecond)
+	r.Equal([]string{"28-2", "28-3"}, alarms)
+
+	// State of sensor, which isn't polled anymore, is dropped
+	cfg, err := h.DS.GetConfig("28-3")
+	r.Nil(err)
+	cfg.Enabled = false
+	_, err = client.Configure(cfg)
+	r.Nil(err)
+	alarms, err = client.Alarms()
+	r.Nil(err)
+	r.Equal([]string{"28-2"}, alarms)
+
+	// Bus without alarms
+	plain, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", &fakeDSBus{}, 0))
+	r.Nil(err)
+	defer plain.Close()
+	plainSrv := httptest.NewServer(plain.Router)
+	defer plainSrv.Close()
+	_, err = embedded.NewDS18B20Client(plainSrv.URL, time.Second).Alarms()
+	r.ErrorContains(err, embedded.ErrNotImplemented.Error())
+}
+
+func (t *DSBusSuite) TestDevices_Clients() {
+	r := t.Require()
+	bus := &fakeDeviceBus{
+		fakeDSBus: fakeDSBus{ids: []string{"28-1"}},
+		devices: []ds18b20.Device{
+			{ID: "28-1", Family: ds18b20.FamilyDS18B20, Name: "DS18B20", Supported: true},
+			{ID: "3a-2", Family: ds18b20.FamilyDS2413, Name: "DS2413", Supported: true},
+			{ID: "01-3", Family: "01", Supported: false},
+		},
+	}
+	// Bus which is not able to list devices is skipped
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, 0), embedded.WithDS18B20Bus("w2", &fakeDSBus{}, 0))
+	r.Nil(err)
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewDS18B20Client(srv.URL, time.Second)
+
+	devices, err := client.Devices()
+	r.Nil(err)
+	r.Len(devices, 3)
+	for i, dev := range devices {
+		r.Equal("w1", dev.Bus)
+		r.Equal(bus.devices[i], dev.Device)
+	}
+
+	bus.err = io.ErrUnexpectedEOF
+	_, err = client.Devices()
+	r.ErrorContains(err, io.ErrUnexpectedEOF.Error())
+
+	// Without buses devices are not available
+	empty, err := embedded.NewRest("")
+	r.Nil(err)
+	emptySrv := httptest.NewServer(empty.Router)
+	defer emptySrv.Close()
+	_, err = embedded.NewDS18B20Client(emptySrv.URL, time.Second).Devices()
+	r.ErrorContains(err, embedded.ErrNotImplemented.Error())
+}
+
+func (t *DSBusSuite) TestCalibration_Clients() {
+	r := t.Require()
+	bus := &fakeDSBus{ids: []string{"28-1"}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, 0))
+	r.Nil(err)
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewDS18B20Client(srv.URL, time.Second)
+
+	cfgs, err := client.Get()
+	r.Nil(err)
+	r.Len(cfgs, 1)
+	cfg := cfgs[0]
+	r.Equal(calibration.None, cfg.Calibration.Model)
+
+	before := time.Now()
+	cfg.Calibration = calibration.Calibration{Model: calibration.Offset, Offset: -1.5}
+	got, err := client.Configure(cfg)
+	r.Nil(err)
+	r.Equal(calibration.Offset, got.Calibration.Model)
+	r.InDelta(-1.5, got.Calibration.Offset, 1e-9)
+	r.False(got.Calibration.Stamp.Before(before))
+
+	actual, _, err := h.DS.Temperature(got.SensorConfig)
+	r.Nil(err)
+	r.InDelta(20.0, actual, 1e-9)
+
+	cfg.Calibration = calibration.Calibration{Model: calibration.Piecewise}
+	_, err = client.Configure(cfg)
+	r.ErrorContains(err, calibration.ErrNotEnoughPoints.Error())
+}
+
+func (t *DSBusSuite) TestFilters_Clients() {
+	r := t.Require()
+	bus := &fakeDSBus{ids: []string{"28-1"}}
+	h, err := embedded.NewRest("", embedded.WithDS18B20Bus("w1", bus, 0))
+	r.Nil(err)
+	defer h.Close()
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+	client := embedded.NewDS18B20Client(srv.URL, time.Second)
+
+	cfgs, err := client.Get()
+	r.Nil(err)
+	r.Len(cfgs, 1)
+	cfg := cfgs[0]
+	r.Empty(cfg.Filters)
+
+	cfg.Filters = []avg.FilterConfig{{Type: avg.Spike, MaxDelta: 5}, {Type: avg.EMA, Alpha: 0.5}}
+	cfg.Enabled = true
+	cfg.PollInterval = time.Millisecond
+	got, err := client.Configure(cfg)
+	r.Nil(err)
+	r.Equal(cfg.Filters, got.Filters)
+
+	var readings []ds18b20.Readings
+	r.Eventually(func() bool {
+		temps, err := client.Temperatures()
+		if err == nil && len(temps) > 0 {
+			readings = append(readings, temps[0].Readings...)
+		}
+		return len(readings) > 0
+	}, time.Second, time.Millisecond)
+	r.InDelta(21.5, readings[0].Filtered, 1e-9)
+
+	cfg.Filters = []avg.FilterConfig{{Type: avg.Median, Window: 3}, {Type: "lowpass"}}
+	_, err = client.Configure(cfg)
+	r.ErrorContains(err, avg.ErrUnknownFilter.Error())
+}
+
+func types(events []embedded.DSEvent) []string {
+	t := make([]string, len(events))
+	for i, e := range events {
+		t[i] = e.Type
+	}
+	return t
+}
+
+func missing(ds *embedded.DSHandler, id string) bool {
+	cfg, err := ds.GetConfig(id)
+	return err == nil && cfg.Missing
+}
+
+func (f *fakeDSBus) set(ids ...string) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.ids = ids
+}
+
+func (f *fakeDSBus) IDs() ([]string, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return append([]string(nil), f.ids...), nil
+}
+
+func (f *fakeDSBus) NewSensor(id string) (embedded.DSSensor, error) {
+	return ds18b20.NewSensor(fakeOnewire{}, id, "w1")
+}
+
+func (f *fakeBulkBus) BulkRead() bool {
+	return true
+}
+
+func (f *fakeBulkBus) GetReadings() []ds18b20.BulkReadings {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	batches := f.batches
+	f.batches = nil
+	return batches
+}
+
+func (f *fakeAlarmBus) AlarmsEnabled() bool {
+	return true
+}
+
+func (f *fakeAlarmBus) NewSensor(id string) (embedded.DSSensor, error) {
+	return ds18b20.NewSensor(alarmOnewire{alarms: f.alarms[id]}, id, "w1", ds18b20.WithAlarms())
+}
+
+func (a alarmOnewire) ReadFile(name string) ([]byte, error) {
+	if path.Base(name) == "alarms" {
+		return []byte(a.alarms), nil
+	}
+	return a.fakeOnewire.ReadFile(name)
+}
+
+func (f *fakeDeviceBus) Devices() ([]ds18b20.Device, error) {
+	return f.devices, f.err
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"time"
 	
+	"github.com/a-clap/embedded/pkg/ds18b20"
 	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
 	"github.com/a-clap/embedded/pkg/restclient"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -33,10 +34,30 @@ func (p *DS18B20Client) Configure(setConfig DSSensorConfig) (DSSensorConfig, err
 	return restclient.Put[DSSensorConfig, *Error](p.addr+RoutesConfigOnewireSensor, p.timeout, setConfig)
 }
 
+// Restore loads resolution and alarms of sensor from its EEPROM
+func (p *DS18B20Client) Restore(id string) (DSSensorConfig, error) {
+	return restclient.Put[DSSensorConfig, *Error](p.addr+RoutesRestoreOnewireSensor, p.timeout, DSSensorConfig{SensorConfig: ds18b20.SensorConfig{ID: id}})
+}
+
 func (p *DS18B20Client) Temperatures() ([]DSTemperature, error) {
 	return restclient.Get[[]DSTemperature, *Error](p.addr+RoutesGetOnewireTemperatures, p.timeout)
 }
 
+// Events returns recent changes of sensors on buses
+func (p *DS18B20Client) Events() ([]DSEvent, error) {
+	return restclient.Get[[]DSEvent, *Error](p.addr+RoutesGetOnewireEvents, p.timeout)
+}
+
+// Alarms returns IDs of sensors in alarm
+func (p *DS18B20Client) Alarms() ([]string, error) {
+	return restclient.Get[[]string, *Error](p.addr+RoutesGetOnewireAlarms, p.timeout)
+}
+
+// Devices returns all devices on buses, including unsupported ones
+func (p *DS18B20Client) Devices() ([]DSDevice, error) {
+	return restclient.Get[[]DSDevice, *Error](p.addr+RoutesGetOnewireDevices, p.timeout)
+}
+
 type DSRPCClient struct {
 	timeout time.Duration
 	conn    *grpc.ClientConn
@@ -77,6 +98,17 @@ func (g *DSRPCClient) Configure(setConfig DSSensorConfig) (DSSensorConfig, error
 	return setConfig, nil
 }
 
+// Restore loads resolution and alarms of sensor from its EEPROM
+func (g *DSRPCClient) Restore(id string) (DSSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.DSRestore(ctx, &embeddedproto.DSConfig{ID: id})
+	if err != nil {
+		return DSSensorConfig{}, err
+	}
+	return rpcToDSConfig(got), nil
+}
+
 func (g *DSRPCClient) Temperatures() ([]DSTemperature, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
 	defer cancel()
@@ -87,6 +119,39 @@ func (g *DSRPCClient) Temperatures() ([]DSTemperature, error) {
 	return rpcToDSTemperature(got), nil
 }
 
+// Events returns recent changes of sensors on buses
+func (g *DSRPCClient) Events() ([]DSEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.DSGetEvents(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return rpcToDSEvents(got), nil
+}
+
+// Alarms returns IDs of sensors in alarm
+func (g *DSRPCClient) Alarms() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.DSGetAlarms(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return got.IDs, nil
+}
+
+// Devices returns all devices on buses, including unsupported ones
+func (g *DSRPCClient) Devices() ([]DSDevice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.DSGetDevices(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return rpcToDSDevices(got), nil
+}
+
 func (g *DSRPCClient) Close() {
 	_ = g.conn.Close()
 }
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/gpio"
+)
+
+// DS2413Switch is dual channel switch found on onewire bus
+type DS2413Switch interface {
+	ID() string
+	State(ch ds18b20.Channel) (pin, latch bool, err error)
+	Set(ch ds18b20.Channel, latch bool) error
+}
+
+// ds2413GPIO exposes single channel of DS2413 as GPIO.
+// PIO is open drain, so input and high output both release latch.
+type ds2413GPIO struct {
+	sw  DS2413Switch
+	ch  ds18b20.Channel
+	mtx sync.Mutex
+	cfg gpio.Config
+}
+
+var _ GPIO = (*ds2413GPIO)(nil)
+
+// NewDS2413GPIOs creates GPIO for each channel of DS2413, ID of GPIO is in format switchID:channel
+func NewDS2413GPIOs(sw DS2413Switch) []GPIO {
+	gpios := make([]GPIO, 0, 2)
+	for _, ch := range []ds18b20.Channel{ds18b20.ChannelA, ds18b20.ChannelB} {
+		gpios = append(gpios, &ds2413GPIO{
+			sw: sw,
+			ch: ch,
+			cfg: gpio.Config{
+				ID:          fmt.Sprintf("%v:%v", sw.ID(), ch),
+				Direction:   gpio.DirInput,
+				ActiveLevel: gpio.High,
+			},
+		})
+	}
+	return gpios
+}
+
+func (d *ds2413GPIO) ID() string {
+	return d.cfg.ID
+}
+
+// Get returns logical level of pin
+func (d *ds2413GPIO) Get() (bool, error) {
+	pin, _, err := d.sw.State(d.ch)
+	if err != nil {
+		return false, err
+	}
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	return pin == (d.cfg.ActiveLevel == gpio.High), nil
+}
+
+// Configure sets latch: released for input, otherwise based on Value and ActiveLevel
+func (d *ds2413GPIO) Configure(config gpio.Config) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	latch := true
+	if config.Direction == gpio.DirOutput {
+		latch = config.Value == (config.ActiveLevel == gpio.High)
+	}
+	if err := d.sw.Set(d.ch, latch); err != nil {
+		return err
+	}
+	d.cfg.Direction, d.cfg.ActiveLevel, d.cfg.Value = config.Direction, config.ActiveLevel, config.Value
+	return nil
+}
+
+func (d *ds2413GPIO) GetConfig() (gpio.Config, error) {
+	value, err := d.Get()
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	if err != nil {
+		return d.cfg, err
+	}
+	d.cfg.Value = value
+	return d.cfg, nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/gpio"
+	"github.com/stretchr/testify/suite"
+)
+
+type DS2413Suite struct {
+	suite.Suite
+}
+
+// fakeSwitch emulates DS2413 with pull-ups, pin is low only when latch is cleared or it is pulled externally
+type fakeSwitch struct {
+	latch  [2]bool
+	pulled [2]bool
+	err    error
+}
+
+func TestDS2413Suite(t *testing.T) {
+	suite.Run(t, new(DS2413Suite))
+}
+
+func (t *DS2413Suite) TestGPIOHandler() {
+	r := t.Require()
+	sw := &fakeSwitch{latch: [2]bool{true, true}}
+	plain := new(GPIOMock)
+	plain.On("ID").Return("plain")
+	plain.On("GetConfig").Return(gpio.Config{ID: "plain"}, nil)
+
+	// DS2413 channels are added next to other GPIOs
+	h, err := embedded.New(embedded.WithGPIOs([]embedded.GPIO{plain}), embedded.WithGPIOs(embedded.NewDS2413GPIOs(sw)))
+	r.Nil(err)
+	_, err = h.GPIO.GetConfig("plain")
+	r.Nil(err)
+
+	cfg, err := h.GPIO.GetConfig("3a-1:A")
+	r.Nil(err)
+	r.Equal(gpio.Config{ID: "3a-1:A", Direction: gpio.DirInput, ActiveLevel: gpio.High, Value: true}, cfg.Config)
+
+	sw.pulled[1] = true
+	cfg, err = h.GPIO.GetConfig("3a-1:B")
+	r.Nil(err)
+	r.False(cfg.Value)
+
+	// Output active low: true clears latch
+	cfg.Direction, cfg.ActiveLevel, cfg.Value = gpio.DirOutput, gpio.Low, true
+	sw.pulled[1] = false
+	r.Nil(h.GPIO.SetConfig(cfg))
+	r.Equal([2]bool{true, false}, sw.latch)
+	cfg, err = h.GPIO.GetConfig("3a-1:B")
+	r.Nil(err)
+	r.Equal(gpio.Config{ID: "3a-1:B", Direction: gpio.DirOutput, ActiveLevel: gpio.Low, Value: true}, cfg.Config)
+
+	// Input releases latch
+	cfg.Direction = gpio.DirInput
+	r.Nil(h.GPIO.SetConfig(cfg))
+	r.Equal([2]bool{true, true}, sw.latch)
+
+	sw.err = io.ErrUnexpectedEOF
+	r.ErrorContains(h.GPIO.SetConfig(cfg), io.ErrUnexpectedEOF.Error())
+	_, err = h.GPIO.GetConfig("3a-1:A")
+	r.ErrorContains(err, io.ErrUnexpectedEOF.Error())
+}
+
+func (f *fakeSwitch) ID() string {
+	return "3a-1"
+}
+
+func (f *fakeSwitch) State(ch ds18b20.Channel) (pin, latch bool, err error) {
+	if f.err != nil {
+		return false, false, f.err
+	}
+	return f.latch[ch] && !f.pulled[ch], f.latch[ch], nil
+}
+
+func (f *fakeSwitch) Set(ch ds18b20.Channel, latch bool) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.latch[ch] = latch
+	return nil
+}
@@ -14,18 +14,30 @@ var (
 )
 
 type Embedded struct {
-	Heaters *HeaterHandler
-	DS      *DSHandler
-	PT      *PTHandler
-	GPIO    *GPIOHandler
+	Heaters     *HeaterHandler
+	DS          *DSHandler
+	PT          *PTHandler
+	TC          *TCHandler
+	IIO         *IIOHandler
+	Counter     *CounterHandler
+	GPIO        *GPIOHandler
+	PID         *PIDHandler
+	Program     *ProgramHandler
+	Calibration *CalibrationHandler
 }
 
 func New(options ...Option) (*Embedded, error) {
 	e := &Embedded{
-		Heaters: new(HeaterHandler),
-		DS:      new(DSHandler),
-		PT:      new(PTHandler),
-		GPIO:    new(GPIOHandler),
+		Heaters:     new(HeaterHandler),
+		DS:          new(DSHandler),
+		PT:          new(PTHandler),
+		TC:          new(TCHandler),
+		IIO:         new(IIOHandler),
+		Counter:     new(CounterHandler),
+		GPIO:        new(GPIOHandler),
+		PID:         new(PIDHandler),
+		Program:     new(ProgramHandler),
+		Calibration: new(CalibrationHandler),
 	}
 
 	for _, opt := range options {
@@ -37,15 +49,33 @@ func New(options ...Option) (*Embedded, error) {
 	e.Heaters.Open()
 	e.DS.Open()
 	e.PT.Open()
+	e.TC.Open()
+	e.IIO.Open()
+	e.Counter.Open()
 	e.GPIO.Open()
 
+	e.PID.heaters, e.PID.ds, e.PID.pt = e.Heaters, e.DS, e.PT
+	e.PID.Open()
+
+	e.Program.heaters, e.Program.pid = e.Heaters, e.PID
+	e.Program.Open()
+
+	e.Calibration.ds, e.Calibration.pt = e.DS, e.PT
+	e.Calibration.Open()
+
 	return e, nil
 }
 
 func (e *Embedded) close() {
+	e.Calibration.Close()
+	e.Program.Close()
+	e.PID.Close()
 	e.Heaters.Close()
 	e.DS.Close()
 	e.PT.Close()
+	e.TC.Close()
+	e.IIO.Close()
+	e.Counter.Close()
 	e.GPIO.Close()
 }
 
@@ -53,15 +83,12 @@ func Parse(c Config) ([]Option, []error) {
 	var errs []error
 	var opts []Option
 	{
-		heaterOpts, err := parseHeaters(c.Heaters)
+		heaterOpts, err := parseHeaters(c.Heaters, c.HeaterGroups)
 		if err != nil {
 			logger.Error("parseHeaters failed")
 			errs = append(errs, err...)
 		}
-
-		if heaterOpts != nil {
-			opts = append(opts, heaterOpts)
-		}
+		opts = append(opts, heaterOpts...)
 	}
 	{
 		dsOpts, err := parseDS18B20(c.DS18B20)
@@ -69,8 +96,9 @@ func Parse(c Config) ([]Option, []error) {
 			logger.Error("parseDS18B20 failed")
 			errs = append(errs, err...)
 		}
-		if dsOpts != nil {
-			opts = append(opts, dsOpts)
+		opts = append(opts, dsOpts...)
+		if c.DS18B20InventoryFile != "" {
+			opts = append(opts, WithDS18B20Inventory(c.DS18B20InventoryFile))
 		}
 	}
 	{
@@ -83,6 +111,36 @@ func Parse(c Config) ([]Option, []error) {
 			opts = append(opts, ptOpts)
 		}
 	}
+	{
+		tcOpts, err := parseThermocouple(c.Thermocouple)
+		if err != nil {
+			logger.Error("parseThermocouple failed")
+			errs = append(errs, err...)
+		}
+		if tcOpts != nil {
+			opts = append(opts, tcOpts)
+		}
+	}
+	{
+		iioOpts, err := parseIIO(c.IIO)
+		if err != nil {
+			logger.Error("parseIIO failed")
+			errs = append(errs, err...)
+		}
+		if iioOpts != nil {
+			opts = append(opts, iioOpts)
+		}
+	}
+	{
+		counterOpts, err := parseCounters(c.Counters)
+		if err != nil {
+			logger.Error("parseCounters failed")
+			errs = append(errs, err...)
+		}
+		if counterOpts != nil {
+			opts = append(opts, counterOpts)
+		}
+	}
 	{
 		gpioOpts, err := parseGPIO(c.GPIO)
 		if err != nil {
@@ -93,6 +151,12 @@ func Parse(c Config) ([]Option, []error) {
 			opts = append(opts, gpioOpts)
 		}
 	}
+	if c.ProgramsFile != "" {
+		opts = append(opts, WithProgramStore(c.ProgramsFile))
+	}
+	if c.CalibrationHistoryFile != "" {
+		opts = append(opts, WithCalibrationHistory(c.CalibrationHistoryFile))
+	}
 
 	return opts, errs
 }
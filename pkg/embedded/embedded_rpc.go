@@ -13,9 +13,15 @@ import (
 type RPC struct {
 	url string
 	embeddedproto.UnimplementedPTServer
+	embeddedproto.UnimplementedTCServer
+	embeddedproto.UnimplementedIIOServer
+	embeddedproto.UnimplementedCounterServer
 	embeddedproto.UnimplementedHeaterServer
 	embeddedproto.UnimplementedDSServer
 	embeddedproto.UnimplementedGPIOServer
+	embeddedproto.UnimplementedPIDServer
+	embeddedproto.UnimplementedProgramServer
+	embeddedproto.UnimplementedCalibratorServer
 	*Embedded
 }
 
@@ -42,7 +48,13 @@ func (r *RPC) Run() error {
 	embeddedproto.RegisterGPIOServer(s, r)
 	embeddedproto.RegisterDSServer(s, r)
 	embeddedproto.RegisterPTServer(s, r)
+	embeddedproto.RegisterTCServer(s, r)
+	embeddedproto.RegisterIIOServer(s, r)
+	embeddedproto.RegisterCounterServer(s, r)
 	embeddedproto.RegisterHeaterServer(s, r)
+	embeddedproto.RegisterPIDServer(s, r)
+	embeddedproto.RegisterProgramServer(s, r)
+	embeddedproto.RegisterCalibratorServer(s, r)
 
 	return s.Serve(listener)
 }
@@ -96,11 +108,48 @@ func (r *RPC) DSConfigure(ctx context.Context, config *embeddedproto.DSConfig) (
 	return dsConfigToRPC(&newCfg), nil
 }
 
+func (r *RPC) DSRestore(ctx context.Context, config *embeddedproto.DSConfig) (*embeddedproto.DSConfig, error) {
+	newCfg, err := r.Embedded.DS.Restore(config.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dsConfigToRPC(&newCfg), nil
+}
+
 func (r *RPC) DSGetTemperatures(ctx context.Context, e *empty.Empty) (*embeddedproto.DSTemperatures, error) {
 	t := r.Embedded.DS.GetTemperatures()
 	return dsTemperatureToRPC(t), nil
 }
 
+func (r *RPC) DSGetEvents(ctx context.Context, e *empty.Empty) (*embeddedproto.DSEvents, error) {
+	if len(r.Embedded.DS.buses) == 0 {
+		return nil, ErrNotImplemented
+	}
+	return dsEventsToRPC(r.Embedded.DS.Events()), nil
+}
+
+func (r *RPC) DSGetAlarms(ctx context.Context, e *empty.Empty) (*embeddedproto.DSAlarms, error) {
+	if !r.Embedded.DS.alarmsEnabled() {
+		return nil, ErrNotImplemented
+	}
+	ids, err := r.Embedded.DS.Alarms()
+	if err != nil {
+		return nil, err
+	}
+	return &embeddedproto.DSAlarms{IDs: ids}, nil
+}
+
+func (r *RPC) DSGetDevices(ctx context.Context, e *empty.Empty) (*embeddedproto.DSDevices, error) {
+	if len(r.Embedded.DS.buses) == 0 {
+		return nil, ErrNotImplemented
+	}
+	devices, err := r.Embedded.DS.Devices()
+	if err != nil {
+		return nil, err
+	}
+	return dsDevicesToRPC(devices), nil
+}
+
 func (r *RPC) PTGet(ctx context.Context, e *empty.Empty) (*embeddedproto.PTConfigs, error) {
 	g := r.Embedded.PT.GetSensors()
 
@@ -120,11 +169,104 @@ func (r *RPC) PTConfigure(ctx context.Context, config *embeddedproto.PTConfig) (
 	return ptConfigToRPC(&newCfg), nil
 }
 
+func (r *RPC) PTDetectFaults(ctx context.Context, config *embeddedproto.PTConfig) (*embeddedproto.PTConfig, error) {
+	newCfg, err := r.Embedded.PT.DetectFaults(config.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ptConfigToRPC(&newCfg), nil
+}
+
 func (r *RPC) PTGetTemperatures(ctx context.Context, e *empty.Empty) (*embeddedproto.PTTemperatures, error) {
 	t := r.Embedded.PT.GetTemperatures()
 	return ptTemperatureToRPC(t), nil
 }
 
+func (r *RPC) TCGet(ctx context.Context, e *empty.Empty) (*embeddedproto.TCConfigs, error) {
+	g := r.Embedded.TC.GetSensors()
+
+	configs := make([]*embeddedproto.TCConfig, len(g))
+	for i, elem := range g {
+		configs[i] = tcConfigToRPC(&elem)
+	}
+	return &embeddedproto.TCConfigs{Configs: configs}, nil
+}
+
+func (r *RPC) TCConfigure(ctx context.Context, config *embeddedproto.TCConfig) (*embeddedproto.TCConfig, error) {
+	cfg := rpcToTCConfig(config)
+	newCfg, err := r.Embedded.TC.SetConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return tcConfigToRPC(&newCfg), nil
+}
+
+func (r *RPC) TCGetTemperatures(ctx context.Context, e *empty.Empty) (*embeddedproto.TCTemperatures, error) {
+	t := r.Embedded.TC.GetTemperatures()
+	return tcTemperatureToRPC(t), nil
+}
+
+func (r *RPC) IIOGet(ctx context.Context, e *empty.Empty) (*embeddedproto.IIOConfigs, error) {
+	g := r.Embedded.IIO.GetSensors()
+
+	configs := make([]*embeddedproto.IIOConfig, len(g))
+	for i, elem := range g {
+		configs[i] = iioConfigToRPC(&elem)
+	}
+	return &embeddedproto.IIOConfigs{Configs: configs}, nil
+}
+
+func (r *RPC) IIOConfigure(ctx context.Context, config *embeddedproto.IIOConfig) (*embeddedproto.IIOConfig, error) {
+	cfg := rpcToIIOConfig(config)
+	newCfg, err := r.Embedded.IIO.SetConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return iioConfigToRPC(&newCfg), nil
+}
+
+func (r *RPC) IIOGetValues(ctx context.Context, e *empty.Empty) (*embeddedproto.IIOValues, error) {
+	v := r.Embedded.IIO.GetValues()
+	return iioValueToRPC(v), nil
+}
+
+func (r *RPC) CounterGet(ctx context.Context, e *empty.Empty) (*embeddedproto.CounterConfigs, error) {
+	g := r.Embedded.Counter.Get()
+
+	configs := make([]*embeddedproto.CounterConfig, len(g))
+	for i, elem := range g {
+		configs[i] = counterConfigToRPC(&elem)
+	}
+	return &embeddedproto.CounterConfigs{Configs: configs}, nil
+}
+
+func (r *RPC) CounterConfigure(ctx context.Context, config *embeddedproto.CounterConfig) (*embeddedproto.CounterConfig, error) {
+	cfg := rpcToCounterConfig(config)
+	newCfg, err := r.Embedded.Counter.SetConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return counterConfigToRPC(&newCfg), nil
+}
+
+func (r *RPC) CounterGetStatus(ctx context.Context, e *empty.Empty) (*embeddedproto.CounterStatusList, error) {
+	g := r.Embedded.Counter.Status()
+
+	status := make([]*embeddedproto.CounterStatus, len(g))
+	for i, elem := range g {
+		status[i] = counterStatusToRPC(&elem)
+	}
+	return &embeddedproto.CounterStatusList{Status: status}, nil
+}
+
+func (r *RPC) CounterReset(ctx context.Context, id *embeddedproto.CounterID) (*embeddedproto.CounterStatus, error) {
+	status, err := r.Embedded.Counter.Reset(id.ID)
+	if err != nil {
+		return nil, err
+	}
+	return counterStatusToRPC(&status), nil
+}
+
 func (r *RPC) HeaterGet(context.Context, *empty.Empty) (*embeddedproto.HeaterConfigs, error) {
 	g := r.Embedded.Heaters.Get()
 
@@ -148,3 +290,175 @@ func (r *RPC) HeaterConfigure(ctx context.Context, config *embeddedproto.HeaterC
 
 	return heaterConfigToRPC(&newCfg), nil
 }
+
+func (r *RPC) HeaterClearFault(ctx context.Context, id *embeddedproto.HeaterID) (*embeddedproto.HeaterConfig, error) {
+	if err := r.Embedded.Heaters.ClearFault(id.ID); err != nil {
+		return nil, err
+	}
+	cfg, err := r.Embedded.Heaters.ConfigBy(id.ID)
+	if err != nil {
+		return nil, err
+	}
+	return heaterConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) HeaterSetPower(ctx context.Context, pwr *embeddedproto.HeaterPower) (*embeddedproto.HeaterPower, error) {
+	if err := r.Embedded.Heaters.PowerPrecise(pwr.ID, pwr.Power); err != nil {
+		return nil, err
+	}
+	got, err := r.Embedded.Heaters.PowerBy(pwr.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &embeddedproto.HeaterPower{ID: got.ID, Power: got.Power}, nil
+}
+
+func (r *RPC) HeaterGetStats(context.Context, *empty.Empty) (*embeddedproto.HeaterStatsList, error) {
+	s := r.Embedded.Heaters.Stats()
+	stats := &embeddedproto.HeaterStatsList{Stats: make([]*embeddedproto.HeaterStats, len(s))}
+	for i, elem := range s {
+		stats.Stats[i] = heaterStatsToRPC(&elem)
+	}
+	return stats, nil
+}
+
+func (r *RPC) HeaterGetGroups(context.Context, *empty.Empty) (*embeddedproto.HeaterGroupList, error) {
+	g := r.Embedded.Heaters.Groups()
+	groups := &embeddedproto.HeaterGroupList{Groups: make([]*embeddedproto.HeaterGroup, len(g))}
+	for i, elem := range g {
+		groups.Groups[i] = heaterGroupToRPC(&elem)
+	}
+	return groups, nil
+}
+
+func (r *RPC) HeaterResetStats(ctx context.Context, id *embeddedproto.HeaterID) (*embeddedproto.HeaterStats, error) {
+	if err := r.Embedded.Heaters.ResetStats(id.ID); err != nil {
+		return nil, err
+	}
+	stats, err := r.Embedded.Heaters.StatsBy(id.ID)
+	if err != nil {
+		return nil, err
+	}
+	return heaterStatsToRPC(&stats), nil
+}
+
+func (r *RPC) PIDGet(context.Context, *empty.Empty) (*embeddedproto.PIDConfigs, error) {
+	g := r.Embedded.PID.GetConfigs()
+
+	configs := make([]*embeddedproto.PIDConfig, len(g))
+	for i, elem := range g {
+		configs[i] = pidConfigToRPC(&elem)
+	}
+	return &embeddedproto.PIDConfigs{Configs: configs}, nil
+}
+
+func (r *RPC) PIDCreate(ctx context.Context, config *embeddedproto.PIDConfig) (*embeddedproto.PIDConfig, error) {
+	cfg, err := r.Embedded.PID.Create(rpcToPIDConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return pidConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) PIDConfigure(ctx context.Context, config *embeddedproto.PIDConfig) (*embeddedproto.PIDConfig, error) {
+	cfg, err := r.Embedded.PID.SetConfig(rpcToPIDConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return pidConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) PIDEnable(ctx context.Context, ena *embeddedproto.PIDEnabled) (*embeddedproto.PIDEnabled, error) {
+	if err := r.Embedded.PID.Enable(ena.ID, ena.Enabled); err != nil {
+		return nil, err
+	}
+	cfg, err := r.Embedded.PID.GetConfig(ena.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &embeddedproto.PIDEnabled{ID: cfg.ID, Enabled: cfg.Enabled}, nil
+}
+
+func (r *RPC) PIDGetStatus(context.Context, *empty.Empty) (*embeddedproto.PIDStatuses, error) {
+	s := r.Embedded.PID.Status()
+
+	status := make([]*embeddedproto.PIDStatus, len(s))
+	for i, elem := range s {
+		status[i] = pidStatusToRPC(&elem)
+	}
+	return &embeddedproto.PIDStatuses{Status: status}, nil
+}
+
+func (r *RPC) ProgramGet(context.Context, *empty.Empty) (*embeddedproto.ProgramConfigs, error) {
+	g := r.Embedded.Program.GetConfigs()
+	configs := make([]*embeddedproto.ProgramConfig, len(g))
+	for i, elem := range g {
+		configs[i] = programConfigToRPC(&elem)
+	}
+	return &embeddedproto.ProgramConfigs{Configs: configs}, nil
+}
+
+func (r *RPC) ProgramCreate(ctx context.Context, config *embeddedproto.ProgramConfig) (*embeddedproto.ProgramConfig, error) {
+	cfg, err := r.Embedded.Program.Create(rpcToProgramConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return programConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) ProgramConfigure(ctx context.Context, config *embeddedproto.ProgramConfig) (*embeddedproto.ProgramConfig, error) {
+	cfg, err := r.Embedded.Program.SetConfig(rpcToProgramConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return programConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) ProgramExecute(ctx context.Context, cmd *embeddedproto.ProgramCommand) (*embeddedproto.ProgramCommand, error) {
+	if err := r.Embedded.Program.Command(cmd.ID, cmd.Command); err != nil {
+		return nil, err
+	}
+	return &embeddedproto.ProgramCommand{ID: cmd.ID, Command: cmd.Command}, nil
+}
+
+func (r *RPC) ProgramGetStatus(context.Context, *empty.Empty) (*embeddedproto.ProgramStatuses, error) {
+	s := r.Embedded.Program.Status()
+	status := make([]*embeddedproto.ProgramStatus, len(s))
+	for i, elem := range s {
+		status[i] = programStatusToRPC(&elem)
+	}
+	return &embeddedproto.ProgramStatuses{Status: status}, nil
+}
+
+func (r *RPC) CalibrationCreate(ctx context.Context, config *embeddedproto.CalibrationConfig) (*embeddedproto.CalibrationConfig, error) {
+	cfg, err := r.Embedded.Calibration.Create(rpcToCalibrationConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return calibrationConfigToRPC(&cfg), nil
+}
+
+func (r *RPC) CalibrationExecute(ctx context.Context, cmd *embeddedproto.CalibrationCommand) (*embeddedproto.CalibrationCommand, error) {
+	if err := r.Embedded.Calibration.Command(cmd.ID, cmd.Command); err != nil {
+		return nil, err
+	}
+	return &embeddedproto.CalibrationCommand{ID: cmd.ID, Command: cmd.Command}, nil
+}
+
+func (r *RPC) CalibrationGetStatus(context.Context, *empty.Empty) (*embeddedproto.CalibrationStatuses, error) {
+	s := r.Embedded.Calibration.Status()
+	status := make([]*embeddedproto.CalibrationStatus, len(s))
+	for i, elem := range s {
+		status[i] = calibrationStatusToRPC(&elem)
+	}
+	return &embeddedproto.CalibrationStatuses{Status: status}, nil
+}
+
+func (r *RPC) CalibrationGetHistory(context.Context, *empty.Empty) (*embeddedproto.CalibrationRecords, error) {
+	h := r.Embedded.Calibration.History()
+	records := make([]*embeddedproto.CalibrationRecord, len(h))
+	for i, elem := range h {
+		records[i] = calibrationRecordToRPC(&elem)
+	}
+	return &embeddedproto.CalibrationRecords{Records: records}, nil
+}
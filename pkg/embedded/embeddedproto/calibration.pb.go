@@ -0,0 +1,1111 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/calibration.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Calibration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model        string              `protobuf:"bytes,1,opt,name=Model,proto3" json:"Model,omitempty"`
+	Offset       float64             `protobuf:"fixed64,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Points       []*CalibrationPoint `protobuf:"bytes,3,rep,name=Points,proto3" json:"Points,omitempty"`
+	Coefficients []float64           `protobuf:"fixed64,4,rep,packed,name=Coefficients,proto3" json:"Coefficients,omitempty"`
+	StampMillis  int64               `protobuf:"varint,5,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+}
+
+func (x *Calibration) Reset() {
+	*x = Calibration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calibration) ProtoMessage() {}
+
+func (x *Calibration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calibration.ProtoReflect.Descriptor instead.
+func (*Calibration) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Calibration) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Calibration) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Calibration) GetPoints() []*CalibrationPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *Calibration) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *Calibration) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+type CalibrationPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Raw       float64 `protobuf:"fixed64,1,opt,name=Raw,proto3" json:"Raw,omitempty"`
+	Reference float64 `protobuf:"fixed64,2,opt,name=Reference,proto3" json:"Reference,omitempty"`
+}
+
+func (x *CalibrationPoint) Reset() {
+	*x = CalibrationPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationPoint) ProtoMessage() {}
+
+func (x *CalibrationPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationPoint.ProtoReflect.Descriptor instead.
+func (*CalibrationPoint) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CalibrationPoint) GetRaw() float64 {
+	if x != nil {
+		return x.Raw
+	}
+	return 0
+}
+
+func (x *CalibrationPoint) GetReference() float64 {
+	if x != nil {
+		return x.Reference
+	}
+	return 0
+}
+
+type CalibrationSensor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SensorType string `protobuf:"bytes,2,opt,name=SensorType,proto3" json:"SensorType,omitempty"`
+}
+
+func (x *CalibrationSensor) Reset() {
+	*x = CalibrationSensor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationSensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationSensor) ProtoMessage() {}
+
+func (x *CalibrationSensor) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationSensor.ProtoReflect.Descriptor instead.
+func (*CalibrationSensor) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CalibrationSensor) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CalibrationSensor) GetSensorType() string {
+	if x != nil {
+		return x.SensorType
+	}
+	return ""
+}
+
+type CalibrationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string               `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Reference *CalibrationSensor   `protobuf:"bytes,2,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Targets   []*CalibrationSensor `protobuf:"bytes,3,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Model     string               `protobuf:"bytes,4,opt,name=Model,proto3" json:"Model,omitempty"`
+	Degree    int32                `protobuf:"varint,5,opt,name=Degree,proto3" json:"Degree,omitempty"`
+	Samples   uint32               `protobuf:"varint,6,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	Interval  int64                `protobuf:"varint,7,opt,name=Interval,proto3" json:"Interval,omitempty"`
+}
+
+func (x *CalibrationConfig) Reset() {
+	*x = CalibrationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationConfig) ProtoMessage() {}
+
+func (x *CalibrationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationConfig.ProtoReflect.Descriptor instead.
+func (*CalibrationConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CalibrationConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CalibrationConfig) GetReference() *CalibrationSensor {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CalibrationConfig) GetTargets() []*CalibrationSensor {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *CalibrationConfig) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CalibrationConfig) GetDegree() int32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *CalibrationConfig) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *CalibrationConfig) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type CalibrationCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=Command,proto3" json:"Command,omitempty"`
+}
+
+func (x *CalibrationCommand) Reset() {
+	*x = CalibrationCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationCommand) ProtoMessage() {}
+
+func (x *CalibrationCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationCommand.ProtoReflect.Descriptor instead.
+func (*CalibrationCommand) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CalibrationCommand) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CalibrationCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type CalibrationPlateau struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference   float64   `protobuf:"fixed64,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Raw         []float64 `protobuf:"fixed64,2,rep,packed,name=Raw,proto3" json:"Raw,omitempty"`
+	Samples     uint32    `protobuf:"varint,3,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	StampMillis int64     `protobuf:"varint,4,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+}
+
+func (x *CalibrationPlateau) Reset() {
+	*x = CalibrationPlateau{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationPlateau) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationPlateau) ProtoMessage() {}
+
+func (x *CalibrationPlateau) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationPlateau.ProtoReflect.Descriptor instead.
+func (*CalibrationPlateau) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CalibrationPlateau) GetReference() float64 {
+	if x != nil {
+		return x.Reference
+	}
+	return 0
+}
+
+func (x *CalibrationPlateau) GetRaw() []float64 {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *CalibrationPlateau) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *CalibrationPlateau) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+type CalibrationPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target      *CalibrationSensor `protobuf:"bytes,1,opt,name=Target,proto3" json:"Target,omitempty"`
+	Calibration *Calibration       `protobuf:"bytes,2,opt,name=Calibration,proto3" json:"Calibration,omitempty"`
+	MaxError    float64            `protobuf:"fixed64,3,opt,name=MaxError,proto3" json:"MaxError,omitempty"`
+	Error       string             `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *CalibrationPreview) Reset() {
+	*x = CalibrationPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationPreview) ProtoMessage() {}
+
+func (x *CalibrationPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationPreview.ProtoReflect.Descriptor instead.
+func (*CalibrationPreview) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalibrationPreview) GetTarget() *CalibrationSensor {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CalibrationPreview) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+func (x *CalibrationPreview) GetMaxError() float64 {
+	if x != nil {
+		return x.MaxError
+	}
+	return 0
+}
+
+func (x *CalibrationPreview) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CalibrationStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []*CalibrationStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CalibrationStatuses) Reset() {
+	*x = CalibrationStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationStatuses) ProtoMessage() {}
+
+func (x *CalibrationStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationStatuses.ProtoReflect.Descriptor instead.
+func (*CalibrationStatuses) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalibrationStatuses) GetStatus() []*CalibrationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CalibrationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       string                `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	State    string                `protobuf:"bytes,2,opt,name=State,proto3" json:"State,omitempty"`
+	Plateaus []*CalibrationPlateau `protobuf:"bytes,3,rep,name=Plateaus,proto3" json:"Plateaus,omitempty"`
+	Preview  []*CalibrationPreview `protobuf:"bytes,4,rep,name=Preview,proto3" json:"Preview,omitempty"`
+	Fault    string                `protobuf:"bytes,5,opt,name=Fault,proto3" json:"Fault,omitempty"`
+}
+
+func (x *CalibrationStatus) Reset() {
+	*x = CalibrationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationStatus) ProtoMessage() {}
+
+func (x *CalibrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationStatus.ProtoReflect.Descriptor instead.
+func (*CalibrationStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CalibrationStatus) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CalibrationStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CalibrationStatus) GetPlateaus() []*CalibrationPlateau {
+	if x != nil {
+		return x.Plateaus
+	}
+	return nil
+}
+
+func (x *CalibrationStatus) GetPreview() []*CalibrationPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *CalibrationStatus) GetFault() string {
+	if x != nil {
+		return x.Fault
+	}
+	return ""
+}
+
+type CalibrationRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*CalibrationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *CalibrationRecords) Reset() {
+	*x = CalibrationRecords{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationRecords) ProtoMessage() {}
+
+func (x *CalibrationRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationRecords.ProtoReflect.Descriptor instead.
+func (*CalibrationRecords) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CalibrationRecords) GetRecords() []*CalibrationRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type CalibrationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sensor      *CalibrationSensor    `protobuf:"bytes,1,opt,name=Sensor,proto3" json:"Sensor,omitempty"`
+	Reference   *CalibrationSensor    `protobuf:"bytes,2,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	Calibration *Calibration          `protobuf:"bytes,3,opt,name=Calibration,proto3" json:"Calibration,omitempty"`
+	Plateaus    []*CalibrationPlateau `protobuf:"bytes,4,rep,name=Plateaus,proto3" json:"Plateaus,omitempty"`
+	MaxError    float64               `protobuf:"fixed64,5,opt,name=MaxError,proto3" json:"MaxError,omitempty"`
+}
+
+func (x *CalibrationRecord) Reset() {
+	*x = CalibrationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalibrationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationRecord) ProtoMessage() {}
+
+func (x *CalibrationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationRecord.ProtoReflect.Descriptor instead.
+func (*CalibrationRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CalibrationRecord) GetSensor() *CalibrationSensor {
+	if x != nil {
+		return x.Sensor
+	}
+	return nil
+}
+
+func (x *CalibrationRecord) GetReference() *CalibrationSensor {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *CalibrationRecord) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+func (x *CalibrationRecord) GetPlateaus() []*CalibrationPlateau {
+	if x != nil {
+		return x.Plateaus
+	}
+	return nil
+}
+
+func (x *CalibrationRecord) GetMaxError() float64 {
+	if x != nil {
+		return x.MaxError
+	}
+	return 0
+}
+
+var File_pkg_embedded_embeddedproto_calibration_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_calibration_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x0b, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x52,
+	0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x52, 0x61, 0x77, 0x12, 0x1c, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x83, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x52, 0x09, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x67, 0x72,
+	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x61, 0x75, 0x12, 0x1c, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x52,
+	0x61, 0x77, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x52, 0x61, 0x77, 0x12, 0x18, 0x0a,
+	0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x38, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73,
+	0x6f, 0x72, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x13, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x11,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x65,
+	0x61, 0x75, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x61, 0x75, 0x52, 0x08, 0x50, 0x6c,
+	0x61, 0x74, 0x65, 0x61, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x50, 0x0a, 0x12, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x3a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xa6, 0x02, 0x0a, 0x11,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x38, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x09, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x52, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x43,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x50, 0x6c, 0x61,
+	0x74, 0x65, 0x61, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x61, 0x75, 0x52, 0x08,
+	0x50, 0x6c, 0x61, 0x74, 0x65, 0x61, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x32, 0xf1, 0x02, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x20, 0x2e, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x12, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x21, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x14,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_calibration_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_calibration_proto_rawDescData = file_pkg_embedded_embeddedproto_calibration_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_calibration_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_calibration_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_calibration_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_calibration_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_calibration_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_calibration_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_pkg_embedded_embeddedproto_calibration_proto_goTypes = []interface{}{
+	(*Calibration)(nil),         // 0: embeddedproto.Calibration
+	(*CalibrationPoint)(nil),    // 1: embeddedproto.CalibrationPoint
+	(*CalibrationSensor)(nil),   // 2: embeddedproto.CalibrationSensor
+	(*CalibrationConfig)(nil),   // 3: embeddedproto.CalibrationConfig
+	(*CalibrationCommand)(nil),  // 4: embeddedproto.CalibrationCommand
+	(*CalibrationPlateau)(nil),  // 5: embeddedproto.CalibrationPlateau
+	(*CalibrationPreview)(nil),  // 6: embeddedproto.CalibrationPreview
+	(*CalibrationStatuses)(nil), // 7: embeddedproto.CalibrationStatuses
+	(*CalibrationStatus)(nil),   // 8: embeddedproto.CalibrationStatus
+	(*CalibrationRecords)(nil),  // 9: embeddedproto.CalibrationRecords
+	(*CalibrationRecord)(nil),   // 10: embeddedproto.CalibrationRecord
+	(*empty.Empty)(nil),         // 11: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_calibration_proto_depIdxs = []int32{
+	1,  // 0: embeddedproto.Calibration.Points:type_name -> embeddedproto.CalibrationPoint
+	2,  // 1: embeddedproto.CalibrationConfig.Reference:type_name -> embeddedproto.CalibrationSensor
+	2,  // 2: embeddedproto.CalibrationConfig.Targets:type_name -> embeddedproto.CalibrationSensor
+	2,  // 3: embeddedproto.CalibrationPreview.Target:type_name -> embeddedproto.CalibrationSensor
+	0,  // 4: embeddedproto.CalibrationPreview.Calibration:type_name -> embeddedproto.Calibration
+	8,  // 5: embeddedproto.CalibrationStatuses.status:type_name -> embeddedproto.CalibrationStatus
+	5,  // 6: embeddedproto.CalibrationStatus.Plateaus:type_name -> embeddedproto.CalibrationPlateau
+	6,  // 7: embeddedproto.CalibrationStatus.Preview:type_name -> embeddedproto.CalibrationPreview
+	10, // 8: embeddedproto.CalibrationRecords.records:type_name -> embeddedproto.CalibrationRecord
+	2,  // 9: embeddedproto.CalibrationRecord.Sensor:type_name -> embeddedproto.CalibrationSensor
+	2,  // 10: embeddedproto.CalibrationRecord.Reference:type_name -> embeddedproto.CalibrationSensor
+	0,  // 11: embeddedproto.CalibrationRecord.Calibration:type_name -> embeddedproto.Calibration
+	5,  // 12: embeddedproto.CalibrationRecord.Plateaus:type_name -> embeddedproto.CalibrationPlateau
+	3,  // 13: embeddedproto.Calibrator.CalibrationCreate:input_type -> embeddedproto.CalibrationConfig
+	4,  // 14: embeddedproto.Calibrator.CalibrationExecute:input_type -> embeddedproto.CalibrationCommand
+	11, // 15: embeddedproto.Calibrator.CalibrationGetStatus:input_type -> google.protobuf.Empty
+	11, // 16: embeddedproto.Calibrator.CalibrationGetHistory:input_type -> google.protobuf.Empty
+	3,  // 17: embeddedproto.Calibrator.CalibrationCreate:output_type -> embeddedproto.CalibrationConfig
+	4,  // 18: embeddedproto.Calibrator.CalibrationExecute:output_type -> embeddedproto.CalibrationCommand
+	7,  // 19: embeddedproto.Calibrator.CalibrationGetStatus:output_type -> embeddedproto.CalibrationStatuses
+	9,  // 20: embeddedproto.Calibrator.CalibrationGetHistory:output_type -> embeddedproto.CalibrationRecords
+	17, // [17:21] is the sub-list for method output_type
+	13, // [13:17] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_calibration_proto_init() }
+func file_pkg_embedded_embeddedproto_calibration_proto_init() {
+	if File_pkg_embedded_embeddedproto_calibration_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Calibration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationSensor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationPlateau); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_calibration_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_calibration_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_calibration_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_calibration_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_calibration_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_calibration_proto = out.File
+	file_pkg_embedded_embeddedproto_calibration_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_calibration_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_calibration_proto_depIdxs = nil
+}
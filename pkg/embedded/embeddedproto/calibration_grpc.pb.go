@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/calibration.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CalibratorClient is the client API for Calibrator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalibratorClient interface {
+	CalibrationCreate(ctx context.Context, in *CalibrationConfig, opts ...grpc.CallOption) (*CalibrationConfig, error)
+	CalibrationExecute(ctx context.Context, in *CalibrationCommand, opts ...grpc.CallOption) (*CalibrationCommand, error)
+	CalibrationGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CalibrationStatuses, error)
+	CalibrationGetHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CalibrationRecords, error)
+}
+
+type calibratorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalibratorClient(cc grpc.ClientConnInterface) CalibratorClient {
+	return &calibratorClient{cc}
+}
+
+func (c *calibratorClient) CalibrationCreate(ctx context.Context, in *CalibrationConfig, opts ...grpc.CallOption) (*CalibrationConfig, error) {
+	out := new(CalibrationConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Calibrator/CalibrationCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibratorClient) CalibrationExecute(ctx context.Context, in *CalibrationCommand, opts ...grpc.CallOption) (*CalibrationCommand, error) {
+	out := new(CalibrationCommand)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Calibrator/CalibrationExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibratorClient) CalibrationGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CalibrationStatuses, error) {
+	out := new(CalibrationStatuses)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Calibrator/CalibrationGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calibratorClient) CalibrationGetHistory(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CalibrationRecords, error) {
+	out := new(CalibrationRecords)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Calibrator/CalibrationGetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalibratorServer is the server API for Calibrator service.
+// All implementations must embed UnimplementedCalibratorServer
+// for forward compatibility
+type CalibratorServer interface {
+	CalibrationCreate(context.Context, *CalibrationConfig) (*CalibrationConfig, error)
+	CalibrationExecute(context.Context, *CalibrationCommand) (*CalibrationCommand, error)
+	CalibrationGetStatus(context.Context, *empty.Empty) (*CalibrationStatuses, error)
+	CalibrationGetHistory(context.Context, *empty.Empty) (*CalibrationRecords, error)
+	mustEmbedUnimplementedCalibratorServer()
+}
+
+// UnimplementedCalibratorServer must be embedded to have forward compatible implementations.
+type UnimplementedCalibratorServer struct {
+}
+
+func (UnimplementedCalibratorServer) CalibrationCreate(context.Context, *CalibrationConfig) (*CalibrationConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalibrationCreate not implemented")
+}
+func (UnimplementedCalibratorServer) CalibrationExecute(context.Context, *CalibrationCommand) (*CalibrationCommand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalibrationExecute not implemented")
+}
+func (UnimplementedCalibratorServer) CalibrationGetStatus(context.Context, *empty.Empty) (*CalibrationStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalibrationGetStatus not implemented")
+}
+func (UnimplementedCalibratorServer) CalibrationGetHistory(context.Context, *empty.Empty) (*CalibrationRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalibrationGetHistory not implemented")
+}
+func (UnimplementedCalibratorServer) mustEmbedUnimplementedCalibratorServer() {}
+
+// UnsafeCalibratorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalibratorServer will
+// result in compilation errors.
+type UnsafeCalibratorServer interface {
+	mustEmbedUnimplementedCalibratorServer()
+}
+
+func RegisterCalibratorServer(s grpc.ServiceRegistrar, srv CalibratorServer) {
+	s.RegisterService(&Calibrator_ServiceDesc, srv)
+}
+
+func _Calibrator_CalibrationCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalibrationConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibratorServer).CalibrationCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Calibrator/CalibrationCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibratorServer).CalibrationCreate(ctx, req.(*CalibrationConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calibrator_CalibrationExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalibrationCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibratorServer).CalibrationExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Calibrator/CalibrationExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibratorServer).CalibrationExecute(ctx, req.(*CalibrationCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calibrator_CalibrationGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibratorServer).CalibrationGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Calibrator/CalibrationGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibratorServer).CalibrationGetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calibrator_CalibrationGetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalibratorServer).CalibrationGetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Calibrator/CalibrationGetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalibratorServer).CalibrationGetHistory(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Calibrator_ServiceDesc is the grpc.ServiceDesc for Calibrator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Calibrator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.Calibrator",
+	HandlerType: (*CalibratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalibrationCreate",
+			Handler:    _Calibrator_CalibrationCreate_Handler,
+		},
+		{
+			MethodName: "CalibrationExecute",
+			Handler:    _Calibrator_CalibrationExecute_Handler,
+		},
+		{
+			MethodName: "CalibrationGetStatus",
+			Handler:    _Calibrator_CalibrationGetStatus_Handler,
+		},
+		{
+			MethodName: "CalibrationGetHistory",
+			Handler:    _Calibrator_CalibrationGetHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/calibration.proto",
+}
@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/counter.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CounterID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CounterID) Reset() {
+	*x = CounterID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterID) ProtoMessage() {}
+
+func (x *CounterID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterID.ProtoReflect.Descriptor instead.
+func (*CounterID) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CounterID) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type CounterConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*CounterConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *CounterConfigs) Reset() {
+	*x = CounterConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterConfigs) ProtoMessage() {}
+
+func (x *CounterConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterConfigs.ProtoReflect.Descriptor instead.
+func (*CounterConfigs) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CounterConfigs) GetConfigs() []*CounterConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type CounterConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name           string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	PulsesPerLitre float64 `protobuf:"fixed64,3,opt,name=PulsesPerLitre,proto3" json:"PulsesPerLitre,omitempty"`
+	Window         int64   `protobuf:"varint,4,opt,name=Window,proto3" json:"Window,omitempty"`
+}
+
+func (x *CounterConfig) Reset() {
+	*x = CounterConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterConfig) ProtoMessage() {}
+
+func (x *CounterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterConfig.ProtoReflect.Descriptor instead.
+func (*CounterConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CounterConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CounterConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CounterConfig) GetPulsesPerLitre() float64 {
+	if x != nil {
+		return x.PulsesPerLitre
+	}
+	return 0
+}
+
+func (x *CounterConfig) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type CounterStatusList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []*CounterStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CounterStatusList) Reset() {
+	*x = CounterStatusList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterStatusList) ProtoMessage() {}
+
+func (x *CounterStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterStatusList.ProtoReflect.Descriptor instead.
+func (*CounterStatusList) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CounterStatusList) GetStatus() []*CounterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CounterStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartedMillis   int64   `protobuf:"varint,2,opt,name=StartedMillis,proto3" json:"StartedMillis,omitempty"`
+	Count           uint64  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	LastPulseMillis int64   `protobuf:"varint,4,opt,name=LastPulseMillis,proto3" json:"LastPulseMillis,omitempty"`
+	Frequency       float64 `protobuf:"fixed64,5,opt,name=Frequency,proto3" json:"Frequency,omitempty"`
+	Flow            float64 `protobuf:"fixed64,6,opt,name=Flow,proto3" json:"Flow,omitempty"`
+	ResetMillis     int64   `protobuf:"varint,7,opt,name=ResetMillis,proto3" json:"ResetMillis,omitempty"`
+	CountSinceReset uint64  `protobuf:"varint,8,opt,name=CountSinceReset,proto3" json:"CountSinceReset,omitempty"`
+	Total           float64 `protobuf:"fixed64,9,opt,name=Total,proto3" json:"Total,omitempty"`
+}
+
+func (x *CounterStatus) Reset() {
+	*x = CounterStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterStatus) ProtoMessage() {}
+
+func (x *CounterStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_counter_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterStatus.ProtoReflect.Descriptor instead.
+func (*CounterStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CounterStatus) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CounterStatus) GetStartedMillis() int64 {
+	if x != nil {
+		return x.StartedMillis
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetLastPulseMillis() int64 {
+	if x != nil {
+		return x.LastPulseMillis
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetFlow() float64 {
+	if x != nil {
+		return x.Flow
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetResetMillis() int64 {
+	if x != nil {
+		return x.ResetMillis
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetCountSinceReset() uint64 {
+	if x != nil {
+		return x.CountSinceReset
+	}
+	return 0
+}
+
+func (x *CounterStatus) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_pkg_embedded_embeddedproto_counter_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_counter_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x73, 0x0a,
+	0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4c,
+	0x69, 0x74, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x50, 0x75, 0x6c, 0x73,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x69, 0x74, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x22, 0x49, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x99, 0x02,
+	0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x24, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x4c,
+	0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x6c, 0x73, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0xbc, 0x02, 0x0a, 0x07, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x12, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1c,
+	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x18,
+	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_counter_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_counter_proto_rawDescData = file_pkg_embedded_embeddedproto_counter_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_counter_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_counter_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_counter_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_counter_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_counter_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_counter_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_embedded_embeddedproto_counter_proto_goTypes = []interface{}{
+	(*CounterID)(nil),         // 0: embeddedproto.CounterID
+	(*CounterConfigs)(nil),    // 1: embeddedproto.CounterConfigs
+	(*CounterConfig)(nil),     // 2: embeddedproto.CounterConfig
+	(*CounterStatusList)(nil), // 3: embeddedproto.CounterStatusList
+	(*CounterStatus)(nil),     // 4: embeddedproto.CounterStatus
+	(*empty.Empty)(nil),       // 5: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_counter_proto_depIdxs = []int32{
+	2, // 0: embeddedproto.CounterConfigs.configs:type_name -> embeddedproto.CounterConfig
+	4, // 1: embeddedproto.CounterStatusList.status:type_name -> embeddedproto.CounterStatus
+	5, // 2: embeddedproto.Counter.CounterGet:input_type -> google.protobuf.Empty
+	2, // 3: embeddedproto.Counter.CounterConfigure:input_type -> embeddedproto.CounterConfig
+	5, // 4: embeddedproto.Counter.CounterGetStatus:input_type -> google.protobuf.Empty
+	0, // 5: embeddedproto.Counter.CounterReset:input_type -> embeddedproto.CounterID
+	1, // 6: embeddedproto.Counter.CounterGet:output_type -> embeddedproto.CounterConfigs
+	2, // 7: embeddedproto.Counter.CounterConfigure:output_type -> embeddedproto.CounterConfig
+	3, // 8: embeddedproto.Counter.CounterGetStatus:output_type -> embeddedproto.CounterStatusList
+	4, // 9: embeddedproto.Counter.CounterReset:output_type -> embeddedproto.CounterStatus
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_counter_proto_init() }
+func file_pkg_embedded_embeddedproto_counter_proto_init() {
+	if File_pkg_embedded_embeddedproto_counter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_counter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_counter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_counter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_counter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterStatusList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_counter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_counter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_counter_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_counter_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_counter_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_counter_proto = out.File
+	file_pkg_embedded_embeddedproto_counter_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_counter_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_counter_proto_depIdxs = nil
+}
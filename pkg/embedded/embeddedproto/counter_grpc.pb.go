@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/counter.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CounterClient is the client API for Counter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CounterClient interface {
+	CounterGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CounterConfigs, error)
+	CounterConfigure(ctx context.Context, in *CounterConfig, opts ...grpc.CallOption) (*CounterConfig, error)
+	CounterGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CounterStatusList, error)
+	CounterReset(ctx context.Context, in *CounterID, opts ...grpc.CallOption) (*CounterStatus, error)
+}
+
+type counterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCounterClient(cc grpc.ClientConnInterface) CounterClient {
+	return &counterClient{cc}
+}
+
+func (c *counterClient) CounterGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CounterConfigs, error) {
+	out := new(CounterConfigs)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Counter/CounterGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *counterClient) CounterConfigure(ctx context.Context, in *CounterConfig, opts ...grpc.CallOption) (*CounterConfig, error) {
+	out := new(CounterConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Counter/CounterConfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *counterClient) CounterGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CounterStatusList, error) {
+	out := new(CounterStatusList)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Counter/CounterGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *counterClient) CounterReset(ctx context.Context, in *CounterID, opts ...grpc.CallOption) (*CounterStatus, error) {
+	out := new(CounterStatus)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Counter/CounterReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CounterServer is the server API for Counter service.
+// All implementations must embed UnimplementedCounterServer
+// for forward compatibility
+type CounterServer interface {
+	CounterGet(context.Context, *empty.Empty) (*CounterConfigs, error)
+	CounterConfigure(context.Context, *CounterConfig) (*CounterConfig, error)
+	CounterGetStatus(context.Context, *empty.Empty) (*CounterStatusList, error)
+	CounterReset(context.Context, *CounterID) (*CounterStatus, error)
+	mustEmbedUnimplementedCounterServer()
+}
+
+// UnimplementedCounterServer must be embedded to have forward compatible implementations.
+type UnimplementedCounterServer struct {
+}
+
+func (UnimplementedCounterServer) CounterGet(context.Context, *empty.Empty) (*CounterConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterGet not implemented")
+}
+func (UnimplementedCounterServer) CounterConfigure(context.Context, *CounterConfig) (*CounterConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterConfigure not implemented")
+}
+func (UnimplementedCounterServer) CounterGetStatus(context.Context, *empty.Empty) (*CounterStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterGetStatus not implemented")
+}
+func (UnimplementedCounterServer) CounterReset(context.Context, *CounterID) (*CounterStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterReset not implemented")
+}
+func (UnimplementedCounterServer) mustEmbedUnimplementedCounterServer() {}
+
+// UnsafeCounterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CounterServer will
+// result in compilation errors.
+type UnsafeCounterServer interface {
+	mustEmbedUnimplementedCounterServer()
+}
+
+func RegisterCounterServer(s grpc.ServiceRegistrar, srv CounterServer) {
+	s.RegisterService(&Counter_ServiceDesc, srv)
+}
+
+func _Counter_CounterGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).CounterGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Counter/CounterGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).CounterGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Counter_CounterConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).CounterConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Counter/CounterConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).CounterConfigure(ctx, req.(*CounterConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Counter_CounterGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).CounterGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Counter/CounterGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).CounterGetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Counter_CounterReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServer).CounterReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Counter/CounterReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServer).CounterReset(ctx, req.(*CounterID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Counter_ServiceDesc is the grpc.ServiceDesc for Counter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Counter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.Counter",
+	HandlerType: (*CounterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CounterGet",
+			Handler:    _Counter_CounterGet_Handler,
+		},
+		{
+			MethodName: "CounterConfigure",
+			Handler:    _Counter_CounterConfigure_Handler,
+		},
+		{
+			MethodName: "CounterGetStatus",
+			Handler:    _Counter_CounterGetStatus_Handler,
+		},
+		{
+			MethodName: "CounterReset",
+			Handler:    _Counter_CounterReset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/counter.proto",
+}
@@ -73,13 +73,20 @@ type DSConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID           string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name         string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Correction   float32 `protobuf:"fixed32,3,opt,name=Correction,proto3" json:"Correction,omitempty"`
-	Resolution   int32   `protobuf:"varint,4,opt,name=Resolution,proto3" json:"Resolution,omitempty"`
-	PollInterval int32   `protobuf:"varint,5,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
-	Samples      uint32  `protobuf:"varint,6,opt,name=Samples,proto3" json:"Samples,omitempty"`
-	Enabled      bool    `protobuf:"varint,7,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	ID           string       `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string       `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Correction   float32      `protobuf:"fixed32,3,opt,name=Correction,proto3" json:"Correction,omitempty"`
+	Resolution   int32        `protobuf:"varint,4,opt,name=Resolution,proto3" json:"Resolution,omitempty"`
+	PollInterval int32        `protobuf:"varint,5,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
+	Samples      uint32       `protobuf:"varint,6,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	Enabled      bool         `protobuf:"varint,7,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Missing      bool         `protobuf:"varint,8,opt,name=Missing,proto3" json:"Missing,omitempty"`
+	AlarmLow     int32        `protobuf:"varint,9,opt,name=AlarmLow,proto3" json:"AlarmLow,omitempty"`
+	AlarmHigh    int32        `protobuf:"varint,10,opt,name=AlarmHigh,proto3" json:"AlarmHigh,omitempty"`
+	Persist      bool         `protobuf:"varint,11,opt,name=Persist,proto3" json:"Persist,omitempty"`
+	Parasitic    bool         `protobuf:"varint,12,opt,name=Parasitic,proto3" json:"Parasitic,omitempty"`
+	Calibration  *Calibration `protobuf:"bytes,13,opt,name=Calibration,proto3" json:"Calibration,omitempty"`
+	Filters      []*Filter    `protobuf:"bytes,14,rep,name=Filters,proto3" json:"Filters,omitempty"`
 }
 
 func (x *DSConfig) Reset() {
@@ -163,6 +170,55 @@ func (x *DSConfig) GetEnabled() bool {
 	return false
 }
 
+func (x *DSConfig) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *DSConfig) GetAlarmLow() int32 {
+	if x != nil {
+		return x.AlarmLow
+	}
+	return 0
+}
+
+func (x *DSConfig) GetAlarmHigh() int32 {
+	if x != nil {
+		return x.AlarmHigh
+	}
+	return 0
+}
+
+func (x *DSConfig) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+func (x *DSConfig) GetParasitic() bool {
+	if x != nil {
+		return x.Parasitic
+	}
+	return false
+}
+
+func (x *DSConfig) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+func (x *DSConfig) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type DSTemperatures struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -267,6 +323,8 @@ type DSReadings struct {
 	Average     float32 `protobuf:"fixed32,3,opt,name=Average,proto3" json:"Average,omitempty"`
 	StampMillis int64   `protobuf:"varint,4,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
 	Error       string  `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	Alarm       bool    `protobuf:"varint,6,opt,name=Alarm,proto3" json:"Alarm,omitempty"`
+	Filtered    float32 `protobuf:"fixed32,7,opt,name=Filtered,proto3" json:"Filtered,omitempty"`
 }
 
 func (x *DSReadings) Reset() {
@@ -336,6 +394,311 @@ func (x *DSReadings) GetError() string {
 	return ""
 }
 
+func (x *DSReadings) GetAlarm() bool {
+	if x != nil {
+		return x.Alarm
+	}
+	return false
+}
+
+func (x *DSReadings) GetFiltered() float32 {
+	if x != nil {
+		return x.Filtered
+	}
+	return 0
+}
+
+type DSEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DSEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *DSEvents) Reset() {
+	*x = DSEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DSEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSEvents) ProtoMessage() {}
+
+func (x *DSEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSEvents.ProtoReflect.Descriptor instead.
+func (*DSEvents) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DSEvents) GetEvents() []*DSEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type DSEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Bus         string `protobuf:"bytes,2,opt,name=Bus,proto3" json:"Bus,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	StampMillis int64  `protobuf:"varint,4,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+}
+
+func (x *DSEvent) Reset() {
+	*x = DSEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DSEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSEvent) ProtoMessage() {}
+
+func (x *DSEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSEvent.ProtoReflect.Descriptor instead.
+func (*DSEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DSEvent) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *DSEvent) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *DSEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DSEvent) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+type DSAlarms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []string `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *DSAlarms) Reset() {
+	*x = DSAlarms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DSAlarms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSAlarms) ProtoMessage() {}
+
+func (x *DSAlarms) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSAlarms.ProtoReflect.Descriptor instead.
+func (*DSAlarms) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DSAlarms) GetIDs() []string {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+type DSDevices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*DSDevice `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *DSDevices) Reset() {
+	*x = DSDevices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DSDevices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSDevices) ProtoMessage() {}
+
+func (x *DSDevices) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSDevices.ProtoReflect.Descriptor instead.
+func (*DSDevices) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DSDevices) GetDevices() []*DSDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type DSDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bus       string `protobuf:"bytes,1,opt,name=Bus,proto3" json:"Bus,omitempty"`
+	ID        string `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Family    string `protobuf:"bytes,3,opt,name=Family,proto3" json:"Family,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=Name,proto3" json:"Name,omitempty"`
+	Supported bool   `protobuf:"varint,5,opt,name=Supported,proto3" json:"Supported,omitempty"`
+}
+
+func (x *DSDevice) Reset() {
+	*x = DSDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DSDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSDevice) ProtoMessage() {}
+
+func (x *DSDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSDevice.ProtoReflect.Descriptor instead.
+func (*DSDevice) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DSDevice) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *DSDevice) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *DSDevice) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *DSDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DSDevice) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
 var File_pkg_embedded_embeddedproto_ds18b20_proto protoreflect.FileDescriptor
 
 var file_pkg_embedded_embeddedproto_ds18b20_proto_rawDesc = []byte{
@@ -344,59 +707,123 @@ var file_pkg_embedded_embeddedproto_ds18b20_proto_rawDesc = []byte{
 	0x38, 0x62, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65,
 	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x08, 0x44, 0x53, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x43, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x50,
-	0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x53, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0x44, 0x0a, 0x0e, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x05,
-	0x74, 0x65, 0x6d, 0x70, 0x73, 0x22, 0x46, 0x0a, 0x0d, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
-	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x52, 0x65, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x90, 0x01,
-	0x0a, 0x0a, 0x44, 0x53, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
-	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d,
-	0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53,
-	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x32, 0xd2, 0x01, 0x0a, 0x02, 0x44, 0x53, 0x12, 0x3b, 0x0a, 0x05, 0x44, 0x53, 0x47, 0x65, 0x74,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
-	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e, 0x65,
-	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x44, 0x53, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d, 0x62,
-	0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
 	0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a,
+	0x09, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xc1, 0x03,
+	0x0a, 0x08, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x4c, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x6c, 0x61, 0x72, 0x6d, 0x48, 0x69, 0x67, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x48, 0x69, 0x67, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x61, 0x73, 0x69, 0x74, 0x69,
+	0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x50, 0x61, 0x72, 0x61, 0x73, 0x69, 0x74,
+	0x69, 0x63, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x44, 0x0a, 0x0e, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x73, 0x22, 0x46, 0x0a, 0x0d, 0x44, 0x53, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0xc2, 0x01, 0x0a, 0x0a, 0x44, 0x53, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x08, 0x44, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x61, 0x0a, 0x07, 0x44, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x42,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x44, 0x53, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x44,
+	0x73, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x76, 0x0a, 0x08, 0x44, 0x53, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x42, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42, 0x75, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x32, 0xdb, 0x03, 0x0a, 0x02, 0x44, 0x53,
+	0x12, 0x3b, 0x0a, 0x05, 0x44, 0x53, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x0b, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x11, 0x44, 0x53, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0b, 0x44, 0x53, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0b, 0x44, 0x53, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x73,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x09, 0x44, 0x53, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12,
+	0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x44, 0x53, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x53, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -411,30 +838,49 @@ func file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescGZIP() []byte {
 	return file_pkg_embedded_embeddedproto_ds18b20_proto_rawDescData
 }
 
-var file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_pkg_embedded_embeddedproto_ds18b20_proto_goTypes = []interface{}{
 	(*DSConfigs)(nil),      // 0: embeddedproto.DSConfigs
 	(*DSConfig)(nil),       // 1: embeddedproto.DSConfig
 	(*DSTemperatures)(nil), // 2: embeddedproto.DSTemperatures
 	(*DSTemperature)(nil),  // 3: embeddedproto.DSTemperature
 	(*DSReadings)(nil),     // 4: embeddedproto.DSReadings
-	(*empty.Empty)(nil),    // 5: google.protobuf.Empty
+	(*DSEvents)(nil),       // 5: embeddedproto.DSEvents
+	(*DSEvent)(nil),        // 6: embeddedproto.DSEvent
+	(*DSAlarms)(nil),       // 7: embeddedproto.DSAlarms
+	(*DSDevices)(nil),      // 8: embeddedproto.DSDevices
+	(*DSDevice)(nil),       // 9: embeddedproto.DSDevice
+	(*Calibration)(nil),    // 10: embeddedproto.Calibration
+	(*Filter)(nil),         // 11: embeddedproto.Filter
+	(*empty.Empty)(nil),    // 12: google.protobuf.Empty
 }
 var file_pkg_embedded_embeddedproto_ds18b20_proto_depIdxs = []int32{
-	1, // 0: embeddedproto.DSConfigs.configs:type_name -> embeddedproto.DSConfig
-	3, // 1: embeddedproto.DSTemperatures.temps:type_name -> embeddedproto.DSTemperature
-	4, // 2: embeddedproto.DSTemperature.readings:type_name -> embeddedproto.DSReadings
-	5, // 3: embeddedproto.DS.DSGet:input_type -> google.protobuf.Empty
-	1, // 4: embeddedproto.DS.DSConfigure:input_type -> embeddedproto.DSConfig
-	5, // 5: embeddedproto.DS.DSGetTemperatures:input_type -> google.protobuf.Empty
-	0, // 6: embeddedproto.DS.DSGet:output_type -> embeddedproto.DSConfigs
-	1, // 7: embeddedproto.DS.DSConfigure:output_type -> embeddedproto.DSConfig
-	2, // 8: embeddedproto.DS.DSGetTemperatures:output_type -> embeddedproto.DSTemperatures
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: embeddedproto.DSConfigs.configs:type_name -> embeddedproto.DSConfig
+	10, // 1: embeddedproto.DSConfig.Calibration:type_name -> embeddedproto.Calibration
+	11, // 2: embeddedproto.DSConfig.Filters:type_name -> embeddedproto.Filter
+	3,  // 3: embeddedproto.DSTemperatures.temps:type_name -> embeddedproto.DSTemperature
+	4,  // 4: embeddedproto.DSTemperature.readings:type_name -> embeddedproto.DSReadings
+	6,  // 5: embeddedproto.DSEvents.events:type_name -> embeddedproto.DSEvent
+	9,  // 6: embeddedproto.DSDevices.devices:type_name -> embeddedproto.DSDevice
+	12, // 7: embeddedproto.DS.DSGet:input_type -> google.protobuf.Empty
+	1,  // 8: embeddedproto.DS.DSConfigure:input_type -> embeddedproto.DSConfig
+	12, // 9: embeddedproto.DS.DSGetTemperatures:input_type -> google.protobuf.Empty
+	12, // 10: embeddedproto.DS.DSGetEvents:input_type -> google.protobuf.Empty
+	12, // 11: embeddedproto.DS.DSGetAlarms:input_type -> google.protobuf.Empty
+	1,  // 12: embeddedproto.DS.DSRestore:input_type -> embeddedproto.DSConfig
+	12, // 13: embeddedproto.DS.DSGetDevices:input_type -> google.protobuf.Empty
+	0,  // 14: embeddedproto.DS.DSGet:output_type -> embeddedproto.DSConfigs
+	1,  // 15: embeddedproto.DS.DSConfigure:output_type -> embeddedproto.DSConfig
+	2,  // 16: embeddedproto.DS.DSGetTemperatures:output_type -> embeddedproto.DSTemperatures
+	5,  // 17: embeddedproto.DS.DSGetEvents:output_type -> embeddedproto.DSEvents
+	7,  // 18: embeddedproto.DS.DSGetAlarms:output_type -> embeddedproto.DSAlarms
+	1,  // 19: embeddedproto.DS.DSRestore:output_type -> embeddedproto.DSConfig
+	8,  // 20: embeddedproto.DS.DSGetDevices:output_type -> embeddedproto.DSDevices
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_embedded_embeddedproto_ds18b20_proto_init() }
@@ -442,6 +888,8 @@ func file_pkg_embedded_embeddedproto_ds18b20_proto_init() {
 	if File_pkg_embedded_embeddedproto_ds18b20_proto != nil {
 		return
 	}
+	file_pkg_embedded_embeddedproto_calibration_proto_init()
+	file_pkg_embedded_embeddedproto_filter_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DSConfigs); i {
@@ -503,6 +951,66 @@ func file_pkg_embedded_embeddedproto_ds18b20_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DSEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DSEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DSAlarms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DSDevices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_ds18b20_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DSDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -510,7 +1018,7 @@ func file_pkg_embedded_embeddedproto_ds18b20_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_embedded_embeddedproto_ds18b20_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,10 @@ type DSClient interface {
 	DSGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSConfigs, error)
 	DSConfigure(ctx context.Context, in *DSConfig, opts ...grpc.CallOption) (*DSConfig, error)
 	DSGetTemperatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSTemperatures, error)
+	DSGetEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSEvents, error)
+	DSGetAlarms(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSAlarms, error)
+	DSRestore(ctx context.Context, in *DSConfig, opts ...grpc.CallOption) (*DSConfig, error)
+	DSGetDevices(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSDevices, error)
 }
 
 type dSClient struct {
@@ -63,6 +67,42 @@ func (c *dSClient) DSGetTemperatures(ctx context.Context, in *empty.Empty, opts
 	return out, nil
 }
 
+func (c *dSClient) DSGetEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSEvents, error) {
+	out := new(DSEvents)
+	err := c.cc.Invoke(ctx, "/embeddedproto.DS/DSGetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSClient) DSGetAlarms(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSAlarms, error) {
+	out := new(DSAlarms)
+	err := c.cc.Invoke(ctx, "/embeddedproto.DS/DSGetAlarms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSClient) DSRestore(ctx context.Context, in *DSConfig, opts ...grpc.CallOption) (*DSConfig, error) {
+	out := new(DSConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.DS/DSRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSClient) DSGetDevices(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DSDevices, error) {
+	out := new(DSDevices)
+	err := c.cc.Invoke(ctx, "/embeddedproto.DS/DSGetDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DSServer is the server API for DS service.
 // All implementations must embed UnimplementedDSServer
 // for forward compatibility
@@ -70,6 +110,10 @@ type DSServer interface {
 	DSGet(context.Context, *empty.Empty) (*DSConfigs, error)
 	DSConfigure(context.Context, *DSConfig) (*DSConfig, error)
 	DSGetTemperatures(context.Context, *empty.Empty) (*DSTemperatures, error)
+	DSGetEvents(context.Context, *empty.Empty) (*DSEvents, error)
+	DSGetAlarms(context.Context, *empty.Empty) (*DSAlarms, error)
+	DSRestore(context.Context, *DSConfig) (*DSConfig, error)
+	DSGetDevices(context.Context, *empty.Empty) (*DSDevices, error)
 	mustEmbedUnimplementedDSServer()
 }
 
@@ -86,6 +130,18 @@ func (UnimplementedDSServer) DSConfigure(context.Context, *DSConfig) (*DSConfig,
 func (UnimplementedDSServer) DSGetTemperatures(context.Context, *empty.Empty) (*DSTemperatures, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DSGetTemperatures not implemented")
 }
+func (UnimplementedDSServer) DSGetEvents(context.Context, *empty.Empty) (*DSEvents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DSGetEvents not implemented")
+}
+func (UnimplementedDSServer) DSGetAlarms(context.Context, *empty.Empty) (*DSAlarms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DSGetAlarms not implemented")
+}
+func (UnimplementedDSServer) DSRestore(context.Context, *DSConfig) (*DSConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DSRestore not implemented")
+}
+func (UnimplementedDSServer) DSGetDevices(context.Context, *empty.Empty) (*DSDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DSGetDevices not implemented")
+}
 func (UnimplementedDSServer) mustEmbedUnimplementedDSServer() {}
 
 // UnsafeDSServer may be embedded to opt out of forward compatibility for this service.
@@ -153,6 +209,78 @@ func _DS_DSGetTemperatures_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DS_DSGetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSServer).DSGetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.DS/DSGetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSServer).DSGetEvents(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DS_DSGetAlarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSServer).DSGetAlarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.DS/DSGetAlarms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSServer).DSGetAlarms(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DS_DSRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DSConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSServer).DSRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.DS/DSRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSServer).DSRestore(ctx, req.(*DSConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DS_DSGetDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSServer).DSGetDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.DS/DSGetDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSServer).DSGetDevices(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DS_ServiceDesc is the grpc.ServiceDesc for DS service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -172,6 +300,22 @@ var DS_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DSGetTemperatures",
 			Handler:    _DS_DSGetTemperatures_Handler,
 		},
+		{
+			MethodName: "DSGetEvents",
+			Handler:    _DS_DSGetEvents_Handler,
+		},
+		{
+			MethodName: "DSGetAlarms",
+			Handler:    _DS_DSGetAlarms_Handler,
+		},
+		{
+			MethodName: "DSRestore",
+			Handler:    _DS_DSRestore_Handler,
+		},
+		{
+			MethodName: "DSGetDevices",
+			Handler:    _DS_DSGetDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/embedded/embeddedproto/ds18b20.proto",
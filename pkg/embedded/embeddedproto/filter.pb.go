@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/filter.proto
+
+package embeddedproto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             string  `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Alpha            float64 `protobuf:"fixed64,2,opt,name=Alpha,proto3" json:"Alpha,omitempty"`
+	Window           uint32  `protobuf:"varint,3,opt,name=Window,proto3" json:"Window,omitempty"`
+	MaxDelta         float64 `protobuf:"fixed64,4,opt,name=MaxDelta,proto3" json:"MaxDelta,omitempty"`
+	MaxRejected      uint32  `protobuf:"varint,5,opt,name=MaxRejected,proto3" json:"MaxRejected,omitempty"`
+	ProcessNoise     float64 `protobuf:"fixed64,6,opt,name=ProcessNoise,proto3" json:"ProcessNoise,omitempty"`
+	MeasurementNoise float64 `protobuf:"fixed64,7,opt,name=MeasurementNoise,proto3" json:"MeasurementNoise,omitempty"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_filter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_filter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Filter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Filter) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *Filter) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Filter) GetMaxDelta() float64 {
+	if x != nil {
+		return x.MaxDelta
+	}
+	return 0
+}
+
+func (x *Filter) GetMaxRejected() uint32 {
+	if x != nil {
+		return x.MaxRejected
+	}
+	return 0
+}
+
+func (x *Filter) GetProcessNoise() float64 {
+	if x != nil {
+		return x.ProcessNoise
+	}
+	return 0
+}
+
+func (x *Filter) GetMeasurementNoise() float64 {
+	if x != nil {
+		return x.MeasurementNoise
+	}
+	return 0
+}
+
+var File_pkg_embedded_embeddedproto_filter_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_filter_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x70, 0x68, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x6f,
+	0x69, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x69, 0x73, 0x65, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_filter_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_filter_proto_rawDescData = file_pkg_embedded_embeddedproto_filter_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_filter_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_filter_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_filter_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_filter_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_filter_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_embedded_embeddedproto_filter_proto_goTypes = []interface{}{
+	(*Filter)(nil), // 0: embeddedproto.Filter
+}
+var file_pkg_embedded_embeddedproto_filter_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_filter_proto_init() }
+func file_pkg_embedded_embeddedproto_filter_proto_init() {
+	if File_pkg_embedded_embeddedproto_filter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_filter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_filter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_filter_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_filter_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_filter_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_filter_proto = out.File
+	file_pkg_embedded_embeddedproto_filter_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_filter_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_filter_proto_depIdxs = nil
+}
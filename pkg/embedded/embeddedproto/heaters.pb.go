@@ -73,9 +73,13 @@ type HeaterConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Enabled bool   `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
-	Power   uint32 `protobuf:"varint,3,opt,name=Power,proto3" json:"Power,omitempty"`
+	ID             string            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Enabled        bool              `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Power          uint32            `protobuf:"varint,3,opt,name=Power,proto3" json:"Power,omitempty"`
+	Modulation     *HeaterModulation `protobuf:"bytes,4,opt,name=Modulation,proto3" json:"Modulation,omitempty"`
+	Fault          *HeaterFault      `protobuf:"bytes,5,opt,name=Fault,proto3" json:"Fault,omitempty"`
+	EffectivePower float64           `protobuf:"fixed64,6,opt,name=EffectivePower,proto3" json:"EffectivePower,omitempty"`
+	RampRate       float64           `protobuf:"fixed64,7,opt,name=RampRate,proto3" json:"RampRate,omitempty"`
 }
 
 func (x *HeaterConfig) Reset() {
@@ -131,6 +135,657 @@ func (x *HeaterConfig) GetPower() uint32 {
 	return 0
 }
 
+func (x *HeaterConfig) GetModulation() *HeaterModulation {
+	if x != nil {
+		return x.Modulation
+	}
+	return nil
+}
+
+func (x *HeaterConfig) GetFault() *HeaterFault {
+	if x != nil {
+		return x.Fault
+	}
+	return nil
+}
+
+func (x *HeaterConfig) GetEffectivePower() float64 {
+	if x != nil {
+		return x.EffectivePower
+	}
+	return 0
+}
+
+func (x *HeaterConfig) GetRampRate() float64 {
+	if x != nil {
+		return x.RampRate
+	}
+	return 0
+}
+
+type HeaterID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *HeaterID) Reset() {
+	*x = HeaterID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterID) ProtoMessage() {}
+
+func (x *HeaterID) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterID.ProtoReflect.Descriptor instead.
+func (*HeaterID) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HeaterID) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type HeaterPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID    string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Power float64 `protobuf:"fixed64,2,opt,name=Power,proto3" json:"Power,omitempty"`
+}
+
+func (x *HeaterPower) Reset() {
+	*x = HeaterPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterPower) ProtoMessage() {}
+
+func (x *HeaterPower) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterPower.ProtoReflect.Descriptor instead.
+func (*HeaterPower) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HeaterPower) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *HeaterPower) GetPower() float64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+type HeaterFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastError    string `protobuf:"bytes,1,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	Count        uint32 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Consecutive  uint32 `protobuf:"varint,3,opt,name=Consecutive,proto3" json:"Consecutive,omitempty"`
+	FirstMillis  int64  `protobuf:"varint,4,opt,name=FirstMillis,proto3" json:"FirstMillis,omitempty"`
+	LastMillis   int64  `protobuf:"varint,5,opt,name=LastMillis,proto3" json:"LastMillis,omitempty"`
+	AutoDisabled bool   `protobuf:"varint,6,opt,name=AutoDisabled,proto3" json:"AutoDisabled,omitempty"`
+}
+
+func (x *HeaterFault) Reset() {
+	*x = HeaterFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterFault) ProtoMessage() {}
+
+func (x *HeaterFault) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterFault.ProtoReflect.Descriptor instead.
+func (*HeaterFault) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HeaterFault) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *HeaterFault) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HeaterFault) GetConsecutive() uint32 {
+	if x != nil {
+		return x.Consecutive
+	}
+	return 0
+}
+
+func (x *HeaterFault) GetFirstMillis() int64 {
+	if x != nil {
+		return x.FirstMillis
+	}
+	return 0
+}
+
+func (x *HeaterFault) GetLastMillis() int64 {
+	if x != nil {
+		return x.LastMillis
+	}
+	return 0
+}
+
+func (x *HeaterFault) GetAutoDisabled() bool {
+	if x != nil {
+		return x.AutoDisabled
+	}
+	return false
+}
+
+type HeaterModulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode       string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Window     uint32 `protobuf:"varint,2,opt,name=Window,proto3" json:"Window,omitempty"`
+	Resolution uint32 `protobuf:"varint,3,opt,name=Resolution,proto3" json:"Resolution,omitempty"`
+}
+
+func (x *HeaterModulation) Reset() {
+	*x = HeaterModulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterModulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterModulation) ProtoMessage() {}
+
+func (x *HeaterModulation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterModulation.ProtoReflect.Descriptor instead.
+func (*HeaterModulation) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HeaterModulation) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *HeaterModulation) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *HeaterModulation) GetResolution() uint32 {
+	if x != nil {
+		return x.Resolution
+	}
+	return 0
+}
+
+type HeaterStatsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*HeaterStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *HeaterStatsList) Reset() {
+	*x = HeaterStatsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterStatsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterStatsList) ProtoMessage() {}
+
+func (x *HeaterStatsList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterStatsList.ProtoReflect.Descriptor instead.
+func (*HeaterStatsList) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HeaterStatsList) GetStats() []*HeaterStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type HeaterGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*HeaterGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *HeaterGroupList) Reset() {
+	*x = HeaterGroupList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterGroupList) ProtoMessage() {}
+
+func (x *HeaterGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterGroupList.ProtoReflect.Descriptor instead.
+func (*HeaterGroupList) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HeaterGroupList) GetGroups() []*HeaterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type HeaterGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string               `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	MaxPower  float64              `protobuf:"fixed64,2,opt,name=MaxPower,proto3" json:"MaxPower,omitempty"`
+	MaxWatts  float64              `protobuf:"fixed64,3,opt,name=MaxWatts,proto3" json:"MaxWatts,omitempty"`
+	Scaling   string               `protobuf:"bytes,4,opt,name=Scaling,proto3" json:"Scaling,omitempty"`
+	Requested float64              `protobuf:"fixed64,5,opt,name=Requested,proto3" json:"Requested,omitempty"`
+	Allocated float64              `protobuf:"fixed64,6,opt,name=Allocated,proto3" json:"Allocated,omitempty"`
+	Limited   bool                 `protobuf:"varint,7,opt,name=Limited,proto3" json:"Limited,omitempty"`
+	Members   []*HeaterGroupMember `protobuf:"bytes,8,rep,name=Members,proto3" json:"Members,omitempty"`
+}
+
+func (x *HeaterGroup) Reset() {
+	*x = HeaterGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterGroup) ProtoMessage() {}
+
+func (x *HeaterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterGroup.ProtoReflect.Descriptor instead.
+func (*HeaterGroup) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HeaterGroup) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *HeaterGroup) GetMaxPower() float64 {
+	if x != nil {
+		return x.MaxPower
+	}
+	return 0
+}
+
+func (x *HeaterGroup) GetMaxWatts() float64 {
+	if x != nil {
+		return x.MaxWatts
+	}
+	return 0
+}
+
+func (x *HeaterGroup) GetScaling() string {
+	if x != nil {
+		return x.Scaling
+	}
+	return ""
+}
+
+func (x *HeaterGroup) GetRequested() float64 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *HeaterGroup) GetAllocated() float64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *HeaterGroup) GetLimited() bool {
+	if x != nil {
+		return x.Limited
+	}
+	return false
+}
+
+func (x *HeaterGroup) GetMembers() []*HeaterGroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type HeaterGroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Requested float64 `protobuf:"fixed64,2,opt,name=Requested,proto3" json:"Requested,omitempty"`
+	Allocated float64 `protobuf:"fixed64,3,opt,name=Allocated,proto3" json:"Allocated,omitempty"`
+}
+
+func (x *HeaterGroupMember) Reset() {
+	*x = HeaterGroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterGroupMember) ProtoMessage() {}
+
+func (x *HeaterGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterGroupMember.ProtoReflect.Descriptor instead.
+func (*HeaterGroupMember) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HeaterGroupMember) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *HeaterGroupMember) GetRequested() float64 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *HeaterGroupMember) GetAllocated() float64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+type HeaterStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                 string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	StartedMillis      int64   `protobuf:"varint,2,opt,name=StartedMillis,proto3" json:"StartedMillis,omitempty"`
+	OnTime             int64   `protobuf:"varint,3,opt,name=OnTime,proto3" json:"OnTime,omitempty"`
+	ResetMillis        int64   `protobuf:"varint,4,opt,name=ResetMillis,proto3" json:"ResetMillis,omitempty"`
+	OnTimeSinceReset   int64   `protobuf:"varint,5,opt,name=OnTimeSinceReset,proto3" json:"OnTimeSinceReset,omitempty"`
+	Duty               float64 `protobuf:"fixed64,6,opt,name=Duty,proto3" json:"Duty,omitempty"`
+	RatedWatts         float64 `protobuf:"fixed64,7,opt,name=RatedWatts,proto3" json:"RatedWatts,omitempty"`
+	EnergyWh           float64 `protobuf:"fixed64,8,opt,name=EnergyWh,proto3" json:"EnergyWh,omitempty"`
+	EnergyWhSinceReset float64 `protobuf:"fixed64,9,opt,name=EnergyWhSinceReset,proto3" json:"EnergyWhSinceReset,omitempty"`
+}
+
+func (x *HeaterStats) Reset() {
+	*x = HeaterStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaterStats) ProtoMessage() {}
+
+func (x *HeaterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaterStats.ProtoReflect.Descriptor instead.
+func (*HeaterStats) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HeaterStats) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *HeaterStats) GetStartedMillis() int64 {
+	if x != nil {
+		return x.StartedMillis
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetOnTime() int64 {
+	if x != nil {
+		return x.OnTime
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetResetMillis() int64 {
+	if x != nil {
+		return x.ResetMillis
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetOnTimeSinceReset() int64 {
+	if x != nil {
+		return x.OnTimeSinceReset
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetDuty() float64 {
+	if x != nil {
+		return x.Duty
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetRatedWatts() float64 {
+	if x != nil {
+		return x.RatedWatts
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetEnergyWh() float64 {
+	if x != nil {
+		return x.EnergyWh
+	}
+	return 0
+}
+
+func (x *HeaterStats) GetEnergyWhSinceReset() float64 {
+	if x != nil {
+		return x.EnergyWhSinceReset
+	}
+	return 0
+}
+
 var File_pkg_embedded_embeddedproto_heaters_proto protoreflect.FileDescriptor
 
 var file_pkg_embedded_embeddedproto_heaters_proto_rawDesc = []byte{
@@ -143,26 +798,135 @@ var file_pkg_embedded_embeddedproto_heaters_proto_rawDesc = []byte{
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
 	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x4e,
-	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x32, 0x9c,
-	0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x09, 0x48, 0x65, 0x61,
-	0x74, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c,
-	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
-	0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x00, 0x12, 0x4d,
-	0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1b,
-	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
-	0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x42, 0x39, 0x50,
-	0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d,
-	0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64,
-	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x85,
+	0x02, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x3f, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x61,
+	0x6d, 0x70, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x52, 0x61,
+	0x6d, 0x70, 0x52, 0x61, 0x74, 0x65, 0x22, 0x1a, 0x0a, 0x08, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x33, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xc9, 0x01, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x81, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4d,
+	0x61, 0x78, 0x57, 0x61, 0x74, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4d,
+	0x61, 0x78, 0x57, 0x61, 0x74, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xa9, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x75, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x44, 0x75, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x74,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x52, 0x61, 0x74, 0x65, 0x64, 0x57,
+	0x61, 0x74, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x68,
+	0x12, 0x2e, 0x0a, 0x12, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x57, 0x68, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x45, 0x6e,
+	0x65, 0x72, 0x67, 0x79, 0x57, 0x68, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x32, 0x98, 0x04, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x09, 0x48,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x1a, 0x1b, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x48,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x2e,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65,
+	0x61, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x1a, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x1a, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a,
+	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c,
+	0x61, 0x70, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -177,23 +941,47 @@ func file_pkg_embedded_embeddedproto_heaters_proto_rawDescGZIP() []byte {
 	return file_pkg_embedded_embeddedproto_heaters_proto_rawDescData
 }
 
-var file_pkg_embedded_embeddedproto_heaters_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_embedded_embeddedproto_heaters_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_pkg_embedded_embeddedproto_heaters_proto_goTypes = []interface{}{
-	(*HeaterConfigs)(nil), // 0: embeddedproto.HeaterConfigs
-	(*HeaterConfig)(nil),  // 1: embeddedproto.HeaterConfig
-	(*empty.Empty)(nil),   // 2: google.protobuf.Empty
+	(*HeaterConfigs)(nil),     // 0: embeddedproto.HeaterConfigs
+	(*HeaterConfig)(nil),      // 1: embeddedproto.HeaterConfig
+	(*HeaterID)(nil),          // 2: embeddedproto.HeaterID
+	(*HeaterPower)(nil),       // 3: embeddedproto.HeaterPower
+	(*HeaterFault)(nil),       // 4: embeddedproto.HeaterFault
+	(*HeaterModulation)(nil),  // 5: embeddedproto.HeaterModulation
+	(*HeaterStatsList)(nil),   // 6: embeddedproto.HeaterStatsList
+	(*HeaterGroupList)(nil),   // 7: embeddedproto.HeaterGroupList
+	(*HeaterGroup)(nil),       // 8: embeddedproto.HeaterGroup
+	(*HeaterGroupMember)(nil), // 9: embeddedproto.HeaterGroupMember
+	(*HeaterStats)(nil),       // 10: embeddedproto.HeaterStats
+	(*empty.Empty)(nil),       // 11: google.protobuf.Empty
 }
 var file_pkg_embedded_embeddedproto_heaters_proto_depIdxs = []int32{
-	1, // 0: embeddedproto.HeaterConfigs.configs:type_name -> embeddedproto.HeaterConfig
-	2, // 1: embeddedproto.Heater.HeaterGet:input_type -> google.protobuf.Empty
-	1, // 2: embeddedproto.Heater.HeaterConfigure:input_type -> embeddedproto.HeaterConfig
-	0, // 3: embeddedproto.Heater.HeaterGet:output_type -> embeddedproto.HeaterConfigs
-	1, // 4: embeddedproto.Heater.HeaterConfigure:output_type -> embeddedproto.HeaterConfig
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: embeddedproto.HeaterConfigs.configs:type_name -> embeddedproto.HeaterConfig
+	5,  // 1: embeddedproto.HeaterConfig.Modulation:type_name -> embeddedproto.HeaterModulation
+	4,  // 2: embeddedproto.HeaterConfig.Fault:type_name -> embeddedproto.HeaterFault
+	10, // 3: embeddedproto.HeaterStatsList.stats:type_name -> embeddedproto.HeaterStats
+	8,  // 4: embeddedproto.HeaterGroupList.groups:type_name -> embeddedproto.HeaterGroup
+	9,  // 5: embeddedproto.HeaterGroup.Members:type_name -> embeddedproto.HeaterGroupMember
+	11, // 6: embeddedproto.Heater.HeaterGet:input_type -> google.protobuf.Empty
+	1,  // 7: embeddedproto.Heater.HeaterConfigure:input_type -> embeddedproto.HeaterConfig
+	2,  // 8: embeddedproto.Heater.HeaterClearFault:input_type -> embeddedproto.HeaterID
+	3,  // 9: embeddedproto.Heater.HeaterSetPower:input_type -> embeddedproto.HeaterPower
+	11, // 10: embeddedproto.Heater.HeaterGetStats:input_type -> google.protobuf.Empty
+	2,  // 11: embeddedproto.Heater.HeaterResetStats:input_type -> embeddedproto.HeaterID
+	11, // 12: embeddedproto.Heater.HeaterGetGroups:input_type -> google.protobuf.Empty
+	0,  // 13: embeddedproto.Heater.HeaterGet:output_type -> embeddedproto.HeaterConfigs
+	1,  // 14: embeddedproto.Heater.HeaterConfigure:output_type -> embeddedproto.HeaterConfig
+	1,  // 15: embeddedproto.Heater.HeaterClearFault:output_type -> embeddedproto.HeaterConfig
+	3,  // 16: embeddedproto.Heater.HeaterSetPower:output_type -> embeddedproto.HeaterPower
+	6,  // 17: embeddedproto.Heater.HeaterGetStats:output_type -> embeddedproto.HeaterStatsList
+	10, // 18: embeddedproto.Heater.HeaterResetStats:output_type -> embeddedproto.HeaterStats
+	7,  // 19: embeddedproto.Heater.HeaterGetGroups:output_type -> embeddedproto.HeaterGroupList
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pkg_embedded_embeddedproto_heaters_proto_init() }
@@ -226,6 +1014,114 @@ func file_pkg_embedded_embeddedproto_heaters_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterPower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterFault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterModulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterStatsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterGroupList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterGroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_heaters_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaterStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -233,7 +1129,7 @@ func file_pkg_embedded_embeddedproto_heaters_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_embedded_embeddedproto_heaters_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
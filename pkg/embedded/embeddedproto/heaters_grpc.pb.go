@@ -25,6 +25,11 @@ const _ = grpc.SupportPackageIsVersion7
 type HeaterClient interface {
 	HeaterGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeaterConfigs, error)
 	HeaterConfigure(ctx context.Context, in *HeaterConfig, opts ...grpc.CallOption) (*HeaterConfig, error)
+	HeaterClearFault(ctx context.Context, in *HeaterID, opts ...grpc.CallOption) (*HeaterConfig, error)
+	HeaterSetPower(ctx context.Context, in *HeaterPower, opts ...grpc.CallOption) (*HeaterPower, error)
+	HeaterGetStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeaterStatsList, error)
+	HeaterResetStats(ctx context.Context, in *HeaterID, opts ...grpc.CallOption) (*HeaterStats, error)
+	HeaterGetGroups(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeaterGroupList, error)
 }
 
 type heaterClient struct {
@@ -53,12 +58,62 @@ func (c *heaterClient) HeaterConfigure(ctx context.Context, in *HeaterConfig, op
 	return out, nil
 }
 
+func (c *heaterClient) HeaterClearFault(ctx context.Context, in *HeaterID, opts ...grpc.CallOption) (*HeaterConfig, error) {
+	out := new(HeaterConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Heater/HeaterClearFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heaterClient) HeaterSetPower(ctx context.Context, in *HeaterPower, opts ...grpc.CallOption) (*HeaterPower, error) {
+	out := new(HeaterPower)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Heater/HeaterSetPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heaterClient) HeaterGetStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeaterStatsList, error) {
+	out := new(HeaterStatsList)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Heater/HeaterGetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heaterClient) HeaterResetStats(ctx context.Context, in *HeaterID, opts ...grpc.CallOption) (*HeaterStats, error) {
+	out := new(HeaterStats)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Heater/HeaterResetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *heaterClient) HeaterGetGroups(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeaterGroupList, error) {
+	out := new(HeaterGroupList)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Heater/HeaterGetGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HeaterServer is the server API for Heater service.
 // All implementations must embed UnimplementedHeaterServer
 // for forward compatibility
 type HeaterServer interface {
 	HeaterGet(context.Context, *empty.Empty) (*HeaterConfigs, error)
 	HeaterConfigure(context.Context, *HeaterConfig) (*HeaterConfig, error)
+	HeaterClearFault(context.Context, *HeaterID) (*HeaterConfig, error)
+	HeaterSetPower(context.Context, *HeaterPower) (*HeaterPower, error)
+	HeaterGetStats(context.Context, *empty.Empty) (*HeaterStatsList, error)
+	HeaterResetStats(context.Context, *HeaterID) (*HeaterStats, error)
+	HeaterGetGroups(context.Context, *empty.Empty) (*HeaterGroupList, error)
 	mustEmbedUnimplementedHeaterServer()
 }
 
@@ -72,6 +127,21 @@ func (UnimplementedHeaterServer) HeaterGet(context.Context, *empty.Empty) (*Heat
 func (UnimplementedHeaterServer) HeaterConfigure(context.Context, *HeaterConfig) (*HeaterConfig, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeaterConfigure not implemented")
 }
+func (UnimplementedHeaterServer) HeaterClearFault(context.Context, *HeaterID) (*HeaterConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaterClearFault not implemented")
+}
+func (UnimplementedHeaterServer) HeaterSetPower(context.Context, *HeaterPower) (*HeaterPower, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaterSetPower not implemented")
+}
+func (UnimplementedHeaterServer) HeaterGetStats(context.Context, *empty.Empty) (*HeaterStatsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaterGetStats not implemented")
+}
+func (UnimplementedHeaterServer) HeaterResetStats(context.Context, *HeaterID) (*HeaterStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaterResetStats not implemented")
+}
+func (UnimplementedHeaterServer) HeaterGetGroups(context.Context, *empty.Empty) (*HeaterGroupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaterGetGroups not implemented")
+}
 func (UnimplementedHeaterServer) mustEmbedUnimplementedHeaterServer() {}
 
 // UnsafeHeaterServer may be embedded to opt out of forward compatibility for this service.
@@ -121,6 +191,96 @@ func _Heater_HeaterConfigure_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Heater_HeaterClearFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeaterID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaterServer).HeaterClearFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Heater/HeaterClearFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaterServer).HeaterClearFault(ctx, req.(*HeaterID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Heater_HeaterSetPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeaterPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaterServer).HeaterSetPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Heater/HeaterSetPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaterServer).HeaterSetPower(ctx, req.(*HeaterPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Heater_HeaterGetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaterServer).HeaterGetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Heater/HeaterGetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaterServer).HeaterGetStats(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Heater_HeaterResetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeaterID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaterServer).HeaterResetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Heater/HeaterResetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaterServer).HeaterResetStats(ctx, req.(*HeaterID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Heater_HeaterGetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeaterServer).HeaterGetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Heater/HeaterGetGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeaterServer).HeaterGetGroups(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Heater_ServiceDesc is the grpc.ServiceDesc for Heater service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,6 +296,26 @@ var Heater_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HeaterConfigure",
 			Handler:    _Heater_HeaterConfigure_Handler,
 		},
+		{
+			MethodName: "HeaterClearFault",
+			Handler:    _Heater_HeaterClearFault_Handler,
+		},
+		{
+			MethodName: "HeaterSetPower",
+			Handler:    _Heater_HeaterSetPower_Handler,
+		},
+		{
+			MethodName: "HeaterGetStats",
+			Handler:    _Heater_HeaterGetStats_Handler,
+		},
+		{
+			MethodName: "HeaterResetStats",
+			Handler:    _Heater_HeaterResetStats_Handler,
+		},
+		{
+			MethodName: "HeaterGetGroups",
+			Handler:    _Heater_HeaterGetGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/embedded/embeddedproto/heaters.proto",
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/iio.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IIOConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*IIOConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *IIOConfigs) Reset() {
+	*x = IIOConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOConfigs) ProtoMessage() {}
+
+func (x *IIOConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOConfigs.ProtoReflect.Descriptor instead.
+func (*IIOConfigs) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IIOConfigs) GetConfigs() []*IIOConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type IIOScaling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gain   float64 `protobuf:"fixed64,1,opt,name=Gain,proto3" json:"Gain,omitempty"`
+	Offset float64 `protobuf:"fixed64,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Unit   string  `protobuf:"bytes,3,opt,name=Unit,proto3" json:"Unit,omitempty"`
+}
+
+func (x *IIOScaling) Reset() {
+	*x = IIOScaling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOScaling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOScaling) ProtoMessage() {}
+
+func (x *IIOScaling) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOScaling.ProtoReflect.Descriptor instead.
+func (*IIOScaling) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IIOScaling) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *IIOScaling) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *IIOScaling) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type IIOConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string      `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string      `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	PollInterval int64       `protobuf:"varint,3,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
+	Samples      uint32      `protobuf:"varint,4,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	Enabled      bool        `protobuf:"varint,5,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Scaling      *IIOScaling `protobuf:"bytes,6,opt,name=Scaling,proto3" json:"Scaling,omitempty"`
+}
+
+func (x *IIOConfig) Reset() {
+	*x = IIOConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOConfig) ProtoMessage() {}
+
+func (x *IIOConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOConfig.ProtoReflect.Descriptor instead.
+func (*IIOConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IIOConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *IIOConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IIOConfig) GetPollInterval() int64 {
+	if x != nil {
+		return x.PollInterval
+	}
+	return 0
+}
+
+func (x *IIOConfig) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *IIOConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *IIOConfig) GetScaling() *IIOScaling {
+	if x != nil {
+		return x.Scaling
+	}
+	return nil
+}
+
+type IIOValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*IIOValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *IIOValues) Reset() {
+	*x = IIOValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOValues) ProtoMessage() {}
+
+func (x *IIOValues) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOValues.ProtoReflect.Descriptor instead.
+func (*IIOValues) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IIOValues) GetValues() []*IIOValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type IIOValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Readings []*IIOReadings `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+}
+
+func (x *IIOValue) Reset() {
+	*x = IIOValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOValue) ProtoMessage() {}
+
+func (x *IIOValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOValue.ProtoReflect.Descriptor instead.
+func (*IIOValue) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IIOValue) GetReadings() []*IIOReadings {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+type IIOReadings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Raw         int64   `protobuf:"varint,2,opt,name=Raw,proto3" json:"Raw,omitempty"`
+	Voltage     float64 `protobuf:"fixed64,3,opt,name=Voltage,proto3" json:"Voltage,omitempty"`
+	Value       float64 `protobuf:"fixed64,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	Average     float64 `protobuf:"fixed64,5,opt,name=Average,proto3" json:"Average,omitempty"`
+	StampMillis int64   `protobuf:"varint,6,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+	Error       string  `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *IIOReadings) Reset() {
+	*x = IIOReadings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IIOReadings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IIOReadings) ProtoMessage() {}
+
+func (x *IIOReadings) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_iio_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IIOReadings.ProtoReflect.Descriptor instead.
+func (*IIOReadings) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IIOReadings) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *IIOReadings) GetRaw() int64 {
+	if x != nil {
+		return x.Raw
+	}
+	return 0
+}
+
+func (x *IIOReadings) GetVoltage() float64 {
+	if x != nil {
+		return x.Voltage
+	}
+	return 0
+}
+
+func (x *IIOReadings) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IIOReadings) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *IIOReadings) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+func (x *IIOReadings) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_pkg_embedded_embeddedproto_iio_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_iio_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x69, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0a, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x22, 0x4c, 0x0a, 0x0a, 0x49, 0x49, 0x4f, 0x53, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x47, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x09, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x50, 0x6f, 0x6c, 0x6c,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07,
+	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x49,
+	0x4f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x22, 0x3c, 0x0a, 0x09, 0x49, 0x49, 0x4f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x49, 0x4f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x42, 0x0a, 0x08, 0x49, 0x49, 0x4f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x49,
+	0x4f, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x49, 0x49, 0x4f, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x52, 0x61, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xce, 0x01, 0x0a, 0x03, 0x49, 0x49, 0x4f, 0x12,
+	0x3d, 0x0a, 0x06, 0x49, 0x49, 0x4f, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0c, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x49, 0x4f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x49, 0x49, 0x4f, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x49, 0x4f,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_iio_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_iio_proto_rawDescData = file_pkg_embedded_embeddedproto_iio_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_iio_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_iio_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_iio_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_iio_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_iio_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_iio_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_embedded_embeddedproto_iio_proto_goTypes = []interface{}{
+	(*IIOConfigs)(nil),  // 0: embeddedproto.IIOConfigs
+	(*IIOScaling)(nil),  // 1: embeddedproto.IIOScaling
+	(*IIOConfig)(nil),   // 2: embeddedproto.IIOConfig
+	(*IIOValues)(nil),   // 3: embeddedproto.IIOValues
+	(*IIOValue)(nil),    // 4: embeddedproto.IIOValue
+	(*IIOReadings)(nil), // 5: embeddedproto.IIOReadings
+	(*empty.Empty)(nil), // 6: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_iio_proto_depIdxs = []int32{
+	2, // 0: embeddedproto.IIOConfigs.configs:type_name -> embeddedproto.IIOConfig
+	1, // 1: embeddedproto.IIOConfig.Scaling:type_name -> embeddedproto.IIOScaling
+	4, // 2: embeddedproto.IIOValues.values:type_name -> embeddedproto.IIOValue
+	5, // 3: embeddedproto.IIOValue.readings:type_name -> embeddedproto.IIOReadings
+	6, // 4: embeddedproto.IIO.IIOGet:input_type -> google.protobuf.Empty
+	2, // 5: embeddedproto.IIO.IIOConfigure:input_type -> embeddedproto.IIOConfig
+	6, // 6: embeddedproto.IIO.IIOGetValues:input_type -> google.protobuf.Empty
+	0, // 7: embeddedproto.IIO.IIOGet:output_type -> embeddedproto.IIOConfigs
+	2, // 8: embeddedproto.IIO.IIOConfigure:output_type -> embeddedproto.IIOConfig
+	3, // 9: embeddedproto.IIO.IIOGetValues:output_type -> embeddedproto.IIOValues
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_iio_proto_init() }
+func file_pkg_embedded_embeddedproto_iio_proto_init() {
+	if File_pkg_embedded_embeddedproto_iio_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOScaling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_iio_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IIOReadings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_iio_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_iio_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_iio_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_iio_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_iio_proto = out.File
+	file_pkg_embedded_embeddedproto_iio_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_iio_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_iio_proto_depIdxs = nil
+}
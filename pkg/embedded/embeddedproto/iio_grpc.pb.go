@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/iio.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// IIOClient is the client API for IIO service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IIOClient interface {
+	IIOGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IIOConfigs, error)
+	IIOConfigure(ctx context.Context, in *IIOConfig, opts ...grpc.CallOption) (*IIOConfig, error)
+	IIOGetValues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IIOValues, error)
+}
+
+type iIOClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIIOClient(cc grpc.ClientConnInterface) IIOClient {
+	return &iIOClient{cc}
+}
+
+func (c *iIOClient) IIOGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IIOConfigs, error) {
+	out := new(IIOConfigs)
+	err := c.cc.Invoke(ctx, "/embeddedproto.IIO/IIOGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iIOClient) IIOConfigure(ctx context.Context, in *IIOConfig, opts ...grpc.CallOption) (*IIOConfig, error) {
+	out := new(IIOConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.IIO/IIOConfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iIOClient) IIOGetValues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IIOValues, error) {
+	out := new(IIOValues)
+	err := c.cc.Invoke(ctx, "/embeddedproto.IIO/IIOGetValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IIOServer is the server API for IIO service.
+// All implementations must embed UnimplementedIIOServer
+// for forward compatibility
+type IIOServer interface {
+	IIOGet(context.Context, *empty.Empty) (*IIOConfigs, error)
+	IIOConfigure(context.Context, *IIOConfig) (*IIOConfig, error)
+	IIOGetValues(context.Context, *empty.Empty) (*IIOValues, error)
+	mustEmbedUnimplementedIIOServer()
+}
+
+// UnimplementedIIOServer must be embedded to have forward compatible implementations.
+type UnimplementedIIOServer struct {
+}
+
+func (UnimplementedIIOServer) IIOGet(context.Context, *empty.Empty) (*IIOConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IIOGet not implemented")
+}
+func (UnimplementedIIOServer) IIOConfigure(context.Context, *IIOConfig) (*IIOConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IIOConfigure not implemented")
+}
+func (UnimplementedIIOServer) IIOGetValues(context.Context, *empty.Empty) (*IIOValues, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IIOGetValues not implemented")
+}
+func (UnimplementedIIOServer) mustEmbedUnimplementedIIOServer() {}
+
+// UnsafeIIOServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IIOServer will
+// result in compilation errors.
+type UnsafeIIOServer interface {
+	mustEmbedUnimplementedIIOServer()
+}
+
+func RegisterIIOServer(s grpc.ServiceRegistrar, srv IIOServer) {
+	s.RegisterService(&IIO_ServiceDesc, srv)
+}
+
+func _IIO_IIOGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IIOServer).IIOGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.IIO/IIOGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IIOServer).IIOGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IIO_IIOConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IIOConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IIOServer).IIOConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.IIO/IIOConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IIOServer).IIOConfigure(ctx, req.(*IIOConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IIO_IIOGetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IIOServer).IIOGetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.IIO/IIOGetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IIOServer).IIOGetValues(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IIO_ServiceDesc is the grpc.ServiceDesc for IIO service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IIO_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.IIO",
+	HandlerType: (*IIOServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IIOGet",
+			Handler:    _IIO_IIOGet_Handler,
+		},
+		{
+			MethodName: "IIOConfigure",
+			Handler:    _IIO_IIOConfigure_Handler,
+		},
+		{
+			MethodName: "IIOGetValues",
+			Handler:    _IIO_IIOGetValues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/iio.proto",
+}
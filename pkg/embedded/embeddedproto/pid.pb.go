@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/pid.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PIDConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*PIDConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *PIDConfigs) Reset() {
+	*x = PIDConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDConfigs) ProtoMessage() {}
+
+func (x *PIDConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDConfigs.ProtoReflect.Descriptor instead.
+func (*PIDConfigs) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PIDConfigs) GetConfigs() []*PIDConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type PIDConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	HeaterID   string  `protobuf:"bytes,2,opt,name=HeaterID,proto3" json:"HeaterID,omitempty"`
+	SensorID   string  `protobuf:"bytes,3,opt,name=SensorID,proto3" json:"SensorID,omitempty"`
+	SensorType string  `protobuf:"bytes,4,opt,name=SensorType,proto3" json:"SensorType,omitempty"`
+	Enabled    bool    `protobuf:"varint,5,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Interval   int64   `protobuf:"varint,6,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	Kp         float32 `protobuf:"fixed32,7,opt,name=Kp,proto3" json:"Kp,omitempty"`
+	Ki         float32 `protobuf:"fixed32,8,opt,name=Ki,proto3" json:"Ki,omitempty"`
+	Kd         float32 `protobuf:"fixed32,9,opt,name=Kd,proto3" json:"Kd,omitempty"`
+	SetPoint   float32 `protobuf:"fixed32,10,opt,name=SetPoint,proto3" json:"SetPoint,omitempty"`
+	OutputMin  float32 `protobuf:"fixed32,11,opt,name=OutputMin,proto3" json:"OutputMin,omitempty"`
+	OutputMax  float32 `protobuf:"fixed32,12,opt,name=OutputMax,proto3" json:"OutputMax,omitempty"`
+	AntiWindup bool    `protobuf:"varint,13,opt,name=AntiWindup,proto3" json:"AntiWindup,omitempty"`
+}
+
+func (x *PIDConfig) Reset() {
+	*x = PIDConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDConfig) ProtoMessage() {}
+
+func (x *PIDConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDConfig.ProtoReflect.Descriptor instead.
+func (*PIDConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PIDConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PIDConfig) GetHeaterID() string {
+	if x != nil {
+		return x.HeaterID
+	}
+	return ""
+}
+
+func (x *PIDConfig) GetSensorID() string {
+	if x != nil {
+		return x.SensorID
+	}
+	return ""
+}
+
+func (x *PIDConfig) GetSensorType() string {
+	if x != nil {
+		return x.SensorType
+	}
+	return ""
+}
+
+func (x *PIDConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PIDConfig) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetKp() float32 {
+	if x != nil {
+		return x.Kp
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetKi() float32 {
+	if x != nil {
+		return x.Ki
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetKd() float32 {
+	if x != nil {
+		return x.Kd
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetSetPoint() float32 {
+	if x != nil {
+		return x.SetPoint
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetOutputMin() float32 {
+	if x != nil {
+		return x.OutputMin
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetOutputMax() float32 {
+	if x != nil {
+		return x.OutputMax
+	}
+	return 0
+}
+
+func (x *PIDConfig) GetAntiWindup() bool {
+	if x != nil {
+		return x.AntiWindup
+	}
+	return false
+}
+
+type PIDEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+}
+
+func (x *PIDEnabled) Reset() {
+	*x = PIDEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDEnabled) ProtoMessage() {}
+
+func (x *PIDEnabled) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDEnabled.ProtoReflect.Descriptor instead.
+func (*PIDEnabled) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PIDEnabled) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PIDEnabled) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type PIDStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []*PIDStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PIDStatuses) Reset() {
+	*x = PIDStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDStatuses) ProtoMessage() {}
+
+func (x *PIDStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDStatuses.ProtoReflect.Descriptor instead.
+func (*PIDStatuses) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PIDStatuses) GetStatus() []*PIDStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PIDStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Enabled     bool    `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Temperature float32 `protobuf:"fixed32,3,opt,name=Temperature,proto3" json:"Temperature,omitempty"`
+	StampMillis int64   `protobuf:"varint,4,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+	Fault       string  `protobuf:"bytes,5,opt,name=Fault,proto3" json:"Fault,omitempty"`
+	Error       float32 `protobuf:"fixed32,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	P           float32 `protobuf:"fixed32,7,opt,name=P,proto3" json:"P,omitempty"`
+	I           float32 `protobuf:"fixed32,8,opt,name=I,proto3" json:"I,omitempty"`
+	D           float32 `protobuf:"fixed32,9,opt,name=D,proto3" json:"D,omitempty"`
+	Output      float32 `protobuf:"fixed32,10,opt,name=Output,proto3" json:"Output,omitempty"`
+}
+
+func (x *PIDStatus) Reset() {
+	*x = PIDStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PIDStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PIDStatus) ProtoMessage() {}
+
+func (x *PIDStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_pid_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PIDStatus.ProtoReflect.Descriptor instead.
+func (*PIDStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PIDStatus) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PIDStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PIDStatus) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetFault() string {
+	if x != nil {
+		return x.Fault
+	}
+	return ""
+}
+
+func (x *PIDStatus) GetError() float32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetP() float32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetI() float32 {
+	if x != nil {
+		return x.I
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetD() float32 {
+	if x != nil {
+		return x.D
+	}
+	return 0
+}
+
+func (x *PIDStatus) GetOutput() float32 {
+	if x != nil {
+		return x.Output
+	}
+	return 0
+}
+
+var File_pkg_embedded_embeddedproto_pid_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_pid_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x69, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x09, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x4b, 0x70,
+	0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x4b, 0x69,
+	0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x4b, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6e, 0x74, 0x69,
+	0x57, 0x69, 0x6e, 0x64, 0x75, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6e,
+	0x74, 0x69, 0x57, 0x69, 0x6e, 0x64, 0x75, 0x70, 0x22, 0x36, 0x0a, 0x0a, 0x50, 0x49, 0x44, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0x3f, 0x0a, 0x0b, 0x50, 0x49, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x49, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xe7, 0x01, 0x0a, 0x09, 0x50, 0x49, 0x44, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b,
+	0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x50, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x50, 0x12, 0x0c, 0x0a, 0x01, 0x49, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x01, 0x49, 0x12, 0x0c, 0x0a, 0x01, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x32, 0xd8, 0x02, 0x0a, 0x03,
+	0x50, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x06, 0x50, 0x49, 0x44, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x50, 0x49, 0x44, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x49, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x09, 0x50,
+	0x49, 0x44, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x1a, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0c, 0x50, 0x49, 0x44, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x49, 0x44, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_pid_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_pid_proto_rawDescData = file_pkg_embedded_embeddedproto_pid_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_pid_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_pid_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_pid_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_pid_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_pid_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_pid_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_embedded_embeddedproto_pid_proto_goTypes = []interface{}{
+	(*PIDConfigs)(nil),  // 0: embeddedproto.PIDConfigs
+	(*PIDConfig)(nil),   // 1: embeddedproto.PIDConfig
+	(*PIDEnabled)(nil),  // 2: embeddedproto.PIDEnabled
+	(*PIDStatuses)(nil), // 3: embeddedproto.PIDStatuses
+	(*PIDStatus)(nil),   // 4: embeddedproto.PIDStatus
+	(*empty.Empty)(nil), // 5: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_pid_proto_depIdxs = []int32{
+	1, // 0: embeddedproto.PIDConfigs.configs:type_name -> embeddedproto.PIDConfig
+	4, // 1: embeddedproto.PIDStatuses.status:type_name -> embeddedproto.PIDStatus
+	5, // 2: embeddedproto.PID.PIDGet:input_type -> google.protobuf.Empty
+	1, // 3: embeddedproto.PID.PIDCreate:input_type -> embeddedproto.PIDConfig
+	1, // 4: embeddedproto.PID.PIDConfigure:input_type -> embeddedproto.PIDConfig
+	2, // 5: embeddedproto.PID.PIDEnable:input_type -> embeddedproto.PIDEnabled
+	5, // 6: embeddedproto.PID.PIDGetStatus:input_type -> google.protobuf.Empty
+	0, // 7: embeddedproto.PID.PIDGet:output_type -> embeddedproto.PIDConfigs
+	1, // 8: embeddedproto.PID.PIDCreate:output_type -> embeddedproto.PIDConfig
+	1, // 9: embeddedproto.PID.PIDConfigure:output_type -> embeddedproto.PIDConfig
+	2, // 10: embeddedproto.PID.PIDEnable:output_type -> embeddedproto.PIDEnabled
+	3, // 11: embeddedproto.PID.PIDGetStatus:output_type -> embeddedproto.PIDStatuses
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_pid_proto_init() }
+func file_pkg_embedded_embeddedproto_pid_proto_init() {
+	if File_pkg_embedded_embeddedproto_pid_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_pid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_pid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_pid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDEnabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_pid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_pid_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PIDStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_pid_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_pid_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_pid_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_pid_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_pid_proto = out.File
+	file_pkg_embedded_embeddedproto_pid_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_pid_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_pid_proto_depIdxs = nil
+}
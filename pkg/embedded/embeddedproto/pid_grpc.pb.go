@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/pid.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PIDClient is the client API for PID service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PIDClient interface {
+	PIDGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PIDConfigs, error)
+	PIDCreate(ctx context.Context, in *PIDConfig, opts ...grpc.CallOption) (*PIDConfig, error)
+	PIDConfigure(ctx context.Context, in *PIDConfig, opts ...grpc.CallOption) (*PIDConfig, error)
+	PIDEnable(ctx context.Context, in *PIDEnabled, opts ...grpc.CallOption) (*PIDEnabled, error)
+	PIDGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PIDStatuses, error)
+}
+
+type pIDClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPIDClient(cc grpc.ClientConnInterface) PIDClient {
+	return &pIDClient{cc}
+}
+
+func (c *pIDClient) PIDGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PIDConfigs, error) {
+	out := new(PIDConfigs)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PID/PIDGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIDClient) PIDCreate(ctx context.Context, in *PIDConfig, opts ...grpc.CallOption) (*PIDConfig, error) {
+	out := new(PIDConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PID/PIDCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIDClient) PIDConfigure(ctx context.Context, in *PIDConfig, opts ...grpc.CallOption) (*PIDConfig, error) {
+	out := new(PIDConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PID/PIDConfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIDClient) PIDEnable(ctx context.Context, in *PIDEnabled, opts ...grpc.CallOption) (*PIDEnabled, error) {
+	out := new(PIDEnabled)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PID/PIDEnable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pIDClient) PIDGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PIDStatuses, error) {
+	out := new(PIDStatuses)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PID/PIDGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PIDServer is the server API for PID service.
+// All implementations must embed UnimplementedPIDServer
+// for forward compatibility
+type PIDServer interface {
+	PIDGet(context.Context, *empty.Empty) (*PIDConfigs, error)
+	PIDCreate(context.Context, *PIDConfig) (*PIDConfig, error)
+	PIDConfigure(context.Context, *PIDConfig) (*PIDConfig, error)
+	PIDEnable(context.Context, *PIDEnabled) (*PIDEnabled, error)
+	PIDGetStatus(context.Context, *empty.Empty) (*PIDStatuses, error)
+	mustEmbedUnimplementedPIDServer()
+}
+
+// UnimplementedPIDServer must be embedded to have forward compatible implementations.
+type UnimplementedPIDServer struct {
+}
+
+func (UnimplementedPIDServer) PIDGet(context.Context, *empty.Empty) (*PIDConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PIDGet not implemented")
+}
+func (UnimplementedPIDServer) PIDCreate(context.Context, *PIDConfig) (*PIDConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PIDCreate not implemented")
+}
+func (UnimplementedPIDServer) PIDConfigure(context.Context, *PIDConfig) (*PIDConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PIDConfigure not implemented")
+}
+func (UnimplementedPIDServer) PIDEnable(context.Context, *PIDEnabled) (*PIDEnabled, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PIDEnable not implemented")
+}
+func (UnimplementedPIDServer) PIDGetStatus(context.Context, *empty.Empty) (*PIDStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PIDGetStatus not implemented")
+}
+func (UnimplementedPIDServer) mustEmbedUnimplementedPIDServer() {}
+
+// UnsafePIDServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PIDServer will
+// result in compilation errors.
+type UnsafePIDServer interface {
+	mustEmbedUnimplementedPIDServer()
+}
+
+func RegisterPIDServer(s grpc.ServiceRegistrar, srv PIDServer) {
+	s.RegisterService(&PID_ServiceDesc, srv)
+}
+
+func _PID_PIDGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIDServer).PIDGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PID/PIDGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIDServer).PIDGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PID_PIDCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PIDConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIDServer).PIDCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PID/PIDCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIDServer).PIDCreate(ctx, req.(*PIDConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PID_PIDConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PIDConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIDServer).PIDConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PID/PIDConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIDServer).PIDConfigure(ctx, req.(*PIDConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PID_PIDEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PIDEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIDServer).PIDEnable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PID/PIDEnable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIDServer).PIDEnable(ctx, req.(*PIDEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PID_PIDGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PIDServer).PIDGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PID/PIDGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PIDServer).PIDGetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PID_ServiceDesc is the grpc.ServiceDesc for PID service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PID_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.PID",
+	HandlerType: (*PIDServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PIDGet",
+			Handler:    _PID_PIDGet_Handler,
+		},
+		{
+			MethodName: "PIDCreate",
+			Handler:    _PID_PIDCreate_Handler,
+		},
+		{
+			MethodName: "PIDConfigure",
+			Handler:    _PID_PIDConfigure_Handler,
+		},
+		{
+			MethodName: "PIDEnable",
+			Handler:    _PID_PIDEnable_Handler,
+		},
+		{
+			MethodName: "PIDGetStatus",
+			Handler:    _PID_PIDGetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/pid.proto",
+}
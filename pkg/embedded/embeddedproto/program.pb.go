@@ -0,0 +1,775 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/program.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProgramConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*ProgramConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *ProgramConfigs) Reset() {
+	*x = ProgramConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramConfigs) ProtoMessage() {}
+
+func (x *ProgramConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramConfigs.ProtoReflect.Descriptor instead.
+func (*ProgramConfigs) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProgramConfigs) GetConfigs() []*ProgramConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type ProgramConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string         `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	HeaterID   string         `protobuf:"bytes,2,opt,name=HeaterID,proto3" json:"HeaterID,omitempty"`
+	SensorID   string         `protobuf:"bytes,3,opt,name=SensorID,proto3" json:"SensorID,omitempty"`
+	SensorType string         `protobuf:"bytes,4,opt,name=SensorType,proto3" json:"SensorType,omitempty"`
+	Interval   int64          `protobuf:"varint,5,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	Tolerance  float64        `protobuf:"fixed64,6,opt,name=Tolerance,proto3" json:"Tolerance,omitempty"`
+	Steps      []*ProgramStep `protobuf:"bytes,7,rep,name=Steps,proto3" json:"Steps,omitempty"`
+	Kp         float64        `protobuf:"fixed64,8,opt,name=Kp,proto3" json:"Kp,omitempty"`
+	Ki         float64        `protobuf:"fixed64,9,opt,name=Ki,proto3" json:"Ki,omitempty"`
+	Kd         float64        `protobuf:"fixed64,10,opt,name=Kd,proto3" json:"Kd,omitempty"`
+	OutputMin  float64        `protobuf:"fixed64,11,opt,name=OutputMin,proto3" json:"OutputMin,omitempty"`
+	OutputMax  float64        `protobuf:"fixed64,12,opt,name=OutputMax,proto3" json:"OutputMax,omitempty"`
+	AntiWindup bool           `protobuf:"varint,13,opt,name=AntiWindup,proto3" json:"AntiWindup,omitempty"`
+}
+
+func (x *ProgramConfig) Reset() {
+	*x = ProgramConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramConfig) ProtoMessage() {}
+
+func (x *ProgramConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramConfig.ProtoReflect.Descriptor instead.
+func (*ProgramConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProgramConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProgramConfig) GetHeaterID() string {
+	if x != nil {
+		return x.HeaterID
+	}
+	return ""
+}
+
+func (x *ProgramConfig) GetSensorID() string {
+	if x != nil {
+		return x.SensorID
+	}
+	return ""
+}
+
+func (x *ProgramConfig) GetSensorType() string {
+	if x != nil {
+		return x.SensorType
+	}
+	return ""
+}
+
+func (x *ProgramConfig) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetSteps() []*ProgramStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ProgramConfig) GetKp() float64 {
+	if x != nil {
+		return x.Kp
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetKi() float64 {
+	if x != nil {
+		return x.Ki
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetKd() float64 {
+	if x != nil {
+		return x.Kd
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetOutputMin() float64 {
+	if x != nil {
+		return x.OutputMin
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetOutputMax() float64 {
+	if x != nil {
+		return x.OutputMax
+	}
+	return 0
+}
+
+func (x *ProgramConfig) GetAntiWindup() bool {
+	if x != nil {
+		return x.AntiWindup
+	}
+	return false
+}
+
+type ProgramStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   string  `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Target float64 `protobuf:"fixed64,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	Rate   float64 `protobuf:"fixed64,3,opt,name=Rate,proto3" json:"Rate,omitempty"`
+	Power  uint32  `protobuf:"varint,4,opt,name=Power,proto3" json:"Power,omitempty"`
+	Hold   int64   `protobuf:"varint,5,opt,name=Hold,proto3" json:"Hold,omitempty"`
+}
+
+func (x *ProgramStep) Reset() {
+	*x = ProgramStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramStep) ProtoMessage() {}
+
+func (x *ProgramStep) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramStep.ProtoReflect.Descriptor instead.
+func (*ProgramStep) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProgramStep) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProgramStep) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ProgramStep) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ProgramStep) GetPower() uint32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ProgramStep) GetHold() int64 {
+	if x != nil {
+		return x.Hold
+	}
+	return 0
+}
+
+type ProgramCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Command string `protobuf:"bytes,2,opt,name=Command,proto3" json:"Command,omitempty"`
+}
+
+func (x *ProgramCommand) Reset() {
+	*x = ProgramCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramCommand) ProtoMessage() {}
+
+func (x *ProgramCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramCommand.ProtoReflect.Descriptor instead.
+func (*ProgramCommand) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProgramCommand) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProgramCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type ProgramStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []*ProgramStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ProgramStatuses) Reset() {
+	*x = ProgramStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramStatuses) ProtoMessage() {}
+
+func (x *ProgramStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramStatuses.ProtoReflect.Descriptor instead.
+func (*ProgramStatuses) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProgramStatuses) GetStatus() []*ProgramStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ProgramStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID            string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	State         string  `protobuf:"bytes,2,opt,name=State,proto3" json:"State,omitempty"`
+	Step          int32   `protobuf:"varint,3,opt,name=Step,proto3" json:"Step,omitempty"`
+	Holding       bool    `protobuf:"varint,4,opt,name=Holding,proto3" json:"Holding,omitempty"`
+	SetPoint      float64 `protobuf:"fixed64,5,opt,name=SetPoint,proto3" json:"SetPoint,omitempty"`
+	Temperature   float64 `protobuf:"fixed64,6,opt,name=Temperature,proto3" json:"Temperature,omitempty"`
+	Power         uint32  `protobuf:"varint,7,opt,name=Power,proto3" json:"Power,omitempty"`
+	StartedMillis int64   `protobuf:"varint,8,opt,name=StartedMillis,proto3" json:"StartedMillis,omitempty"`
+	Elapsed       int64   `protobuf:"varint,9,opt,name=Elapsed,proto3" json:"Elapsed,omitempty"`
+	StepElapsed   int64   `protobuf:"varint,10,opt,name=StepElapsed,proto3" json:"StepElapsed,omitempty"`
+	Remaining     int64   `protobuf:"varint,11,opt,name=Remaining,proto3" json:"Remaining,omitempty"`
+	Fault         string  `protobuf:"bytes,12,opt,name=Fault,proto3" json:"Fault,omitempty"`
+}
+
+func (x *ProgramStatus) Reset() {
+	*x = ProgramStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProgramStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgramStatus) ProtoMessage() {}
+
+func (x *ProgramStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_program_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgramStatus.ProtoReflect.Descriptor instead.
+func (*ProgramStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProgramStatus) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ProgramStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProgramStatus) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetHolding() bool {
+	if x != nil {
+		return x.Holding
+	}
+	return false
+}
+
+func (x *ProgramStatus) GetSetPoint() float64 {
+	if x != nil {
+		return x.SetPoint
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetPower() uint32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetStartedMillis() int64 {
+	if x != nil {
+		return x.StartedMillis
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetElapsed() int64 {
+	if x != nil {
+		return x.Elapsed
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetStepElapsed() int64 {
+	if x != nil {
+		return x.StepElapsed
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *ProgramStatus) GetFault() string {
+	if x != nil {
+		return x.Fault
+	}
+	return ""
+}
+
+var File_pkg_embedded_embeddedproto_program_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_program_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x22, 0xef, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x65, 0x61, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x54, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x53, 0x74, 0x65, 0x70, 0x52,
+	0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x02, 0x4b, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x69, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x02, 0x4b, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x4b, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x02, 0x4b, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x4d, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d, 0x61,
+	0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4d,
+	0x61, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x6e, 0x74, 0x69, 0x57, 0x69, 0x6e, 0x64, 0x75, 0x70,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6e, 0x74, 0x69, 0x57, 0x69, 0x6e, 0x64,
+	0x75, 0x70, 0x22, 0x77, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x53, 0x74, 0x65,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x22, 0x3a, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xcd, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x48,
+	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74,
+	0x65, 0x70, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x53, 0x74, 0x65, 0x70, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x32, 0x91, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x45, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x1a, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x1d, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
+	0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_program_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_program_proto_rawDescData = file_pkg_embedded_embeddedproto_program_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_program_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_program_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_program_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_program_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_program_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_program_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_embedded_embeddedproto_program_proto_goTypes = []interface{}{
+	(*ProgramConfigs)(nil),  // 0: embeddedproto.ProgramConfigs
+	(*ProgramConfig)(nil),   // 1: embeddedproto.ProgramConfig
+	(*ProgramStep)(nil),     // 2: embeddedproto.ProgramStep
+	(*ProgramCommand)(nil),  // 3: embeddedproto.ProgramCommand
+	(*ProgramStatuses)(nil), // 4: embeddedproto.ProgramStatuses
+	(*ProgramStatus)(nil),   // 5: embeddedproto.ProgramStatus
+	(*empty.Empty)(nil),     // 6: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_program_proto_depIdxs = []int32{
+	1, // 0: embeddedproto.ProgramConfigs.configs:type_name -> embeddedproto.ProgramConfig
+	2, // 1: embeddedproto.ProgramConfig.Steps:type_name -> embeddedproto.ProgramStep
+	5, // 2: embeddedproto.ProgramStatuses.status:type_name -> embeddedproto.ProgramStatus
+	6, // 3: embeddedproto.Program.ProgramGet:input_type -> google.protobuf.Empty
+	1, // 4: embeddedproto.Program.ProgramCreate:input_type -> embeddedproto.ProgramConfig
+	1, // 5: embeddedproto.Program.ProgramConfigure:input_type -> embeddedproto.ProgramConfig
+	3, // 6: embeddedproto.Program.ProgramExecute:input_type -> embeddedproto.ProgramCommand
+	6, // 7: embeddedproto.Program.ProgramGetStatus:input_type -> google.protobuf.Empty
+	0, // 8: embeddedproto.Program.ProgramGet:output_type -> embeddedproto.ProgramConfigs
+	1, // 9: embeddedproto.Program.ProgramCreate:output_type -> embeddedproto.ProgramConfig
+	1, // 10: embeddedproto.Program.ProgramConfigure:output_type -> embeddedproto.ProgramConfig
+	3, // 11: embeddedproto.Program.ProgramExecute:output_type -> embeddedproto.ProgramCommand
+	4, // 12: embeddedproto.Program.ProgramGetStatus:output_type -> embeddedproto.ProgramStatuses
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_program_proto_init() }
+func file_pkg_embedded_embeddedproto_program_proto_init() {
+	if File_pkg_embedded_embeddedproto_program_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_program_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProgramStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_program_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_program_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_program_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_program_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_program_proto = out.File
+	file_pkg_embedded_embeddedproto_program_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_program_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_program_proto_depIdxs = nil
+}
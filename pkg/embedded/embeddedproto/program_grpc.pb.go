@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/program.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProgramClient is the client API for Program service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProgramClient interface {
+	ProgramGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProgramConfigs, error)
+	ProgramCreate(ctx context.Context, in *ProgramConfig, opts ...grpc.CallOption) (*ProgramConfig, error)
+	ProgramConfigure(ctx context.Context, in *ProgramConfig, opts ...grpc.CallOption) (*ProgramConfig, error)
+	ProgramExecute(ctx context.Context, in *ProgramCommand, opts ...grpc.CallOption) (*ProgramCommand, error)
+	ProgramGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProgramStatuses, error)
+}
+
+type programClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProgramClient(cc grpc.ClientConnInterface) ProgramClient {
+	return &programClient{cc}
+}
+
+func (c *programClient) ProgramGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProgramConfigs, error) {
+	out := new(ProgramConfigs)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Program/ProgramGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programClient) ProgramCreate(ctx context.Context, in *ProgramConfig, opts ...grpc.CallOption) (*ProgramConfig, error) {
+	out := new(ProgramConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Program/ProgramCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programClient) ProgramConfigure(ctx context.Context, in *ProgramConfig, opts ...grpc.CallOption) (*ProgramConfig, error) {
+	out := new(ProgramConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Program/ProgramConfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programClient) ProgramExecute(ctx context.Context, in *ProgramCommand, opts ...grpc.CallOption) (*ProgramCommand, error) {
+	out := new(ProgramCommand)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Program/ProgramExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programClient) ProgramGetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProgramStatuses, error) {
+	out := new(ProgramStatuses)
+	err := c.cc.Invoke(ctx, "/embeddedproto.Program/ProgramGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProgramServer is the server API for Program service.
+// All implementations must embed UnimplementedProgramServer
+// for forward compatibility
+type ProgramServer interface {
+	ProgramGet(context.Context, *empty.Empty) (*ProgramConfigs, error)
+	ProgramCreate(context.Context, *ProgramConfig) (*ProgramConfig, error)
+	ProgramConfigure(context.Context, *ProgramConfig) (*ProgramConfig, error)
+	ProgramExecute(context.Context, *ProgramCommand) (*ProgramCommand, error)
+	ProgramGetStatus(context.Context, *empty.Empty) (*ProgramStatuses, error)
+	mustEmbedUnimplementedProgramServer()
+}
+
+// UnimplementedProgramServer must be embedded to have forward compatible implementations.
+type UnimplementedProgramServer struct {
+}
+
+func (UnimplementedProgramServer) ProgramGet(context.Context, *empty.Empty) (*ProgramConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramGet not implemented")
+}
+func (UnimplementedProgramServer) ProgramCreate(context.Context, *ProgramConfig) (*ProgramConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramCreate not implemented")
+}
+func (UnimplementedProgramServer) ProgramConfigure(context.Context, *ProgramConfig) (*ProgramConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramConfigure not implemented")
+}
+func (UnimplementedProgramServer) ProgramExecute(context.Context, *ProgramCommand) (*ProgramCommand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramExecute not implemented")
+}
+func (UnimplementedProgramServer) ProgramGetStatus(context.Context, *empty.Empty) (*ProgramStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramGetStatus not implemented")
+}
+func (UnimplementedProgramServer) mustEmbedUnimplementedProgramServer() {}
+
+// UnsafeProgramServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProgramServer will
+// result in compilation errors.
+type UnsafeProgramServer interface {
+	mustEmbedUnimplementedProgramServer()
+}
+
+func RegisterProgramServer(s grpc.ServiceRegistrar, srv ProgramServer) {
+	s.RegisterService(&Program_ServiceDesc, srv)
+}
+
+func _Program_ProgramGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgramServer).ProgramGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Program/ProgramGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgramServer).ProgramGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Program_ProgramCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgramConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgramServer).ProgramCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Program/ProgramCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgramServer).ProgramCreate(ctx, req.(*ProgramConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Program_ProgramConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgramConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgramServer).ProgramConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Program/ProgramConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgramServer).ProgramConfigure(ctx, req.(*ProgramConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Program_ProgramExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgramCommand)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgramServer).ProgramExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Program/ProgramExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgramServer).ProgramExecute(ctx, req.(*ProgramCommand))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Program_ProgramGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgramServer).ProgramGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.Program/ProgramGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgramServer).ProgramGetStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Program_ServiceDesc is the grpc.ServiceDesc for Program service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Program_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.Program",
+	HandlerType: (*ProgramServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProgramGet",
+			Handler:    _Program_ProgramGet_Handler,
+		},
+		{
+			MethodName: "ProgramCreate",
+			Handler:    _Program_ProgramCreate_Handler,
+		},
+		{
+			MethodName: "ProgramConfigure",
+			Handler:    _Program_ProgramConfigure_Handler,
+		},
+		{
+			MethodName: "ProgramExecute",
+			Handler:    _Program_ProgramExecute_Handler,
+		},
+		{
+			MethodName: "ProgramGetStatus",
+			Handler:    _Program_ProgramGetStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/program.proto",
+}
@@ -25,6 +25,7 @@ const _ = grpc.SupportPackageIsVersion7
 type PTClient interface {
 	PTGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PTConfigs, error)
 	PTConfigure(ctx context.Context, in *PTConfig, opts ...grpc.CallOption) (*PTConfig, error)
+	PTDetectFaults(ctx context.Context, in *PTConfig, opts ...grpc.CallOption) (*PTConfig, error)
 	PTGetTemperatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PTTemperatures, error)
 }
 
@@ -54,6 +55,15 @@ func (c *pTClient) PTConfigure(ctx context.Context, in *PTConfig, opts ...grpc.C
 	return out, nil
 }
 
+func (c *pTClient) PTDetectFaults(ctx context.Context, in *PTConfig, opts ...grpc.CallOption) (*PTConfig, error) {
+	out := new(PTConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.PT/PTDetectFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pTClient) PTGetTemperatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PTTemperatures, error) {
 	out := new(PTTemperatures)
 	err := c.cc.Invoke(ctx, "/embeddedproto.PT/PTGetTemperatures", in, out, opts...)
@@ -69,6 +79,7 @@ func (c *pTClient) PTGetTemperatures(ctx context.Context, in *empty.Empty, opts
 type PTServer interface {
 	PTGet(context.Context, *empty.Empty) (*PTConfigs, error)
 	PTConfigure(context.Context, *PTConfig) (*PTConfig, error)
+	PTDetectFaults(context.Context, *PTConfig) (*PTConfig, error)
 	PTGetTemperatures(context.Context, *empty.Empty) (*PTTemperatures, error)
 	mustEmbedUnimplementedPTServer()
 }
@@ -83,6 +94,9 @@ func (UnimplementedPTServer) PTGet(context.Context, *empty.Empty) (*PTConfigs, e
 func (UnimplementedPTServer) PTConfigure(context.Context, *PTConfig) (*PTConfig, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PTConfigure not implemented")
 }
+func (UnimplementedPTServer) PTDetectFaults(context.Context, *PTConfig) (*PTConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PTDetectFaults not implemented")
+}
 func (UnimplementedPTServer) PTGetTemperatures(context.Context, *empty.Empty) (*PTTemperatures, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PTGetTemperatures not implemented")
 }
@@ -135,6 +149,24 @@ func _PT_PTConfigure_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PT_PTDetectFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PTConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTServer).PTDetectFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.PT/PTDetectFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTServer).PTDetectFaults(ctx, req.(*PTConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PT_PTGetTemperatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -168,6 +200,10 @@ var PT_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PTConfigure",
 			Handler:    _PT_PTConfigure_Handler,
 		},
+		{
+			MethodName: "PTDetectFaults",
+			Handler:    _PT_PTDetectFaults_Handler,
+		},
 		{
 			MethodName: "PTGetTemperatures",
 			Handler:    _PT_PTGetTemperatures_Handler,
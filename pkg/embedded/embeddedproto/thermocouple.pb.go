@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: pkg/embedded/embeddedproto/thermocouple.proto
+
+package embeddedproto
+
+import (
+	empty "github.com/golang/protobuf/ptypes/empty"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TCConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs []*TCConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+}
+
+func (x *TCConfigs) Reset() {
+	*x = TCConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCConfigs) ProtoMessage() {}
+
+func (x *TCConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCConfigs.ProtoReflect.Descriptor instead.
+func (*TCConfigs) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TCConfigs) GetConfigs() []*TCConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type TCConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Correction   float32 `protobuf:"fixed32,3,opt,name=Correction,proto3" json:"Correction,omitempty"`
+	PollInterval int32   `protobuf:"varint,4,opt,name=PollInterval,proto3" json:"PollInterval,omitempty"`
+	Samples      uint32  `protobuf:"varint,5,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	Enabled      bool    `protobuf:"varint,6,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Async        bool    `protobuf:"varint,7,opt,name=Async,proto3" json:"Async,omitempty"`
+	Type         string  `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
+	Filter       int32   `protobuf:"varint,9,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	Averaging    uint32  `protobuf:"varint,10,opt,name=Averaging,proto3" json:"Averaging,omitempty"`
+	Fault        uint32  `protobuf:"varint,11,opt,name=Fault,proto3" json:"Fault,omitempty"`
+	FaultText    string  `protobuf:"bytes,12,opt,name=FaultText,proto3" json:"FaultText,omitempty"`
+}
+
+func (x *TCConfig) Reset() {
+	*x = TCConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCConfig) ProtoMessage() {}
+
+func (x *TCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCConfig.ProtoReflect.Descriptor instead.
+func (*TCConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TCConfig) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *TCConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TCConfig) GetCorrection() float32 {
+	if x != nil {
+		return x.Correction
+	}
+	return 0
+}
+
+func (x *TCConfig) GetPollInterval() int32 {
+	if x != nil {
+		return x.PollInterval
+	}
+	return 0
+}
+
+func (x *TCConfig) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *TCConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TCConfig) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *TCConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TCConfig) GetFilter() int32 {
+	if x != nil {
+		return x.Filter
+	}
+	return 0
+}
+
+func (x *TCConfig) GetAveraging() uint32 {
+	if x != nil {
+		return x.Averaging
+	}
+	return 0
+}
+
+func (x *TCConfig) GetFault() uint32 {
+	if x != nil {
+		return x.Fault
+	}
+	return 0
+}
+
+func (x *TCConfig) GetFaultText() string {
+	if x != nil {
+		return x.FaultText
+	}
+	return ""
+}
+
+type TCTemperatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Temps []*TCTemperature `protobuf:"bytes,1,rep,name=temps,proto3" json:"temps,omitempty"`
+}
+
+func (x *TCTemperatures) Reset() {
+	*x = TCTemperatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCTemperatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCTemperatures) ProtoMessage() {}
+
+func (x *TCTemperatures) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCTemperatures.ProtoReflect.Descriptor instead.
+func (*TCTemperatures) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TCTemperatures) GetTemps() []*TCTemperature {
+	if x != nil {
+		return x.Temps
+	}
+	return nil
+}
+
+type TCTemperature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Readings []*TCReadings `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+}
+
+func (x *TCTemperature) Reset() {
+	*x = TCTemperature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCTemperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCTemperature) ProtoMessage() {}
+
+func (x *TCTemperature) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCTemperature.ProtoReflect.Descriptor instead.
+func (*TCTemperature) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TCTemperature) GetReadings() []*TCReadings {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+type TCReadings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Temperature  float32 `protobuf:"fixed32,2,opt,name=Temperature,proto3" json:"Temperature,omitempty"`
+	Average      float32 `protobuf:"fixed32,3,opt,name=Average,proto3" json:"Average,omitempty"`
+	ColdJunction float32 `protobuf:"fixed32,4,opt,name=ColdJunction,proto3" json:"ColdJunction,omitempty"`
+	StampMillis  int64   `protobuf:"varint,5,opt,name=StampMillis,proto3" json:"StampMillis,omitempty"`
+	Error        string  `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	Fault        uint32  `protobuf:"varint,7,opt,name=Fault,proto3" json:"Fault,omitempty"`
+	FaultText    string  `protobuf:"bytes,8,opt,name=FaultText,proto3" json:"FaultText,omitempty"`
+}
+
+func (x *TCReadings) Reset() {
+	*x = TCReadings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCReadings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCReadings) ProtoMessage() {}
+
+func (x *TCReadings) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCReadings.ProtoReflect.Descriptor instead.
+func (*TCReadings) Descriptor() ([]byte, []int) {
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TCReadings) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *TCReadings) GetTemperature() float32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *TCReadings) GetAverage() float32 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *TCReadings) GetColdJunction() float32 {
+	if x != nil {
+		return x.ColdJunction
+	}
+	return 0
+}
+
+func (x *TCReadings) GetStampMillis() int64 {
+	if x != nil {
+		return x.StampMillis
+	}
+	return 0
+}
+
+func (x *TCReadings) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TCReadings) GetFault() uint32 {
+	if x != nil {
+		return x.Fault
+	}
+	return 0
+}
+
+func (x *TCReadings) GetFaultText() string {
+	if x != nil {
+		return x.FaultText
+	}
+	return ""
+}
+
+var File_pkg_embedded_embeddedproto_thermocouple_proto protoreflect.FileDescriptor
+
+var file_pkg_embedded_embeddedproto_thermocouple_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x68, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x09, 0x54,
+	0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x08,
+	0x54, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0x44, 0x0a, 0x0e, 0x54, 0x43, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x65,
+	0x6d, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x54, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x05, 0x74, 0x65, 0x6d, 0x70, 0x73, 0x22, 0x46,
+	0x0a, 0x0d, 0x54, 0x43, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x43, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x54, 0x43, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x54, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x64, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x43, 0x6f, 0x6c, 0x64, 0x4a, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x78,
+	0x74, 0x32, 0xd2, 0x01, 0x0a, 0x02, 0x54, 0x43, 0x12, 0x3b, 0x0a, 0x05, 0x54, 0x43, 0x47, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x54, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x54, 0x43, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x43, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x00, 0x42, 0x39, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x2d, 0x63, 0x6c, 0x61, 0x70, 0x2f, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x65, 0x64, 0x2f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescOnce sync.Once
+	file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescData = file_pkg_embedded_embeddedproto_thermocouple_proto_rawDesc
+)
+
+func file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescGZIP() []byte {
+	file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescOnce.Do(func() {
+		file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescData)
+	})
+	return file_pkg_embedded_embeddedproto_thermocouple_proto_rawDescData
+}
+
+var file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_embedded_embeddedproto_thermocouple_proto_goTypes = []interface{}{
+	(*TCConfigs)(nil),      // 0: embeddedproto.TCConfigs
+	(*TCConfig)(nil),       // 1: embeddedproto.TCConfig
+	(*TCTemperatures)(nil), // 2: embeddedproto.TCTemperatures
+	(*TCTemperature)(nil),  // 3: embeddedproto.TCTemperature
+	(*TCReadings)(nil),     // 4: embeddedproto.TCReadings
+	(*empty.Empty)(nil),    // 5: google.protobuf.Empty
+}
+var file_pkg_embedded_embeddedproto_thermocouple_proto_depIdxs = []int32{
+	1, // 0: embeddedproto.TCConfigs.configs:type_name -> embeddedproto.TCConfig
+	3, // 1: embeddedproto.TCTemperatures.temps:type_name -> embeddedproto.TCTemperature
+	4, // 2: embeddedproto.TCTemperature.readings:type_name -> embeddedproto.TCReadings
+	5, // 3: embeddedproto.TC.TCGet:input_type -> google.protobuf.Empty
+	1, // 4: embeddedproto.TC.TCConfigure:input_type -> embeddedproto.TCConfig
+	5, // 5: embeddedproto.TC.TCGetTemperatures:input_type -> google.protobuf.Empty
+	0, // 6: embeddedproto.TC.TCGet:output_type -> embeddedproto.TCConfigs
+	1, // 7: embeddedproto.TC.TCConfigure:output_type -> embeddedproto.TCConfig
+	2, // 8: embeddedproto.TC.TCGetTemperatures:output_type -> embeddedproto.TCTemperatures
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_embedded_embeddedproto_thermocouple_proto_init() }
+func file_pkg_embedded_embeddedproto_thermocouple_proto_init() {
+	if File_pkg_embedded_embeddedproto_thermocouple_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCTemperatures); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCTemperature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCReadings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_embedded_embeddedproto_thermocouple_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_embedded_embeddedproto_thermocouple_proto_goTypes,
+		DependencyIndexes: file_pkg_embedded_embeddedproto_thermocouple_proto_depIdxs,
+		MessageInfos:      file_pkg_embedded_embeddedproto_thermocouple_proto_msgTypes,
+	}.Build()
+	File_pkg_embedded_embeddedproto_thermocouple_proto = out.File
+	file_pkg_embedded_embeddedproto_thermocouple_proto_rawDesc = nil
+	file_pkg_embedded_embeddedproto_thermocouple_proto_goTypes = nil
+	file_pkg_embedded_embeddedproto_thermocouple_proto_depIdxs = nil
+}
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: pkg/embedded/embeddedproto/thermocouple.proto
+
+package embeddedproto
+
+import (
+	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TCClient is the client API for TC service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TCClient interface {
+	TCGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TCConfigs, error)
+	TCConfigure(ctx context.Context, in *TCConfig, opts ...grpc.CallOption) (*TCConfig, error)
+	TCGetTemperatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TCTemperatures, error)
+}
+
+type tCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTCClient(cc grpc.ClientConnInterface) TCClient {
+	return &tCClient{cc}
+}
+
+func (c *tCClient) TCGet(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TCConfigs, error) {
+	out := new(TCConfigs)
+	err := c.cc.Invoke(ctx, "/embeddedproto.TC/TCGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tCClient) TCConfigure(ctx context.Context, in *TCConfig, opts ...grpc.CallOption) (*TCConfig, error) {
+	out := new(TCConfig)
+	err := c.cc.Invoke(ctx, "/embeddedproto.TC/TCConfigure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tCClient) TCGetTemperatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TCTemperatures, error) {
+	out := new(TCTemperatures)
+	err := c.cc.Invoke(ctx, "/embeddedproto.TC/TCGetTemperatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TCServer is the server API for TC service.
+// All implementations must embed UnimplementedTCServer
+// for forward compatibility
+type TCServer interface {
+	TCGet(context.Context, *empty.Empty) (*TCConfigs, error)
+	TCConfigure(context.Context, *TCConfig) (*TCConfig, error)
+	TCGetTemperatures(context.Context, *empty.Empty) (*TCTemperatures, error)
+	mustEmbedUnimplementedTCServer()
+}
+
+// UnimplementedTCServer must be embedded to have forward compatible implementations.
+type UnimplementedTCServer struct {
+}
+
+func (UnimplementedTCServer) TCGet(context.Context, *empty.Empty) (*TCConfigs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TCGet not implemented")
+}
+func (UnimplementedTCServer) TCConfigure(context.Context, *TCConfig) (*TCConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TCConfigure not implemented")
+}
+func (UnimplementedTCServer) TCGetTemperatures(context.Context, *empty.Empty) (*TCTemperatures, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TCGetTemperatures not implemented")
+}
+func (UnimplementedTCServer) mustEmbedUnimplementedTCServer() {}
+
+// UnsafeTCServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TCServer will
+// result in compilation errors.
+type UnsafeTCServer interface {
+	mustEmbedUnimplementedTCServer()
+}
+
+func RegisterTCServer(s grpc.ServiceRegistrar, srv TCServer) {
+	s.RegisterService(&TC_ServiceDesc, srv)
+}
+
+func _TC_TCGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TCServer).TCGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.TC/TCGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TCServer).TCGet(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TC_TCConfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TCConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TCServer).TCConfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.TC/TCConfigure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TCServer).TCConfigure(ctx, req.(*TCConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TC_TCGetTemperatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TCServer).TCGetTemperatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/embeddedproto.TC/TCGetTemperatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TCServer).TCGetTemperatures(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TC_ServiceDesc is the grpc.ServiceDesc for TC service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TC_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "embeddedproto.TC",
+	HandlerType: (*TCServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TCGet",
+			Handler:    _TC_TCGet_Handler,
+		},
+		{
+			MethodName: "TCConfigure",
+			Handler:    _TC_TCConfigure_Handler,
+		},
+		{
+			MethodName: "TCGetTemperatures",
+			Handler:    _TC_TCGetTemperatures_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/embedded/embeddedproto/thermocouple.proto",
+}
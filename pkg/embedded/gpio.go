@@ -6,6 +6,8 @@
 package embedded
 
 import (
+	"sync"
+
 	"github.com/a-clap/embedded/pkg/gpio"
 )
 
@@ -38,8 +40,10 @@ type GPIOConfig struct {
 	gpio.Config
 }
 
+// gpioHandler serializes changes of GPIO, mtx protects GPIOConfig
 type gpioHandler struct {
 	GPIO
+	mtx sync.Mutex
 	GPIOConfig
 }
 
@@ -52,6 +56,8 @@ func (g *GPIOHandler) SetConfig(cfg GPIOConfig) error {
 	if err != nil {
 		return &GPIOError{ID: cfg.ID, Op: "SetConfig.gpioBy", Err: err.Error()}
 	}
+	gp.mtx.Lock()
+	defer gp.mtx.Unlock()
 	if err := gp.Configure(cfg.Config); err != nil {
 		return &GPIOError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
 	}
@@ -89,6 +95,8 @@ func (g *GPIOHandler) gpioBy(id string) (*gpioHandler, error) {
 }
 
 func (g *gpioHandler) getConfig() (GPIOConfig, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
 	var err error
 	g.GPIOConfig.Config, err = g.GetConfig()
 	return g.GPIOConfig, err
@@ -33,6 +33,26 @@ func (p *HeaterClient) Configure(setConfig HeaterConfig) (HeaterConfig, error) {
 	return restclient.Put[HeaterConfig, *Error](p.addr+RoutesConfigHeater, p.timeout, setConfig)
 }
 
+func (p *HeaterClient) ClearFault(id string) (HeaterConfig, error) {
+	return restclient.Put[HeaterConfig, *Error](p.addr+RoutesClearHeaterFault, p.timeout, HeaterConfig{ID: id})
+}
+
+func (p *HeaterClient) SetPower(id string, pwr float64) (HeaterPower, error) {
+	return restclient.Put[HeaterPower, *Error](p.addr+RoutesSetHeaterPower, p.timeout, HeaterPower{ID: id, Power: pwr})
+}
+
+func (p *HeaterClient) Stats() ([]HeaterStats, error) {
+	return restclient.Get[[]HeaterStats, *Error](p.addr+RoutesGetHeaterStats, p.timeout)
+}
+
+func (p *HeaterClient) ResetStats(id string) (HeaterStats, error) {
+	return restclient.Put[HeaterStats, *Error](p.addr+RoutesResetHeaterStats, p.timeout, HeaterStats{ID: id})
+}
+
+func (p *HeaterClient) Groups() ([]HeaterGroupState, error) {
+	return restclient.Get[[]HeaterGroupState, *Error](p.addr+RoutesGetHeaterGroups, p.timeout)
+}
+
 type HeaterRPCClient struct {
 	timeout time.Duration
 	conn    *grpc.ClientConn
@@ -73,6 +93,64 @@ func (g *HeaterRPCClient) Configure(setConfig HeaterConfig) (HeaterConfig, error
 	return setConfig, nil
 }
 
+func (g *HeaterRPCClient) ClearFault(id string) (HeaterConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.HeaterClearFault(ctx, &embeddedproto.HeaterID{ID: id})
+	if err != nil {
+		return HeaterConfig{}, err
+	}
+	return rpcToHeaterConfig(got), nil
+}
+
+func (g *HeaterRPCClient) SetPower(id string, pwr float64) (HeaterPower, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.HeaterSetPower(ctx, &embeddedproto.HeaterPower{ID: id, Power: pwr})
+	if err != nil {
+		return HeaterPower{}, err
+	}
+	return HeaterPower{ID: got.ID, Power: got.Power}, nil
+}
+
+func (g *HeaterRPCClient) Stats() ([]HeaterStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.HeaterGetStats(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]HeaterStats, len(got.Stats))
+	for i, elem := range got.Stats {
+		stats[i] = rpcToHeaterStats(elem)
+	}
+	return stats, nil
+}
+
+func (g *HeaterRPCClient) ResetStats(id string) (HeaterStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.HeaterResetStats(ctx, &embeddedproto.HeaterID{ID: id})
+	if err != nil {
+		return HeaterStats{}, err
+	}
+	return rpcToHeaterStats(got), nil
+}
+
+func (g *HeaterRPCClient) Groups() ([]HeaterGroupState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.HeaterGetGroups(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]HeaterGroupState, len(got.Groups))
+	for i, elem := range got.Groups {
+		groups[i] = rpcToHeaterGroup(elem)
+	}
+	return groups, nil
+}
+
 func (g *HeaterRPCClient) Close() {
 	_ = g.conn.Close()
 }
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/heater"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -42,15 +43,15 @@ func (p *HeaterClientSuite) Test_Configure() {
 	var mocks []*HeaterMock
 	heaters := make(map[string]embedded.Heater, 0)
 	for _, arg := range args {
-		heater := new(HeaterMock)
+		heaterMock := new(HeaterMock)
 		if arg.Enabled {
-			heater.On("Enable", mock.Anything).Once()
+			heaterMock.On("Enable", mock.Anything).Once()
 		} else {
-			heater.On("Disable").Once()
+			heaterMock.On("Disable").Once()
 		}
 
-		mocks = append(mocks, heater)
-		heaters[arg.ID] = heater
+		mocks = append(mocks, heaterMock)
+		heaters[arg.ID] = heaterMock
 	}
 	h, _ := embedded.NewRest("", embedded.WithHeaters(heaters))
 	srv := httptest.NewServer(h.Router)
@@ -85,12 +86,117 @@ func (p *HeaterClientSuite) Test_Configure() {
 	}
 
 	mocks[0].On("Power").Return(args[0].Power)
+	mocks[0].On("Modulation").Return(heater.ModulationConfig{})
+	mocks[0].On("Fault").Return(heater.Fault{})
+	mocks[0].On("EffectivePower").Return(0.0)
+	mocks[0].On("RampRate").Return(0.0)
 	mocks[0].On("Enabled").Return(args[0].Enabled)
 	cfg, err := hc.Configure(args[0])
 	t.Nil(err)
 	t.EqualValues(args[0], cfg)
 }
 
+func (p *HeaterClientSuite) Test_ClearFault() {
+	t := p.Require()
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Enabled").Return(true)
+	heaterMock.On("Power").Return(uint(40))
+	heaterMock.On("Modulation").Return(heater.ModulationConfig{})
+	heaterMock.On("Fault").Return(heater.Fault{})
+	heaterMock.On("EffectivePower").Return(0.0)
+	heaterMock.On("RampRate").Return(0.0)
+	heaterMock.On("ClearFault").Once()
+
+	h, _ := embedded.NewRest("", embedded.WithHeaters(map[string]embedded.Heater{"heater": heaterMock}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	hc := embedded.NewHeaterClient(srv.URL, 1*time.Second)
+	_, err := hc.ClearFault("unknown")
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesClearHeaterFault)
+
+	cfg, err := hc.ClearFault("heater")
+	t.Nil(err)
+	t.Equal(embedded.HeaterConfig{ID: "heater", Enabled: true, Power: 40}, cfg)
+	heaterMock.AssertExpectations(p.T())
+}
+
+func (p *HeaterClientSuite) Test_SetPower() {
+	t := p.Require()
+	heaterMock := new(HeaterMock)
+
+	h, _ := embedded.NewRest("", embedded.WithHeaters(map[string]embedded.Heater{"heater": heaterMock}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	hc := embedded.NewHeaterClient(srv.URL, 1*time.Second)
+	_, err := hc.SetPower("unknown", 12.5)
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesSetHeaterPower)
+
+	errPower := errors.New("out of range")
+	heaterMock.On("SetPowerPrecise", 120.5).Return(errPower).Once()
+	_, err = hc.SetPower("heater", 120.5)
+	t.ErrorContains(err, errPower.Error())
+
+	// Fraction of percent reaches heater
+	heaterMock.On("SetPowerPrecise", 12.5).Return(nil).Once()
+	heaterMock.On("PowerPrecise").Return(12.5).Once()
+	pwr, err := hc.SetPower("heater", 12.5)
+	t.Nil(err)
+	t.Equal(embedded.HeaterPower{ID: "heater", Power: 12.5}, pwr)
+	heaterMock.AssertExpectations(p.T())
+}
+
+func (p *HeaterClientSuite) Test_Stats() {
+	t := p.Require()
+	stats := heater.Stats{OnTime: time.Minute, OnTimeSinceReset: time.Second, Duty: 12.5, RatedWatts: 1000}
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Stats").Return(stats)
+	heaterMock.On("ResetStats").Once()
+
+	h, _ := embedded.NewRest("", embedded.WithHeaters(map[string]embedded.Heater{"heater": heaterMock}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	hc := embedded.NewHeaterClient(srv.URL, 1*time.Second)
+	got, err := hc.Stats()
+	t.Nil(err)
+	t.Equal([]embedded.HeaterStats{{ID: "heater", Stats: stats}}, got)
+
+	_, err = hc.ResetStats("unknown")
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesResetHeaterStats)
+
+	reset, err := hc.ResetStats("heater")
+	t.Nil(err)
+	t.Equal(embedded.HeaterStats{ID: "heater", Stats: stats}, reset)
+	heaterMock.AssertExpectations(p.T())
+}
+
+func (p *HeaterClientSuite) Test_Groups() {
+	t := p.Require()
+	state := heater.GroupState{
+		GroupConfig: heater.GroupConfig{MaxWatts: 3000, Scaling: heater.ScalingPriority},
+		Requested:   4000,
+		Allocated:   3000,
+		Limited:     true,
+		Members:     []heater.GroupMember{{ID: "heater", Requested: 100, Allocated: 75}},
+	}
+	groupMock := new(HeaterGroupMock)
+	groupMock.On("State").Return(state)
+
+	h, _ := embedded.NewRest("", embedded.WithHeaterGroups(map[string]embedded.HeaterGroup{"circuit": groupMock}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	hc := embedded.NewHeaterClient(srv.URL, 1*time.Second)
+	got, err := hc.Groups()
+	t.Nil(err)
+	t.Equal([]embedded.HeaterGroupState{{ID: "circuit", GroupState: state}}, got)
+}
+
 func (p *HeaterClientSuite) Test_NotImplemented() {
 	t := p.Require()
 	h, _ := embedded.NewRest("")
@@ -5,6 +5,13 @@
 
 package embedded
 
+import (
+	"sync"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/a-clap/logging"
+)
+
 type HeaterError struct {
 	ID  string `json:"ID"`
 	Op  string `json:"op"`
@@ -27,34 +34,99 @@ type Heater interface {
 	Enable(chan error)
 	Disable()
 	SetPower(pwr uint) error
+	SetPowerPrecise(pwr float64) error
 	Enabled() bool
 	Power() uint
+	PowerPrecise() float64
+	Modulation() heater.ModulationConfig
+	SetModulation(cfg heater.ModulationConfig) error
+	Fault() heater.Fault
+	ClearFault()
+	Stats() heater.Stats
+	ResetStats()
+	EffectivePower() float64
+	RampRate() float64
 }
 
+// HeaterConfig is used to set and report Heater state, Modulation is changed only if Mode is not empty
 type HeaterConfig struct {
-	ID      string `json:"id"`
-	Enabled bool   `json:"enabled"`
-	Power   uint   `json:"power"`
+	ID         string                  `json:"id"`
+	Enabled    bool                    `json:"enabled"`
+	Power      uint                    `json:"power"`
+	Modulation heater.ModulationConfig `json:"modulation"`
+	// Fault is read-only, use ClearFault to reset it
+	Fault heater.Fault `json:"fault"`
+	// EffectivePower and RampRate are read-only, Power is ramped up to with RampRate %/s
+	EffectivePower float64 `json:"effective_power"`
+	RampRate       float64 `json:"ramp_rate"`
+}
+
+// HeaterPower sets power of Heater with fraction of percent
+type HeaterPower struct {
+	ID    string  `json:"id"`
+	Power float64 `json:"power"`
+}
+
+// HeaterStats reports on-time and energy used by Heater
+type HeaterStats struct {
+	ID string `json:"id"`
+	heater.Stats
+}
+
+// HeaterGroup limits total power of heaters
+type HeaterGroup interface {
+	State() heater.GroupState
+}
+
+// HeaterGroupState reports usage of shared power budget
+type HeaterGroupState struct {
+	ID string `json:"id"`
+	heater.GroupState
 }
 
+// HeaterHandler serializes changes of heaters, so config read by ConfigBy or Get is never half-applied.
+// owners keeps PID or Program, which drives heater, ownMtx protects it.
 type HeaterHandler struct {
 	heaters map[string]Heater
+	groups  map[string]HeaterGroup
+	errs    map[string]chan error
+	done    chan struct{}
+	mtx     sync.Mutex
+	owners  map[string]string
+	ownMtx  sync.Mutex
 }
 
 func (h *HeaterHandler) SetConfig(cfg HeaterConfig) error {
-	heater, err := h.by(cfg.ID)
+	heat, err := h.by(cfg.ID)
 	if err != nil {
 		return &HeaterError{ID: cfg.ID, Op: "SetConfig", Err: err.Error()}
 	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
 	
-	if err := heater.SetPower(cfg.Power); err != nil {
-		return err
+	if err := heat.SetPower(cfg.Power); err != nil {
+		return &HeaterError{ID: cfg.ID, Op: "SetConfig.SetPower", Err: err.Error()}
+	}
+	if cfg.Modulation.Mode != "" && cfg.Modulation != heat.Modulation() {
+		// Invalid config doesn't switch off running heater
+		if _, _, err := heater.NewModulator(cfg.Modulation); err != nil {
+			return &HeaterError{ID: cfg.ID, Op: "SetConfig.NewModulator", Err: err.Error()}
+		}
+		// Modulator can't be changed on running heater
+		enabled := heat.Enabled()
+		heat.Disable()
+		if err := heat.SetModulation(cfg.Modulation); err != nil {
+			// e.g. lack of zero cross, heater is left as it was
+			if enabled {
+				heat.Enable(h.errs[cfg.ID])
+			}
+			return &HeaterError{ID: cfg.ID, Op: "SetConfig.SetModulation", Err: err.Error()}
+		}
 	}
 	if cfg.Enabled {
-		// TODO: add channel to handle errors
-		heater.Enable(nil)
+		heat.Enable(h.errs[cfg.ID])
 	} else {
-		heater.Disable()
+		heat.Disable()
 	}
 	return nil
 }
@@ -64,8 +136,10 @@ func (h *HeaterHandler) Enable(id string, ena bool) error {
 	if err != nil {
 		return &HeaterError{ID: id, Op: "Enable", Err: err.Error()}
 	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
 	if ena {
-		heat.Enable(nil)
+		heat.Enable(h.errs[id])
 	} else {
 		heat.Disable()
 	}
@@ -77,38 +151,153 @@ func (h *HeaterHandler) Power(id string, pwr uint) error {
 	if err != nil {
 		return &HeaterError{ID: id, Op: "Power", Err: err.Error()}
 	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
 	if err := heat.SetPower(pwr); err != nil {
 		return &HeaterError{ID: id, Op: "Power.SetPower", Err: err.Error()}
 	}
 	return nil
 }
 
+// PowerPrecise sets power with fraction of percent, it is rounded to Resolution of modulation
+func (h *HeaterHandler) PowerPrecise(id string, pwr float64) error {
+	heat, err := h.by(id)
+	if err != nil {
+		return &HeaterError{ID: id, Op: "PowerPrecise", Err: err.Error()}
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if err := heat.SetPowerPrecise(pwr); err != nil {
+		return &HeaterError{ID: id, Op: "PowerPrecise.SetPowerPrecise", Err: err.Error()}
+	}
+	return nil
+}
+
+// PowerBy returns requested power of Heater with fraction of percent
+func (h *HeaterHandler) PowerBy(id string) (HeaterPower, error) {
+	heat, err := h.by(id)
+	if err != nil {
+		return HeaterPower{}, &HeaterError{ID: id, Op: "PowerBy", Err: err.Error()}
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return HeaterPower{ID: id, Power: heat.PowerPrecise()}, nil
+}
+
 func (h *HeaterHandler) ConfigBy(id string) (HeaterConfig, error) {
 	heat, err := h.by(id)
 	if err != nil {
 		return HeaterConfig{}, &HeaterError{ID: id, Op: "ConfigBy", Err: err.Error()}
 	}
-	return HeaterConfig{
-		ID:      id,
-		Enabled: heat.Enabled(),
-		Power:   heat.Power(),
-	}, nil
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return heaterConfig(id, heat), nil
+}
+
+// ClearFault resets failures recorded by Heater
+func (h *HeaterHandler) ClearFault(id string) error {
+	heat, err := h.by(id)
+	if err != nil {
+		return &HeaterError{ID: id, Op: "ClearFault", Err: err.Error()}
+	}
+	heat.ClearFault()
+	return nil
+}
+
+// StatsBy returns statistics of Heater with specified id
+func (h *HeaterHandler) StatsBy(id string) (HeaterStats, error) {
+	heat, err := h.by(id)
+	if err != nil {
+		return HeaterStats{}, &HeaterError{ID: id, Op: "StatsBy", Err: err.Error()}
+	}
+	return HeaterStats{ID: id, Stats: heat.Stats()}, nil
+}
+
+// Stats returns statistics of all heaters
+func (h *HeaterHandler) Stats() []HeaterStats {
+	stats := make([]HeaterStats, 0, len(h.heaters))
+	for id, heat := range h.heaters {
+		stats = append(stats, HeaterStats{ID: id, Stats: heat.Stats()})
+	}
+	return stats
+}
+
+// ResetStats starts new accounting period, totals since start are kept
+func (h *HeaterHandler) ResetStats(id string) error {
+	heat, err := h.by(id)
+	if err != nil {
+		return &HeaterError{ID: id, Op: "ResetStats", Err: err.Error()}
+	}
+	heat.ResetStats()
+	return nil
+}
+
+// GroupBy returns state of HeaterGroup with specified id
+func (h *HeaterHandler) GroupBy(id string) (HeaterGroupState, error) {
+	group, ok := h.groups[id]
+	if !ok {
+		return HeaterGroupState{}, &HeaterError{ID: id, Op: "GroupBy", Err: ErrNoSuchID.Error()}
+	}
+	return HeaterGroupState{ID: id, GroupState: group.State()}, nil
+}
+
+// Groups returns state of all heater groups
+func (h *HeaterHandler) Groups() []HeaterGroupState {
+	groups := make([]HeaterGroupState, 0, len(h.groups))
+	for id, group := range h.groups {
+		groups = append(groups, HeaterGroupState{ID: id, GroupState: group.State()})
+	}
+	return groups
 }
 
 func (h *HeaterHandler) Get() []HeaterConfig {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
 	status := make([]HeaterConfig, len(h.heaters))
 	pos := 0
 	for id, heat := range h.heaters {
-		status[pos] = HeaterConfig{
-			ID:      id,
-			Enabled: heat.Enabled(),
-			Power:   heat.Power(),
-		}
+		status[pos] = heaterConfig(id, heat)
 		pos++
 	}
 	return status
 }
 
+// heaterConfig returns snapshot of Heater, HeaterHandler.mtx must be locked
+func heaterConfig(id string, heat Heater) HeaterConfig {
+	return HeaterConfig{
+		ID:             id,
+		Enabled:        heat.Enabled(),
+		Power:          heat.Power(),
+		Modulation:     heat.Modulation(),
+		Fault:          heat.Fault(),
+		EffectivePower: heat.EffectivePower(),
+		RampRate:       heat.RampRate(),
+	}
+}
+
+// claim makes owner the only one, which drives heater. Heater claimed by other owner is busy
+func (h *HeaterHandler) claim(id, owner string) error {
+	h.ownMtx.Lock()
+	defer h.ownMtx.Unlock()
+	if current, ok := h.owners[id]; ok && current != owner {
+		return ErrHeaterBusy
+	}
+	if h.owners == nil {
+		h.owners = make(map[string]string)
+	}
+	h.owners[id] = owner
+	return nil
+}
+
+// release frees heater, if it is claimed by owner
+func (h *HeaterHandler) release(id, owner string) {
+	h.ownMtx.Lock()
+	defer h.ownMtx.Unlock()
+	if h.owners[id] == owner {
+		delete(h.owners, id)
+	}
+}
+
 func (h *HeaterHandler) by(id string) (Heater, error) {
 	maybeHeater, ok := h.heaters[id]
 	if !ok {
@@ -118,7 +307,27 @@ func (h *HeaterHandler) by(id string) (Heater, error) {
 }
 
 func (h *HeaterHandler) Open() {
+	h.done = make(chan struct{})
+	h.errs = make(map[string]chan error, len(h.heaters))
+	for id := range h.heaters {
+		errs := make(chan error, 10)
+		h.errs[id] = errs
+		go h.logErrors(id, errs)
+	}
 }
 
 func (h *HeaterHandler) Close() {
+	close(h.done)
+}
+
+// logErrors logs failures reported by Heater, state of failures is kept by Heater itself
+func (h *HeaterHandler) logErrors(id string, errs chan error) {
+	for {
+		select {
+		case <-h.done:
+			return
+		case err := <-errs:
+			logger.Error("Heater failure", logging.String("ID", id), logging.String("error", err.Error()))
+		}
+	}
 }
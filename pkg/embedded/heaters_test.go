@@ -14,8 +14,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/heater"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -88,6 +90,10 @@ func (t *HeaterTestSuite) TestHeater_PutHeaterAllGood_ReturnValuesFromInterface(
 
 	heaterMock.On("Enabled").Return(returnHeater.Enabled).Twice()
 	heaterMock.On("Power").Return(returnHeater.Power).Twice()
+	heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Twice()
+	heaterMock.On("Fault").Return(heater.Fault{}).Twice()
+	heaterMock.On("EffectivePower").Return(0.0).Twice()
+	heaterMock.On("RampRate").Return(0.0).Twice()
 
 	var body bytes.Buffer
 	_ = json.NewEncoder(&body).Encode(setHeater)
@@ -122,6 +128,10 @@ func (t *HeaterTestSuite) TestHeater_PutHeaterAllGoodTwice() {
 
 		heaterMock.On("Enabled").Return(expectedHeater.Enabled).Once()
 		heaterMock.On("Power").Return(expectedHeater.Power).Once()
+		heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Once()
+		heaterMock.On("Fault").Return(heater.Fault{}).Once()
+		heaterMock.On("EffectivePower").Return(0.0).Once()
+		heaterMock.On("RampRate").Return(0.0).Once()
 
 		var body bytes.Buffer
 		if err := json.NewEncoder(&body).Encode(expectedHeater); err != nil {
@@ -154,6 +164,10 @@ func (t *HeaterTestSuite) TestHeater_PutHeaterAllGoodTwice() {
 
 		heaterMock.On("Enabled").Return(newExpected.Enabled).Once()
 		heaterMock.On("Power").Return(newExpected.Power).Once()
+		heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Once()
+		heaterMock.On("Fault").Return(heater.Fault{}).Once()
+		heaterMock.On("EffectivePower").Return(0.0).Once()
+		heaterMock.On("RampRate").Return(0.0).Once()
 
 		var body bytes.Buffer
 		_ = json.NewEncoder(&body).Encode(newExpected)
@@ -180,11 +194,15 @@ func (t *HeaterTestSuite) TestHeater_PutHeaterInterfaceError() {
 	}
 	errOnSetPower := errors.New("nope, sorry")
 	for _, arg := range args {
-		heater := new(HeaterMock)
-		heater.On("Enabled").Return(arg.Enabled).Maybe()
-		heater.On("Power").Return(arg.Power).Maybe()
-		heater.On("SetPower", mock.Anything).Return(errOnSetPower).Once()
-		t.mock[arg.ID] = heater
+		heaterMock := new(HeaterMock)
+		heaterMock.On("Enabled").Return(arg.Enabled).Maybe()
+		heaterMock.On("Power").Return(arg.Power).Maybe()
+		heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Maybe()
+		heaterMock.On("Fault").Return(heater.Fault{}).Maybe()
+		heaterMock.On("EffectivePower").Return(0.0).Maybe()
+		heaterMock.On("RampRate").Return(0.0).Maybe()
+		heaterMock.On("SetPower", mock.Anything).Return(errOnSetPower).Once()
+		t.mock[arg.ID] = heaterMock
 	}
 
 	var body bytes.Buffer
@@ -215,10 +233,14 @@ func (t *HeaterTestSuite) TestHeater_GetHeater() {
 	}
 
 	for _, arg := range args {
-		heater := new(HeaterMock)
-		heater.On("Enabled").Return(arg.Enabled).Once()
-		heater.On("Power").Return(arg.Power).Once()
-		t.mock[arg.ID] = heater
+		heaterMock := new(HeaterMock)
+		heaterMock.On("Enabled").Return(arg.Enabled).Once()
+		heaterMock.On("Power").Return(arg.Power).Once()
+		heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Once()
+		heaterMock.On("Fault").Return(heater.Fault{}).Once()
+		heaterMock.On("EffectivePower").Return(0.0).Once()
+		heaterMock.On("RampRate").Return(0.0).Once()
+		t.mock[arg.ID] = heaterMock
 	}
 
 	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaters, nil)
@@ -276,16 +298,20 @@ func (t *HeaterTestSuite) TestHeater_MultipleHeaters() {
 	}
 
 	for _, arg := range args {
-		heater := new(HeaterMock)
+		heaterMock := new(HeaterMock)
 		if arg.enabled {
-			heater.On("Enable", mock.Anything).Once()
+			heaterMock.On("Enable", mock.Anything).Once()
 		} else {
-			heater.On("Disable").Once()
+			heaterMock.On("Disable").Once()
 		}
-		heater.On("SetPower", arg.power).Return(nil).Once()
-		heater.On("Enabled").Return(arg.enabled).Once()
-		heater.On("Power").Return(arg.power).Once()
-		t.mock[arg.name] = heater
+		heaterMock.On("SetPower", arg.power).Return(nil).Once()
+		heaterMock.On("Enabled").Return(arg.enabled).Once()
+		heaterMock.On("Power").Return(arg.power).Once()
+		heaterMock.On("Modulation").Return(heater.ModulationConfig{}).Once()
+		heaterMock.On("Fault").Return(heater.Fault{}).Once()
+		heaterMock.On("EffectivePower").Return(0.0).Once()
+		heaterMock.On("RampRate").Return(0.0).Once()
+		t.mock[arg.name] = heaterMock
 	}
 
 	mainHandler, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
@@ -319,6 +345,10 @@ func (t *HeaterTestSuite) TestHeater_SingleHeater() {
 	firstMock.On("SetPower", uint(16)).Return(nil).Once()
 	firstMock.On("Enabled").Return(true).Once()
 	firstMock.On("Power").Return(uint(16)).Once()
+	firstMock.On("Modulation").Return(heater.ModulationConfig{}).Once()
+	firstMock.On("Fault").Return(heater.Fault{}).Once()
+	firstMock.On("EffectivePower").Return(0.0).Once()
+	firstMock.On("RampRate").Return(0.0).Once()
 
 	t.mock["firstMock"] = firstMock
 
@@ -367,6 +397,10 @@ func (h *HeaterMock) SetPower(pwr uint) error {
 	return h.Called(pwr).Error(0)
 }
 
+func (h *HeaterMock) SetPowerPrecise(pwr float64) error {
+	return h.Called(pwr).Error(0)
+}
+
 func (h *HeaterMock) Enabled() bool {
 	return h.Called().Bool(0)
 }
@@ -375,3 +409,254 @@ func (h *HeaterMock) Power() uint {
 	args := h.Called()
 	return args.Get(0).(uint)
 }
+
+func (h *HeaterMock) PowerPrecise() float64 {
+	return h.Called().Get(0).(float64)
+}
+
+func (h *HeaterMock) Fault() heater.Fault {
+	return h.Called().Get(0).(heater.Fault)
+}
+
+func (h *HeaterMock) ClearFault() {
+	h.Called()
+}
+
+func (h *HeaterMock) Stats() heater.Stats {
+	return h.Called().Get(0).(heater.Stats)
+}
+
+func (h *HeaterMock) ResetStats() {
+	h.Called()
+}
+
+func (h *HeaterMock) EffectivePower() float64 {
+	return h.Called().Get(0).(float64)
+}
+
+func (h *HeaterMock) RampRate() float64 {
+	return h.Called().Get(0).(float64)
+}
+
+func (h *HeaterMock) SetModulation(cfg heater.ModulationConfig) error {
+	return h.Called(cfg).Error(0)
+}
+
+func (h *HeaterMock) Modulation() heater.ModulationConfig {
+	args := h.Called()
+	return args.Get(0).(heater.ModulationConfig)
+}
+
+func (t *HeaterTestSuite) TestHeater_ReportsModulation() {
+	modulation := heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Window: 100, Resolution: 1000}
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Enabled").Return(true).Once()
+	heaterMock.On("Power").Return(uint(12)).Once()
+	heaterMock.On("Modulation").Return(modulation).Once()
+	heaterMock.On("Fault").Return(heater.Fault{}).Once()
+	heaterMock.On("EffectivePower").Return(0.0).Once()
+	heaterMock.On("RampRate").Return(0.0).Once()
+	t.mock["heater"] = heaterMock
+
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaters, nil)
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+
+	t.Equal(http.StatusOK, t.resp.Code)
+	expected := []embedded.HeaterConfig{{ID: "heater", Enabled: true, Power: 12, Modulation: modulation}}
+	t.JSONEq(toJSON(expected), string(b))
+}
+
+func (t *HeaterTestSuite) TestHeater_SetModulation() {
+	current := heater.ModulationConfig{Mode: heater.ModulationBurst, Window: 100, Resolution: 100}
+	phase := heater.ModulationConfig{Mode: heater.ModulationPhaseAngle, Window: 100, Resolution: 100}
+
+	heaterMock := new(HeaterMock)
+	heaterMock.On("SetPower", uint(30)).Return(nil)
+	heaterMock.On("Modulation").Return(current).Once()
+	// Heater is disabled before modulation is changed, then enabled again
+	heaterMock.On("Enabled").Return(false).Once()
+	heaterMock.On("Disable").Once()
+	heaterMock.On("SetModulation", phase).Return(nil).Once()
+	heaterMock.On("Enable", mock.Anything).Once()
+	t.mock["heater"] = heaterMock
+
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+	t.Nil(h.Heaters.SetConfig(embedded.HeaterConfig{ID: "heater", Enabled: true, Power: 30, Modulation: phase}))
+
+	// Same modulation - nothing to change
+	heaterMock.On("Modulation").Return(phase).Once()
+	heaterMock.On("Enable", mock.Anything).Once()
+	t.Nil(h.Heaters.SetConfig(embedded.HeaterConfig{ID: "heater", Enabled: true, Power: 30, Modulation: phase}))
+
+	// Error on SetModulation, running heater is enabled again
+	errModulation := errors.New("no zero cross")
+	heaterMock.On("Modulation").Return(phase).Once()
+	heaterMock.On("Enabled").Return(true).Once()
+	heaterMock.On("Disable").Once()
+	heaterMock.On("SetModulation", current).Return(errModulation).Once()
+	heaterMock.On("Enable", mock.Anything).Once()
+	t.ErrorContains(h.Heaters.SetConfig(embedded.HeaterConfig{ID: "heater", Enabled: true, Power: 30, Modulation: current}), errModulation.Error())
+
+	// Unknown mode is rejected before heater is disabled
+	heaterMock.On("Modulation").Return(phase).Once()
+	err := h.Heaters.SetConfig(embedded.HeaterConfig{ID: "heater", Enabled: true, Power: 30, Modulation: heater.ModulationConfig{Mode: "pwm"}})
+	t.ErrorContains(err, heater.ErrUnknownModulation.Error())
+
+	heaterMock.AssertExpectations(t.T())
+	heaterMock.AssertNumberOfCalls(t.T(), "Disable", 2)
+}
+
+func (t *HeaterTestSuite) TestHeater_Fault() {
+	stamp := time.Date(2023, 3, 4, 5, 6, 7, 0, time.UTC)
+	fault := heater.Fault{LastError: "gpio write failed", Count: 3, Consecutive: 2, First: stamp, Last: stamp.Add(time.Second), AutoDisabled: true}
+
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Enabled").Return(false)
+	heaterMock.On("Power").Return(uint(0))
+	heaterMock.On("Modulation").Return(heater.ModulationConfig{})
+	heaterMock.On("Fault").Return(fault).Once()
+	heaterMock.On("EffectivePower").Return(0.0).Once()
+	heaterMock.On("RampRate").Return(0.0).Once()
+	t.mock["heater"] = heaterMock
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+
+	cfg, err := h.Heaters.ConfigBy("heater")
+	t.Nil(err)
+	t.Equal(fault, cfg.Fault)
+
+	// Clear
+	heaterMock.On("ClearFault").Once()
+	heaterMock.On("Fault").Return(heater.Fault{}).Once()
+	heaterMock.On("EffectivePower").Return(0.0).Once()
+	heaterMock.On("RampRate").Return(0.0).Once()
+	var body bytes.Buffer
+	_ = json.NewEncoder(&body).Encode(embedded.HeaterConfig{ID: "heater"})
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesClearHeaterFault, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(embedded.HeaterConfig{ID: "heater"}), string(b))
+
+	// Wrong ID
+	t.resp = httptest.NewRecorder()
+	_ = json.NewEncoder(&body).Encode(embedded.HeaterConfig{ID: "unknown"})
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesClearHeaterFault, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusInternalServerError, t.resp.Code)
+	t.Contains(string(b), embedded.ErrNoSuchID.Error())
+
+	heaterMock.AssertExpectations(t.T())
+}
+
+func (t *HeaterTestSuite) TestHeater_Stats() {
+	stamp := time.Date(2023, 3, 4, 5, 6, 7, 0, time.UTC)
+	stats := heater.Stats{Started: stamp, OnTime: time.Hour, Reset: stamp, OnTimeSinceReset: time.Hour, Duty: 40, RatedWatts: 2000, EnergyWh: 2000, EnergyWhSinceReset: 2000}
+
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Stats").Return(stats).Once()
+	t.mock["heater"] = heaterMock
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaterStats, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON([]embedded.HeaterStats{{ID: "heater", Stats: stats}}), string(b))
+
+	// Reset
+	reset := stats
+	reset.OnTimeSinceReset = 0
+	reset.EnergyWhSinceReset = 0
+	heaterMock.On("ResetStats").Once()
+	heaterMock.On("Stats").Return(reset).Once()
+
+	t.resp = httptest.NewRecorder()
+	var body bytes.Buffer
+	_ = json.NewEncoder(&body).Encode(embedded.HeaterStats{ID: "heater"})
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesResetHeaterStats, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(embedded.HeaterStats{ID: "heater", Stats: reset}), string(b))
+
+	// Wrong ID
+	t.resp = httptest.NewRecorder()
+	_ = json.NewEncoder(&body).Encode(embedded.HeaterStats{ID: "unknown"})
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesResetHeaterStats, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusInternalServerError, t.resp.Code)
+	t.Contains(string(b), embedded.ErrNoSuchID.Error())
+
+	heaterMock.AssertExpectations(t.T())
+}
+
+func (t *HeaterTestSuite) TestHeater_ReportsEffectivePower() {
+	heaterMock := new(HeaterMock)
+	heaterMock.On("Enabled").Return(true)
+	heaterMock.On("Power").Return(uint(80))
+	heaterMock.On("Modulation").Return(heater.ModulationConfig{})
+	heaterMock.On("Fault").Return(heater.Fault{})
+	heaterMock.On("EffectivePower").Return(12.5)
+	heaterMock.On("RampRate").Return(25.0)
+	t.mock["heater"] = heaterMock
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+
+	expected := []embedded.HeaterConfig{{ID: "heater", Enabled: true, Power: 80, EffectivePower: 12.5, RampRate: 25}}
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaters, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(expected), string(b))
+	t.Contains(string(b), `"effective_power":12.5`)
+}
+
+type HeaterGroupMock struct {
+	mock.Mock
+}
+
+func (g *HeaterGroupMock) State() heater.GroupState {
+	return g.Called().Get(0).(heater.GroupState)
+}
+
+func (t *HeaterTestSuite) TestHeater_Groups() {
+	state := heater.GroupState{
+		GroupConfig: heater.GroupConfig{MaxPower: 100, Scaling: heater.ScalingProportional},
+		Requested:   160,
+		Allocated:   100,
+		Limited:     true,
+		Members:     []heater.GroupMember{{ID: "first", Requested: 80, Allocated: 50}, {ID: "second", Requested: 80, Allocated: 50}},
+	}
+	groupMock := new(HeaterGroupMock)
+	groupMock.On("State").Return(state)
+
+	// Lack of groups
+	h, _ := embedded.NewRest("", embedded.WithHeaters(t.heaters()))
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaterGroups, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusInternalServerError, t.resp.Code)
+	t.Contains(string(b), embedded.ErrNotImplemented.Error())
+
+	h, _ = embedded.NewRest("", embedded.WithHeaters(t.heaters()), embedded.WithHeaterGroups(map[string]embedded.HeaterGroup{"circuit": groupMock}))
+	group, err := h.Heaters.GroupBy("circuit")
+	t.Nil(err)
+	t.Equal(embedded.HeaterGroupState{ID: "circuit", GroupState: state}, group)
+	_, err = h.Heaters.GroupBy("unknown")
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+
+	t.resp = httptest.NewRecorder()
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetHeaterGroups, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON([]embedded.HeaterGroupState{{ID: "circuit", GroupState: state}}), string(b))
+	t.Contains(string(b), `"max_power":100`)
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"sync"
+
+	"github.com/a-clap/embedded/pkg/iio"
+)
+
+type IIOSensor interface {
+	ID() string
+	Poll() error
+	Configure(config iio.SensorConfig) error
+	GetConfig() iio.SensorConfig
+	Average() float64
+	Value() (actual float64, average float64, err error)
+	GetReadings() []iio.Readings
+	Close() error
+}
+
+type IIOError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *IIOError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+type IIOSensorConfig struct {
+	Enabled bool `json:"enabled"`
+	iio.SensorConfig
+}
+
+// iioSensor serializes changes of IIOSensor, mtx protects IIOSensorConfig
+type iioSensor struct {
+	IIOSensor
+	mtx sync.Mutex
+	IIOSensorConfig
+}
+
+type IIOValue struct {
+	Readings []iio.Readings
+}
+
+// IIOHandler is responsible for handling analog inputs of IIO devices
+type IIOHandler struct {
+	sensors map[string]*iioSensor
+}
+
+func (i *IIOHandler) GetValues() []IIOValue {
+	values := make([]IIOValue, 0, len(i.sensors))
+	for _, sensor := range i.sensors {
+		if sensor.enabled() {
+			values = append(values, IIOValue{Readings: sensor.GetReadings()})
+		}
+	}
+	return values
+}
+
+func (i *IIOHandler) GetSensors() []IIOSensorConfig {
+	sensors := make([]IIOSensorConfig, 0, len(i.sensors))
+	for _, sensor := range i.sensors {
+		sensor.mtx.Lock()
+		sensors = append(sensors, sensor.IIOSensorConfig)
+		sensor.mtx.Unlock()
+	}
+	return sensors
+}
+
+func (i *IIOHandler) SetConfig(cfg IIOSensorConfig) (newCfg IIOSensorConfig, err error) {
+	sensor, err := i.sensorBy(cfg.ID)
+	if err != nil {
+		err = &IIOError{ID: cfg.ID, Op: "SetConfig.sensorBy", Err: err.Error()}
+		return
+	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+
+	if err = sensor.Configure(cfg.SensorConfig); err != nil {
+		err = &IIOError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
+		return
+	}
+
+	if cfg.Enabled != sensor.Enabled {
+		if cfg.Enabled {
+			if err = sensor.Poll(); err != nil {
+				err = &IIOError{ID: cfg.ID, Op: "SetConfig.Poll", Err: err.Error()}
+				return
+			}
+		} else {
+			if err = sensor.Close(); err != nil {
+				err = &IIOError{ID: cfg.ID, Op: "SetConfig.Close", Err: err.Error()}
+				return
+			}
+		}
+	}
+	sensor.Enabled = cfg.Enabled
+
+	return sensor.config(), nil
+}
+
+func (i *IIOHandler) GetConfig(id string) (IIOSensorConfig, error) {
+	sensor, err := i.sensorBy(id)
+	if err != nil {
+		return IIOSensorConfig{}, &IIOError{ID: id, Op: "GetConfig.sensorBy", Err: err.Error()}
+	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+	return sensor.config(), nil
+}
+
+func (i *IIOHandler) sensorBy(id string) (*iioSensor, error) {
+	maybeSensor, ok := i.sensors[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return maybeSensor, nil
+}
+
+func (i *IIOHandler) Open() {
+}
+
+func (i *IIOHandler) Close() []error {
+	var errs []error
+	for name, sensor := range i.sensors {
+		sensor.mtx.Lock()
+		if sensor.Enabled {
+			sensor.Enabled = false
+			if err := sensor.Close(); err != nil {
+				err = &IIOError{ID: name, Op: "Close", Err: err.Error()}
+				errs = append(errs, err)
+			}
+		}
+		sensor.mtx.Unlock()
+	}
+	return errs
+}
+
+// config refreshes and returns snapshot of config, s.mtx must be locked
+func (s *iioSensor) config() IIOSensorConfig {
+	s.SensorConfig = s.GetConfig()
+	return s.IIOSensorConfig
+}
+
+// enabled returns true, if sensor is polling
+func (s *iioSensor) enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.Enabled
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type IIOClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewIIOClient(addr string, timeout time.Duration) *IIOClient {
+	return &IIOClient{addr: addr, timeout: timeout}
+}
+
+func (i *IIOClient) Get() ([]IIOSensorConfig, error) {
+	return restclient.Get[[]IIOSensorConfig, *Error](i.addr+RoutesGetIIOSensors, i.timeout)
+}
+
+func (i *IIOClient) Configure(setConfig IIOSensorConfig) (IIOSensorConfig, error) {
+	return restclient.Put[IIOSensorConfig, *Error](i.addr+RoutesConfigIIOSensor, i.timeout, setConfig)
+}
+
+func (i *IIOClient) Values() ([]IIOValue, error) {
+	return restclient.Get[[]IIOValue, *Error](i.addr+RoutesGetIIOValues, i.timeout)
+}
+
+type IIORPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.IIOClient
+}
+
+func NewIIORPCClient(addr string, timeout time.Duration) (*IIORPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &IIORPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewIIOClient(conn)}, nil
+}
+
+func (g *IIORPCClient) Get() ([]IIOSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.IIOGet(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	confs := make([]IIOSensorConfig, len(got.Configs))
+	for i, elem := range got.Configs {
+		confs[i] = rpcToIIOConfig(elem)
+	}
+	return confs, nil
+}
+
+func (g *IIORPCClient) Configure(setConfig IIOSensorConfig) (IIOSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	set := iioConfigToRPC(&setConfig)
+	got, err := g.client.IIOConfigure(ctx, set)
+	if err != nil {
+		return IIOSensorConfig{}, err
+	}
+	return rpcToIIOConfig(got), nil
+}
+
+func (g *IIORPCClient) Values() ([]IIOValue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.IIOGetValues(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return rpcToIIOValue(got), nil
+}
+
+func (g *IIORPCClient) Close() {
+	_ = g.conn.Close()
+}
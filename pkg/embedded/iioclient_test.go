@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/iio"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type IIOClientSuite struct {
+	suite.Suite
+}
+
+// IIOMock implements embedded.IIOSensor
+type IIOMock struct {
+	mock.Mock
+}
+
+func TestIIOClient(t *testing.T) {
+	suite.Run(t, new(IIOClientSuite))
+}
+
+func (p *IIOClientSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (p *IIOClientSuite) Test_Values() {
+	t := p.Require()
+
+	args := []struct {
+		cfg      embedded.IIOSensorConfig
+		readings []iio.Readings
+	}{
+		{
+			cfg: embedded.IIOSensorConfig{
+				SensorConfig: iio.SensorConfig{
+					ID:           "pressure",
+					PollInterval: 100,
+					Samples:      13,
+					Scaling:      iio.Scaling{Gain: 2.5, Offset: -1.25, Unit: "bar"},
+				},
+			},
+			readings: []iio.Readings{
+				{
+					ID:      "pressure",
+					Raw:     40000,
+					Voltage: 2.5,
+					Value:   5,
+					Average: 4.9,
+				},
+			},
+		}, {
+			cfg: embedded.IIOSensorConfig{
+				SensorConfig: iio.SensorConfig{
+					ID:           "level",
+					PollInterval: 101,
+					Samples:      15,
+					Scaling:      iio.Volts,
+				},
+			},
+			readings: []iio.Readings{
+				{
+					ID:    "level",
+					Error: "file returned empty buffer",
+				},
+			},
+		},
+	}
+
+	var sensors []embedded.IIOSensor
+	var values []embedded.IIOValue
+	for _, elem := range args {
+		m := new(IIOMock)
+		m.On("ID").Return(elem.cfg.ID)
+		m.On("GetConfig").Return(elem.cfg.SensorConfig)
+		m.On("GetReadings").Return(elem.readings)
+		m.On("Configure", mock.Anything).Return(nil)
+		m.On("Poll").Return(nil)
+
+		values = append(values, embedded.IIOValue{Readings: elem.readings})
+		sensors = append(sensors, m)
+	}
+
+	h, _ := embedded.NewRest("", embedded.WithIIO(sensors))
+	// enable sensor, so we can get values
+	for _, elem := range args {
+		cfg, err := h.IIO.GetConfig(elem.cfg.ID)
+		t.Nil(err)
+		cfg.Enabled = true
+		_, err = h.IIO.SetConfig(cfg)
+		t.Nil(err)
+	}
+
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewIIOClient(srv.URL, 1*time.Second)
+	s, err := client.Values()
+	t.Nil(err)
+	t.ElementsMatch(values, s)
+}
+
+func (p *IIOClientSuite) Test_Configure() {
+	t := p.Require()
+	cfg := embedded.IIOSensorConfig{
+		SensorConfig: iio.SensorConfig{
+			ID:           "pressure",
+			PollInterval: 100,
+			Samples:      10,
+			Scaling:      iio.Volts,
+		},
+	}
+
+	m := new(IIOMock)
+	m.On("ID").Return(cfg.ID)
+	m.On("GetConfig").Return(cfg.SensorConfig).Once()
+
+	h, _ := embedded.NewRest("", embedded.WithIIO([]embedded.IIOSensor{m}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewIIOClient(srv.URL, 1*time.Second)
+	s, err := client.Get()
+	t.Nil(err)
+	t.Equal([]embedded.IIOSensorConfig{cfg}, s)
+
+	// Expected error - sensor doesn't exist
+	_, err = client.Configure(embedded.IIOSensorConfig{})
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesConfigIIOSensor)
+
+	errSet := errors.New("hello world")
+	m.On("Configure", mock.Anything).Return(errSet).Once()
+	_, err = client.Configure(cfg)
+	t.ErrorContains(err, errSet.Error())
+
+	// All good now
+	cfg.Scaling = iio.Scaling{Gain: 25, Unit: "%"}
+	cfg.Enabled = true
+	m.On("Configure", cfg.SensorConfig).Return(nil).Once()
+	m.On("Poll").Return(nil).Once()
+	m.On("GetConfig").Return(cfg.SensorConfig).Once()
+	got, err := client.Configure(cfg)
+	t.Nil(err)
+	t.Equal(cfg, got)
+
+	// Close error
+	cfg.Enabled = false
+	m.On("Configure", cfg.SensorConfig).Return(nil).Once()
+	m.On("Close").Return(errSet).Once()
+	_, err = client.Configure(cfg)
+	t.ErrorContains(err, errSet.Error())
+	t.ErrorContains(err, "SetConfig.Close")
+}
+
+func (p *IIOClientSuite) Test_NotImplemented() {
+	t := p.Require()
+	h, _ := embedded.NewRest("")
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	client := embedded.NewIIOClient(srv.URL, 1*time.Second)
+
+	s, err := client.Get()
+	t.Nil(s)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetIIOSensors)
+
+	_, err = client.Configure(embedded.IIOSensorConfig{})
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesConfigIIOSensor)
+
+	values, err := client.Values()
+	t.Nil(values)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetIIOValues)
+}
+
+func (m *IIOMock) ID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *IIOMock) Poll() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *IIOMock) Configure(config iio.SensorConfig) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
+func (m *IIOMock) GetConfig() iio.SensorConfig {
+	args := m.Called()
+	return args.Get(0).(iio.SensorConfig)
+}
+
+func (m *IIOMock) Average() float64 {
+	args := m.Called()
+	return args.Get(0).(float64)
+}
+
+func (m *IIOMock) Value() (actual float64, average float64, err error) {
+	args := m.Called()
+	return args.Get(0).(float64), args.Get(1).(float64), args.Error(2)
+}
+
+func (m *IIOMock) GetReadings() []iio.Readings {
+	args := m.Called()
+	return args.Get(0).([]iio.Readings)
+}
+
+func (m *IIOMock) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
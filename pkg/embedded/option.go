@@ -6,6 +6,8 @@
 package embedded
 
 import (
+	"time"
+
 	"github.com/a-clap/logging"
 )
 
@@ -19,6 +21,33 @@ func WithHeaters(heaters map[string]Heater) Option {
 	}
 }
 
+// WithHeaterGroups reports state of groups, heaters must be added to groups before
+func WithHeaterGroups(groups map[string]HeaterGroup) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithHeaterGroups", logging.Int("len", len(groups)))
+		e.Heaters.groups = groups
+		return nil
+	}
+}
+
+// WithProgramStore keeps programs in file, so they are resumed after restart
+func WithProgramStore(path string) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithProgramStore", logging.String("path", path))
+		e.Program.path = path
+		return nil
+	}
+}
+
+// WithCalibrationHistory keeps applied calibrations in file
+func WithCalibrationHistory(path string) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithCalibrationHistory", logging.String("path", path))
+		e.Calibration.path = path
+		return nil
+	}
+}
+
 func WithDS18B20(ds []DSSensor) Option {
 	return func(e *Embedded) error {
 		logger.Debug("WithDS18B20", logging.Int("len", len(ds)))
@@ -39,6 +68,23 @@ func WithDS18B20(ds []DSSensor) Option {
 	}
 }
 
+// WithDS18B20Bus adds bus, which is scanned on Open and then every rescan, 0 disables rescans
+func WithDS18B20Bus(name string, bus DSBus, rescan time.Duration) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithDS18B20Bus", logging.String("name", name), logging.String("rescan", rescan.String()))
+		e.DS.buses = append(e.DS.buses, &dsBus{DSBus: bus, name: name, rescan: rescan})
+		return nil
+	}
+}
+
+// WithDS18B20Inventory remembers sensors found on buses in file, so sensors missing at boot are reported
+func WithDS18B20Inventory(path string) Option {
+	return func(e *Embedded) error {
+		e.DS.inventory = path
+		return nil
+	}
+}
+
 func WithPT(pt []PTSensor) Option {
 	return func(e *Embedded) error {
 		logger.Debug("WithPT", logging.Int("len", len(pt)))
@@ -59,10 +105,68 @@ func WithPT(pt []PTSensor) Option {
 	}
 }
 
+// WithTC adds thermocouples
+func WithTC(tc []TCSensor) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithTC", logging.Int("len", len(tc)))
+		e.TC.sensors = make(map[string]*tcSensor)
+		for _, t := range tc {
+			id := t.ID()
+			cfg := t.GetConfig()
+			e.TC.sensors[id] = &tcSensor{
+				TCSensor: t,
+				TCSensorConfig: TCSensorConfig{
+					Enabled:      false,
+					SensorConfig: cfg,
+				},
+			}
+			logger.Debug("New TCSensor", logging.String("ID", id))
+		}
+		return nil
+	}
+}
+
+// WithIIO adds analog inputs of IIO devices
+func WithIIO(sensors []IIOSensor) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithIIO", logging.Int("len", len(sensors)))
+		e.IIO.sensors = make(map[string]*iioSensor)
+		for _, s := range sensors {
+			id := s.ID()
+			cfg := s.GetConfig()
+			e.IIO.sensors[id] = &iioSensor{
+				IIOSensor: s,
+				IIOSensorConfig: IIOSensorConfig{
+					Enabled:      false,
+					SensorConfig: cfg,
+				},
+			}
+			logger.Debug("New IIOSensor", logging.String("ID", id))
+		}
+		return nil
+	}
+}
+
+// WithCounters adds pulse counters
+func WithCounters(counters []PulseCounter) Option {
+	return func(e *Embedded) error {
+		logger.Debug("WithCounters", logging.Int("len", len(counters)))
+		e.Counter.counters = make(map[string]PulseCounter)
+		for _, c := range counters {
+			logger.Debug("New PulseCounter", logging.String("ID", c.ID()))
+			e.Counter.counters[c.ID()] = c
+		}
+		return nil
+	}
+}
+
+// WithGPIOs adds gpios to GPIOHandler, it can be used multiple times
 func WithGPIOs(gpios []GPIO) Option {
 	return func(e *Embedded) error {
 		logger.Debug("WithGPIOs", logging.Int("len", len(gpios)))
-		e.GPIO.io = make(map[string]*gpioHandler)
+		if e.GPIO.io == nil {
+			e.GPIO.io = make(map[string]*gpioHandler)
+		}
 		for _, gpio := range gpios {
 			logger.Debug("New GPIO", logging.String("ID", gpio.ID()))
 			e.GPIO.io[gpio.ID()] = &gpioHandler{
@@ -0,0 +1,462 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/pid"
+	"github.com/a-clap/logging"
+)
+
+// Sensor types, which can be used as PID input
+const (
+	SensorDS18B20 = "ds18b20"
+	SensorPT100   = "pt100"
+)
+
+var (
+	ErrIDAlreadyExists   = errors.New("specified ID already exists")
+	ErrUnknownSensorType = errors.New("unknown sensor type")
+	ErrSensorNotEnabled  = errors.New("sensor is not enabled")
+	ErrOutputOutOfRange  = errors.New("output limits must be within heater power range")
+)
+
+type PIDError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *PIDError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+// PIDConfig binds Heater with DS18B20 or PT100 sensor
+type PIDConfig struct {
+	ID         string        `json:"id"`
+	HeaterID   string        `json:"heater_id"`
+	SensorID   string        `json:"sensor_id"`
+	SensorType string        `json:"sensor_type"`
+	Enabled    bool          `json:"enabled"`
+	Interval   time.Duration `json:"interval"`
+	pid.Config
+}
+
+// PIDEnabled is used to enable or disable controller
+type PIDEnabled struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// PIDStatus is a snapshot of controller state after last update
+type PIDStatus struct {
+	ID          string    `json:"id"`
+	Enabled     bool      `json:"enabled"`
+	Temperature float64   `json:"temperature"`
+	Stamp       time.Time `json:"stamp"`
+	Fault       string    `json:"fault"`
+	pid.Terms
+}
+
+type pidController struct {
+	PIDConfig
+	pid       *pid.PID
+	status    PIDStatus
+	last      time.Time
+	stop, fin chan struct{}
+	mtx       sync.Mutex
+	// cmd serializes Enable and SetConfig, so loop is started and stopped only once
+	cmd sync.Mutex
+}
+
+// PIDHandler runs PID loops, which read sensor Average and drive Heater power
+type PIDHandler struct {
+	heaters     *HeaterHandler
+	ds          *DSHandler
+	pt          *PTHandler
+	controllers map[string]*pidController
+	mtx         sync.Mutex
+}
+
+const (
+	pidDefaultInterval = time.Second
+	pidPowerMin        = 0
+	pidPowerMax        = 100
+)
+
+// Create adds new controller, controller is always created as disabled
+func (p *PIDHandler) Create(cfg PIDConfig) (PIDConfig, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.controllers[cfg.ID]; ok {
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "Create", Err: ErrIDAlreadyExists.Error()}
+	}
+	if err := p.verify(&cfg); err != nil {
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "Create.verify", Err: err.Error()}
+	}
+	ctrl, err := pid.New(cfg.Config)
+	if err != nil {
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "Create.New", Err: err.Error()}
+	}
+
+	cfg.Enabled = false
+	if p.controllers == nil {
+		p.controllers = make(map[string]*pidController)
+	}
+	p.controllers[cfg.ID] = &pidController{
+		PIDConfig: cfg,
+		pid:       ctrl,
+		status:    PIDStatus{ID: cfg.ID},
+	}
+	logger.Debug("New PID", logging.String("ID", cfg.ID))
+
+	return cfg, nil
+}
+
+// SetConfig updates existing controller, running loop is restarted, if binding changed
+func (p *PIDHandler) SetConfig(cfg PIDConfig) (PIDConfig, error) {
+	c, err := p.controllerBy(cfg.ID)
+	if err != nil {
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "SetConfig.controllerBy", Err: err.Error()}
+	}
+	if err := p.verify(&cfg); err != nil {
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "SetConfig.verify", Err: err.Error()}
+	}
+
+	c.cmd.Lock()
+	defer c.cmd.Unlock()
+
+	enabled := cfg.Enabled
+	c.mtx.Lock()
+	wasEnabled := c.Enabled
+	c.mtx.Unlock()
+
+	if wasEnabled {
+		if err := p.disable(c); err != nil {
+			return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "SetConfig.disable", Err: err.Error()}
+		}
+	}
+
+	c.mtx.Lock()
+	if err := c.pid.Configure(cfg.Config); err != nil {
+		c.mtx.Unlock()
+		return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
+	}
+	cfg.Enabled = false
+	c.PIDConfig = cfg
+	c.mtx.Unlock()
+
+	if enabled {
+		if err := p.enable(c); err != nil {
+			return PIDConfig{}, &PIDError{ID: cfg.ID, Op: "SetConfig.enable", Err: err.Error()}
+		}
+	}
+
+	return p.GetConfig(cfg.ID)
+}
+
+// Enable starts or stops controller loop
+func (p *PIDHandler) Enable(id string, ena bool) error {
+	c, err := p.controllerBy(id)
+	if err != nil {
+		return &PIDError{ID: id, Op: "Enable.controllerBy", Err: err.Error()}
+	}
+
+	c.cmd.Lock()
+	defer c.cmd.Unlock()
+	c.mtx.Lock()
+	enabled := c.Enabled
+	c.mtx.Unlock()
+	if enabled == ena {
+		return nil
+	}
+
+	if ena {
+		err = p.enable(c)
+	} else {
+		err = p.disable(c)
+	}
+	if err != nil {
+		return &PIDError{ID: id, Op: "Enable", Err: err.Error()}
+	}
+	return nil
+}
+
+// GetConfig returns config of controller with specified id
+func (p *PIDHandler) GetConfig(id string) (PIDConfig, error) {
+	c, err := p.controllerBy(id)
+	if err != nil {
+		return PIDConfig{}, &PIDError{ID: id, Op: "GetConfig.controllerBy", Err: err.Error()}
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.PIDConfig, nil
+}
+
+// GetConfigs returns configs of all controllers
+func (p *PIDHandler) GetConfigs() []PIDConfig {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	configs := make([]PIDConfig, 0, len(p.controllers))
+	for _, c := range p.controllers {
+		c.mtx.Lock()
+		configs = append(configs, c.PIDConfig)
+		c.mtx.Unlock()
+	}
+	return configs
+}
+
+// StatusBy returns status of controller with specified id
+func (p *PIDHandler) StatusBy(id string) (PIDStatus, error) {
+	c, err := p.controllerBy(id)
+	if err != nil {
+		return PIDStatus{}, &PIDError{ID: id, Op: "StatusBy.controllerBy", Err: err.Error()}
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.status, nil
+}
+
+// Status returns status of all controllers
+func (p *PIDHandler) Status() []PIDStatus {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	status := make([]PIDStatus, 0, len(p.controllers))
+	for _, c := range p.controllers {
+		c.mtx.Lock()
+		status = append(status, c.status)
+		c.mtx.Unlock()
+	}
+	return status
+}
+
+func (p *PIDHandler) controllerBy(id string) (*pidController, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	c, ok := p.controllers[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return c, nil
+}
+
+// verify checks whether heater and sensor exist and fills defaults
+func (p *PIDHandler) verify(cfg *PIDConfig) error {
+	if _, err := p.heaters.by(cfg.HeaterID); err != nil {
+		return &HeaterError{ID: cfg.HeaterID, Op: "heater", Err: err.Error()}
+	}
+	switch cfg.SensorType {
+	case SensorDS18B20:
+		if _, err := p.ds.sensorBy(cfg.SensorID); err != nil {
+			return &DSError{ID: cfg.SensorID, Op: "sensor", Err: err.Error()}
+		}
+	case SensorPT100:
+		if _, err := p.pt.sensorBy(cfg.SensorID); err != nil {
+			return &PTError{ID: cfg.SensorID, Op: "sensor", Err: err.Error()}
+		}
+	default:
+		return ErrUnknownSensorType
+	}
+
+	if cfg.OutputMin == 0 && cfg.OutputMax == 0 {
+		cfg.OutputMax = pidPowerMax
+	}
+	if cfg.OutputMin < pidPowerMin || cfg.OutputMax > pidPowerMax {
+		return ErrOutputOutOfRange
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = pidDefaultInterval
+	}
+	return nil
+}
+
+func (p *PIDHandler) temperature(sensorType, id string) (float64, error) {
+	switch sensorType {
+	case SensorDS18B20:
+		s, err := p.ds.sensorBy(id)
+		if err != nil {
+			return 0, err
+		}
+		if !s.enabled() {
+			return 0, ErrSensorNotEnabled
+		}
+		return s.Average(), nil
+	case SensorPT100:
+		s, err := p.pt.sensorBy(id)
+		if err != nil {
+			return 0, err
+		}
+		if !s.enabled() {
+			return 0, ErrSensorNotEnabled
+		}
+		return s.Average(), nil
+	}
+	return 0, ErrUnknownSensorType
+}
+
+// enableSensor starts polling of sensor, if it isn't polling yet, so its readings can be used
+func enableSensor(ds *DSHandler, pt *PTHandler, sensorType, id string) error {
+	switch sensorType {
+	case SensorDS18B20:
+		cfg, err := ds.GetConfig(id)
+		if err != nil || cfg.Enabled {
+			return err
+		}
+		cfg.Enabled, cfg.Persist = true, false
+		_, err = ds.SetConfig(cfg)
+		return err
+	case SensorPT100:
+		cfg, err := pt.GetConfig(id)
+		if err != nil || cfg.Enabled {
+			return err
+		}
+		cfg.Enabled = true
+		_, err = pt.SetConfig(cfg)
+		return err
+	}
+	return ErrUnknownSensorType
+}
+
+func (p *PIDHandler) enable(c *pidController) error {
+	// c.cmd is locked, so HeaterID can't change
+	if err := p.heaters.claim(c.HeaterID, c.owner()); err != nil {
+		return err
+	}
+	// Without polling, sensor has no Average to feed controller
+	if err := enableSensor(p.ds, p.pt, c.SensorType, c.SensorID); err != nil {
+		p.heaters.release(c.HeaterID, c.owner())
+		return err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if err := p.heaters.Enable(c.HeaterID, true); err != nil {
+		p.heaters.release(c.HeaterID, c.owner())
+		return err
+	}
+	c.pid.Reset()
+	c.last = time.Time{}
+	c.Enabled = true
+	c.status = PIDStatus{ID: c.ID, Enabled: true}
+	c.stop = make(chan struct{})
+	c.fin = make(chan struct{})
+	go p.run(c, c.Interval, c.stop, c.fin)
+
+	return nil
+}
+
+func (p *PIDHandler) disable(c *pidController) error {
+	c.mtx.Lock()
+	stop, fin := c.stop, c.fin
+	c.mtx.Unlock()
+
+	close(stop)
+	<-fin
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.Enabled = false
+	c.status.Enabled = false
+	defer p.heaters.release(c.HeaterID, c.owner())
+
+	// Leave heater in safe state
+	if err := p.heaters.Power(c.HeaterID, 0); err != nil {
+		return err
+	}
+	return p.heaters.Enable(c.HeaterID, false)
+}
+
+// owner identifies controller as user of heater
+func (c *pidController) owner() string {
+	return "pid:" + c.ID
+}
+
+func (p *PIDHandler) run(c *pidController, interval time.Duration, stop, fin chan struct{}) {
+	defer close(fin)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			p.update(c, now)
+		}
+	}
+}
+
+func (p *PIDHandler) update(c *pidController, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.status.Stamp = now
+	c.status.Fault = ""
+
+	tmp, err := p.temperature(c.SensorType, c.SensorID)
+	if err != nil {
+		c.status.Fault = err.Error()
+		logger.Error("PID temperature", logging.String("ID", c.ID), logging.String("error", err.Error()))
+		// Without feedback, safest option is to turn off heater
+		_ = p.heaters.Power(c.HeaterID, 0)
+		return
+	}
+
+	dt := time.Duration(0)
+	if !c.last.IsZero() {
+		dt = now.Sub(c.last)
+	}
+	c.last = now
+
+	out := c.pid.Update(tmp, dt)
+	c.status.Temperature = tmp
+	c.status.Terms = c.pid.Terms()
+
+	if err := p.heaters.PowerPrecise(c.HeaterID, out); err != nil {
+		c.status.Fault = err.Error()
+		logger.Error("PID power", logging.String("ID", c.ID), logging.String("error", err.Error()))
+	}
+}
+
+func (p *PIDHandler) Open() {
+}
+
+func (p *PIDHandler) Close() {
+	p.mtx.Lock()
+	controllers := make([]*pidController, 0, len(p.controllers))
+	for _, c := range p.controllers {
+		controllers = append(controllers, c)
+	}
+	p.mtx.Unlock()
+
+	for _, c := range controllers {
+		c.cmd.Lock()
+		c.mtx.Lock()
+		enabled := c.Enabled
+		c.mtx.Unlock()
+		if enabled {
+			_ = p.disable(c)
+		}
+		c.cmd.Unlock()
+	}
+}
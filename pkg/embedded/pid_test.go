@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/pid"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PIDTestSuite struct {
+	suite.Suite
+	req  *http.Request
+	resp *httptest.ResponseRecorder
+}
+
+func TestPIDTestSuite(t *testing.T) {
+	suite.Run(t, new(PIDTestSuite))
+}
+
+func (t *PIDTestSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+	t.resp = httptest.NewRecorder()
+}
+
+func (t *PIDTestSuite) handler(heater *HeaterMock, ds *DS18B20SensorMock) *embedded.Rest {
+	ds.On("ID").Return("ds")
+	ds.On("GetConfig").Return(ds18b20.SensorConfig{ID: "ds"})
+	h, err := embedded.NewRest("",
+		embedded.WithHeaters(map[string]embedded.Heater{"heater": heater}),
+		embedded.WithDS18B20([]embedded.DSSensor{ds}),
+	)
+	t.Require().Nil(err)
+	return h
+}
+
+// polled lets sensor to be started by PID
+func (t *PIDTestSuite) polled(ds *DS18B20SensorMock) {
+	ds.On("Configure", mock.Anything).Return(nil)
+	ds.On("Poll").Return()
+	ds.On("Close").Return()
+}
+
+func (t *PIDTestSuite) TestCreate_Verify() {
+	h := t.handler(new(HeaterMock), new(DS18B20SensorMock))
+
+	args := []struct {
+		name string
+		cfg  embedded.PIDConfig
+		err  error
+	}{
+		{
+			name: "no such heater",
+			cfg:  embedded.PIDConfig{ID: "1", HeaterID: "unknown", SensorID: "ds", SensorType: embedded.SensorDS18B20},
+			err:  embedded.ErrNoSuchID,
+		},
+		{
+			name: "no such sensor",
+			cfg:  embedded.PIDConfig{ID: "1", HeaterID: "heater", SensorID: "unknown", SensorType: embedded.SensorDS18B20},
+			err:  embedded.ErrNoSuchID,
+		},
+		{
+			name: "unknown sensor type",
+			cfg:  embedded.PIDConfig{ID: "1", HeaterID: "heater", SensorID: "ds", SensorType: "thermocouple"},
+			err:  embedded.ErrUnknownSensorType,
+		},
+		{
+			name: "output over 100",
+			cfg: embedded.PIDConfig{ID: "1", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20,
+				Config: pid.Config{OutputMin: 0, OutputMax: 150}},
+			err: embedded.ErrOutputOutOfRange,
+		},
+	}
+	for _, arg := range args {
+		_, err := h.PID.Create(arg.cfg)
+		t.ErrorContains(err, arg.err.Error(), arg.name)
+	}
+
+	cfg, err := h.PID.Create(embedded.PIDConfig{ID: "1", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Enabled: true})
+	t.Nil(err)
+	t.False(cfg.Enabled)
+	t.EqualValues(100, cfg.OutputMax)
+	t.EqualValues(time.Second, cfg.Interval)
+
+	_, err = h.PID.Create(cfg)
+	t.ErrorContains(err, embedded.ErrIDAlreadyExists.Error())
+}
+
+func (t *PIDTestSuite) TestRun_DrivesHeater() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+
+	// Sensor must be enabled to be used by PID
+	ds.On("Configure", mock.Anything).Return(nil)
+	ds.On("Poll").Return()
+	ds.On("Close").Return()
+	_, err := h.DS.SetConfig(embedded.DSSensorConfig{Enabled: true, SensorConfig: ds18b20.SensorConfig{ID: "ds"}})
+	t.Require().Nil(err)
+
+	ds.On("Average").Return(40.0)
+	heater.On("Enable", mock.Anything).Once()
+	// Output isn't rounded to whole percent
+	heater.On("SetPowerPrecise", 22.5).Return(nil)
+	heater.On("SetPower", uint(0)).Return(nil).Once()
+	heater.On("Disable").Once()
+
+	cfg := embedded.PIDConfig{
+		ID:         "pid",
+		HeaterID:   "heater",
+		SensorID:   "ds",
+		SensorType: embedded.SensorDS18B20,
+		Interval:   5 * time.Millisecond,
+		Config: pid.Config{
+			Kp:        2.25,
+			SetPoint:  50,
+			OutputMin: 0,
+			OutputMax: 100,
+		},
+	}
+	_, err = h.PID.Create(cfg)
+	t.Require().Nil(err)
+	t.Require().Nil(h.PID.Enable(cfg.ID, true))
+
+	<-time.After(30 * time.Millisecond)
+
+	status, err := h.PID.StatusBy(cfg.ID)
+	t.Nil(err)
+	t.True(status.Enabled)
+	t.Empty(status.Fault)
+	t.InDelta(40, status.Temperature, 1e-9)
+	t.InDelta(10, status.Error, 1e-9)
+	t.InDelta(22.5, status.P, 1e-9)
+	t.InDelta(22.5, status.Output, 1e-9)
+
+	t.Nil(h.PID.Enable(cfg.ID, false))
+	status, _ = h.PID.StatusBy(cfg.ID)
+	t.False(status.Enabled)
+	heater.AssertExpectations(t.T())
+}
+
+func (t *PIDTestSuite) TestEnable_StartsSensor() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+
+	heater.On("Enable", mock.Anything)
+	heater.On("Disable")
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("SetPowerPrecise", mock.Anything).Return(nil)
+	ds.On("Average").Return(40.0)
+
+	cfg := embedded.PIDConfig{ID: "pid", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Interval: 5 * time.Millisecond}
+	_, err := h.PID.Create(cfg)
+	t.Require().Nil(err)
+
+	// Sensor which can't be started is not used
+	errConfigure := errors.New("no sensor")
+	ds.On("Configure", mock.Anything).Return(errConfigure).Once()
+	t.ErrorContains(h.PID.Enable(cfg.ID, true), errConfigure.Error())
+	got, err := h.PID.GetConfig(cfg.ID)
+	t.Nil(err)
+	t.False(got.Enabled)
+	heater.AssertNotCalled(t.T(), "Enable", mock.Anything)
+
+	t.polled(ds)
+	t.Require().Nil(h.PID.Enable(cfg.ID, true))
+	ds.AssertCalled(t.T(), "Poll")
+	dsCfg, err := h.DS.GetConfig("ds")
+	t.Nil(err)
+	t.True(dsCfg.Enabled)
+	<-time.After(20 * time.Millisecond)
+
+	status, _ := h.PID.StatusBy(cfg.ID)
+	t.Empty(status.Fault)
+	t.InDelta(40, status.Temperature, 1e-9)
+	t.Nil(h.PID.Enable(cfg.ID, false))
+}
+
+func (t *PIDTestSuite) TestEnable_Concurrent() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.polled(ds)
+
+	heater.On("Enable", mock.Anything)
+	heater.On("Disable")
+	heater.On("SetPower", uint(0)).Return(nil)
+
+	cfg := embedded.PIDConfig{ID: "pid", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Interval: time.Millisecond}
+	_, err := h.PID.Create(cfg)
+	t.Require().Nil(err)
+
+	const goroutines = 8
+	for _, ena := range []bool{true, false, true, false} {
+		var wg sync.WaitGroup
+		wg.Add(goroutines)
+		for i := 0; i < goroutines; i++ {
+			go func(ena bool) {
+				defer wg.Done()
+				t.Nil(h.PID.Enable(cfg.ID, ena))
+			}(ena)
+		}
+		wg.Wait()
+
+		got, err := h.PID.GetConfig(cfg.ID)
+		t.Nil(err)
+		t.Equal(ena, got.Enabled)
+	}
+	// Each loop was started and stopped only once
+	heater.AssertNumberOfCalls(t.T(), "Enable", 2)
+	heater.AssertNumberOfCalls(t.T(), "Disable", 2)
+}
+
+func (t *PIDTestSuite) TestEnable_HeaterBusy() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.polled(ds)
+
+	heater.On("Enable", mock.Anything)
+	heater.On("Disable")
+	heater.On("SetPower", uint(0)).Return(nil)
+
+	first := embedded.PIDConfig{ID: "first", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Interval: time.Millisecond}
+	second := first
+	second.ID = "second"
+	for _, cfg := range []embedded.PIDConfig{first, second} {
+		_, err := h.PID.Create(cfg)
+		t.Require().Nil(err)
+	}
+
+	// Only one controller drives heater
+	t.Require().Nil(h.PID.Enable(first.ID, true))
+	t.ErrorContains(h.PID.Enable(second.ID, true), embedded.ErrHeaterBusy.Error())
+	second.Enabled = true
+	_, err := h.PID.SetConfig(second)
+	t.ErrorContains(err, embedded.ErrHeaterBusy.Error())
+	got, err := h.PID.GetConfig(second.ID)
+	t.Nil(err)
+	t.False(got.Enabled)
+
+	// Heater is released with disable
+	t.Nil(h.PID.Enable(first.ID, false))
+	t.Nil(h.PID.Enable(second.ID, true))
+	t.ErrorContains(h.PID.Enable(first.ID, true), embedded.ErrHeaterBusy.Error())
+	t.Nil(h.PID.Enable(second.ID, false))
+}
+
+func (t *PIDTestSuite) TestRestAPI() {
+	h := t.handler(new(HeaterMock), new(DS18B20SensorMock))
+	cfg := embedded.PIDConfig{
+		ID:         "pid",
+		HeaterID:   "heater",
+		SensorID:   "ds",
+		SensorType: embedded.SensorDS18B20,
+		Interval:   time.Second,
+		Config: pid.Config{
+			Kp:         1,
+			Ki:         0.1,
+			Kd:         3,
+			SetPoint:   78,
+			OutputMin:  0,
+			OutputMax:  80,
+			AntiWindup: true,
+		},
+	}
+
+	// Create
+	var body bytes.Buffer
+	_ = json.NewEncoder(&body).Encode(cfg)
+	t.req, _ = http.NewRequest(http.MethodPost, embedded.RoutesCreatePID, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(cfg), string(b))
+
+	// Update with wrong ID
+	t.resp = httptest.NewRecorder()
+	wrong := cfg
+	wrong.ID = "another"
+	_ = json.NewEncoder(&body).Encode(wrong)
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesConfigPID, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusInternalServerError, t.resp.Code)
+	t.Contains(string(b), embedded.ErrNoSuchID.Error())
+
+	// Update
+	t.resp = httptest.NewRecorder()
+	cfg.SetPoint = 92
+	_ = json.NewEncoder(&body).Encode(cfg)
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesConfigPID, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(cfg), string(b))
+
+	// Get
+	t.resp = httptest.NewRecorder()
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetPID, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON([]embedded.PIDConfig{cfg}), string(b))
+
+	// Status
+	t.resp = httptest.NewRecorder()
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetPIDStatus, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	var status []embedded.PIDStatus
+	fromJSON(b, &status)
+	t.Len(status, 1)
+	t.Equal(cfg.ID, status[0].ID)
+	t.False(status[0].Enabled)
+}
+
+func (t *PIDTestSuite) TestClient() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.polled(ds)
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	pc := embedded.NewPIDClient(srv.URL, 1*time.Second)
+	cfg := embedded.PIDConfig{ID: "pid", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Interval: time.Hour}
+
+	_, err := pc.Enable(cfg.ID, true)
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesEnablePID)
+
+	created, err := pc.Create(cfg)
+	t.Nil(err)
+	t.Equal(cfg.ID, created.ID)
+
+	heater.On("Enable", mock.Anything).Once()
+	ena, err := pc.Enable(cfg.ID, true)
+	t.Nil(err)
+	t.True(ena.Enabled)
+
+	heater.On("SetPower", uint(0)).Return(nil).Once()
+	heater.On("Disable").Once()
+	ena, err = pc.Enable(cfg.ID, false)
+	t.Nil(err)
+	t.False(ena.Enabled)
+
+	got, err := pc.Get()
+	t.Nil(err)
+	t.Len(got, 1)
+	heater.AssertExpectations(t.T())
+}
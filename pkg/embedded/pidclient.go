@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type PIDClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewPIDClient(addr string, timeout time.Duration) *PIDClient {
+	return &PIDClient{addr: addr, timeout: timeout}
+}
+
+func (p *PIDClient) Get() ([]PIDConfig, error) {
+	return restclient.Get[[]PIDConfig, *Error](p.addr+RoutesGetPID, p.timeout)
+}
+
+func (p *PIDClient) Create(cfg PIDConfig) (PIDConfig, error) {
+	return restclient.Post[PIDConfig, *Error](p.addr+RoutesCreatePID, p.timeout, cfg)
+}
+
+func (p *PIDClient) Configure(setConfig PIDConfig) (PIDConfig, error) {
+	return restclient.Put[PIDConfig, *Error](p.addr+RoutesConfigPID, p.timeout, setConfig)
+}
+
+func (p *PIDClient) Enable(id string, ena bool) (PIDEnabled, error) {
+	return restclient.Put[PIDEnabled, *Error](p.addr+RoutesEnablePID, p.timeout, PIDEnabled{ID: id, Enabled: ena})
+}
+
+func (p *PIDClient) Status() ([]PIDStatus, error) {
+	return restclient.Get[[]PIDStatus, *Error](p.addr+RoutesGetPIDStatus, p.timeout)
+}
+
+type PIDRPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.PIDClient
+}
+
+func NewPIDRPCClient(addr string, timeout time.Duration) (*PIDRPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &PIDRPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewPIDClient(conn)}, nil
+}
+
+func (g *PIDRPCClient) Get() ([]PIDConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PIDGet(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	confs := make([]PIDConfig, len(got.Configs))
+	for i, elem := range got.Configs {
+		confs[i] = rpcToPIDConfig(elem)
+	}
+	return confs, nil
+}
+
+func (g *PIDRPCClient) Create(cfg PIDConfig) (PIDConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PIDCreate(ctx, pidConfigToRPC(&cfg))
+	if err != nil {
+		return PIDConfig{}, err
+	}
+	return rpcToPIDConfig(got), nil
+}
+
+func (g *PIDRPCClient) Configure(setConfig PIDConfig) (PIDConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PIDConfigure(ctx, pidConfigToRPC(&setConfig))
+	if err != nil {
+		return PIDConfig{}, err
+	}
+	return rpcToPIDConfig(got), nil
+}
+
+func (g *PIDRPCClient) Enable(id string, ena bool) (PIDEnabled, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PIDEnable(ctx, &embeddedproto.PIDEnabled{ID: id, Enabled: ena})
+	if err != nil {
+		return PIDEnabled{}, err
+	}
+	return PIDEnabled{ID: got.ID, Enabled: got.Enabled}, nil
+}
+
+func (g *PIDRPCClient) Status() ([]PIDStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PIDGetStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	status := make([]PIDStatus, len(got.Status))
+	for i, elem := range got.Status {
+		status[i] = rpcToPIDStatus(elem)
+	}
+	return status, nil
+}
+
+func (g *PIDRPCClient) Close() {
+	_ = g.conn.Close()
+}
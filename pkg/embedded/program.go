@@ -0,0 +1,730 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/pid"
+	"github.com/a-clap/logging"
+)
+
+// Types of ProgramStep
+const (
+	ProgramStepTemperature = "temperature"
+	ProgramStepPower       = "power"
+)
+
+// States of Program
+const (
+	ProgramIdle     = "idle"
+	ProgramRunning  = "running"
+	ProgramPaused   = "paused"
+	ProgramFinished = "finished"
+	ProgramAborted  = "aborted"
+)
+
+// Commands accepted by ProgramHandler.Command
+const (
+	ProgramStart  = "start"
+	ProgramPause  = "pause"
+	ProgramResume = "resume"
+	ProgramAbort  = "abort"
+)
+
+var (
+	ErrNoSteps           = errors.New("program must have at least one step")
+	ErrUnknownStepType   = errors.New("unknown step type")
+	ErrUnknownCommand    = errors.New("unknown command")
+	ErrProgramRunning    = errors.New("program is running or paused")
+	ErrProgramNotRunning = errors.New("program is not running")
+	ErrProgramNotPaused  = errors.New("program is not paused")
+	ErrHeaterBusy        = errors.New("heater is used by another program or PID")
+)
+
+type ProgramError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *ProgramError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+// ProgramStep is single segment of Program:
+// - temperature: set point moves toward Target with Rate °C/min (0 means at once), then Target is held for Hold.
+// Hold starts, when temperature is within Tolerance of Target,
+// - power: heater is driven with Power % for Hold.
+type ProgramStep struct {
+	Type   string        `json:"type"`
+	Target float64       `json:"target"`
+	Rate   float64       `json:"rate"`
+	Power  uint          `json:"power"`
+	Hold   time.Duration `json:"hold"`
+}
+
+// ProgramConfig binds steps with Heater and sensor, PID gains are used in temperature steps
+type ProgramConfig struct {
+	ID         string        `json:"id"`
+	HeaterID   string        `json:"heater_id"`
+	SensorID   string        `json:"sensor_id"`
+	SensorType string        `json:"sensor_type"`
+	Interval   time.Duration `json:"interval"`
+	Tolerance  float64       `json:"tolerance"`
+	Steps      []ProgramStep `json:"steps"`
+	pid.Config
+}
+
+// ProgramCommand is used to start, pause, resume or abort Program
+type ProgramCommand struct {
+	ID      string `json:"id"`
+	Command string `json:"command"`
+}
+
+// ProgramStatus reports progress of Program, Remaining doesn't include time of waiting for temperature
+type ProgramStatus struct {
+	ID          string        `json:"id"`
+	State       string        `json:"state"`
+	Step        int           `json:"step"`
+	Holding     bool          `json:"holding"`
+	SetPoint    float64       `json:"set_point"`
+	Temperature float64       `json:"temperature"`
+	Power       uint          `json:"power"`
+	Started     time.Time     `json:"started"`
+	Elapsed     time.Duration `json:"elapsed"`
+	StepElapsed time.Duration `json:"step_elapsed"`
+	Remaining   time.Duration `json:"remaining"`
+	Fault       string        `json:"fault"`
+}
+
+// programRecord is stored in file, so Program survives restart
+type programRecord struct {
+	Config        ProgramConfig `json:"config"`
+	Status        ProgramStatus `json:"status"`
+	Held          time.Duration `json:"held"`
+	SetPointValid bool          `json:"set_point_valid"`
+}
+
+type program struct {
+	programRecord
+	pid       *pid.PID
+	last      time.Time
+	stop, fin chan struct{}
+	mtx       sync.Mutex
+	// cmd serializes commands, so loop is stopped only once
+	cmd sync.Mutex
+}
+
+// ProgramHandler runs ramp-and-soak programs on the device, so they don't depend on client connection
+type ProgramHandler struct {
+	heaters  *HeaterHandler
+	pid      *PIDHandler
+	path     string
+	programs map[string]*program
+	mtx      sync.Mutex
+	storeMtx sync.Mutex
+}
+
+const (
+	programDefaultTolerance = 0.5
+	// programSaveInterval limits writes of progress, state changes are written at once
+	programSaveInterval = 30 * time.Second
+)
+
+// Create adds new Program in idle state
+func (p *ProgramHandler) Create(cfg ProgramConfig) (ProgramConfig, error) {
+	if err := p.verify(&cfg); err != nil {
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "Create.verify", Err: err.Error()}
+	}
+	ctrl, err := pid.New(cfg.Config)
+	if err != nil {
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "Create.New", Err: err.Error()}
+	}
+
+	p.mtx.Lock()
+	if _, ok := p.programs[cfg.ID]; ok {
+		p.mtx.Unlock()
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "Create", Err: ErrIDAlreadyExists.Error()}
+	}
+	if p.programs == nil {
+		p.programs = make(map[string]*program)
+	}
+	prog := &program{pid: ctrl}
+	prog.Config = cfg
+	prog.Status = ProgramStatus{ID: cfg.ID, State: ProgramIdle}
+	prog.Status.Remaining = prog.remaining()
+	p.programs[cfg.ID] = prog
+	p.mtx.Unlock()
+
+	logger.Debug("New Program", logging.String("ID", cfg.ID))
+	p.save()
+	return cfg, nil
+}
+
+// SetConfig replaces steps and binding of Program, which is not running nor paused
+func (p *ProgramHandler) SetConfig(cfg ProgramConfig) (ProgramConfig, error) {
+	prog, err := p.programBy(cfg.ID)
+	if err != nil {
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "SetConfig.programBy", Err: err.Error()}
+	}
+	if err := p.verify(&cfg); err != nil {
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "SetConfig.verify", Err: err.Error()}
+	}
+
+	prog.cmd.Lock()
+	defer prog.cmd.Unlock()
+	prog.mtx.Lock()
+	if state := prog.Status.State; state == ProgramRunning || state == ProgramPaused {
+		prog.mtx.Unlock()
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "SetConfig", Err: ErrProgramRunning.Error()}
+	}
+	if err := prog.pid.Configure(cfg.Config); err != nil {
+		prog.mtx.Unlock()
+		return ProgramConfig{}, &ProgramError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
+	}
+	prog.Config = cfg
+	prog.Status = ProgramStatus{ID: cfg.ID, State: ProgramIdle}
+	prog.Held, prog.SetPointValid = 0, false
+	prog.Status.Remaining = prog.remaining()
+	prog.mtx.Unlock()
+
+	p.save()
+	return cfg, nil
+}
+
+// Command starts, pauses, resumes or aborts Program
+func (p *ProgramHandler) Command(id, command string) error {
+	prog, err := p.programBy(id)
+	if err != nil {
+		return &ProgramError{ID: id, Op: "Command.programBy", Err: err.Error()}
+	}
+
+	prog.cmd.Lock()
+	defer prog.cmd.Unlock()
+	switch command {
+	case ProgramStart:
+		err = p.start(prog)
+	case ProgramPause:
+		err = p.pause(prog)
+	case ProgramResume:
+		err = p.resume(prog)
+	case ProgramAbort:
+		err = p.abort(prog)
+	default:
+		err = ErrUnknownCommand
+	}
+	if err != nil {
+		return &ProgramError{ID: id, Op: "Command." + command, Err: err.Error()}
+	}
+	p.save()
+	return nil
+}
+
+// GetConfig returns config of Program with specified id
+func (p *ProgramHandler) GetConfig(id string) (ProgramConfig, error) {
+	prog, err := p.programBy(id)
+	if err != nil {
+		return ProgramConfig{}, &ProgramError{ID: id, Op: "GetConfig.programBy", Err: err.Error()}
+	}
+	prog.mtx.Lock()
+	defer prog.mtx.Unlock()
+	return prog.Config, nil
+}
+
+// GetConfigs returns configs of all programs
+func (p *ProgramHandler) GetConfigs() []ProgramConfig {
+	programs := p.all()
+	configs := make([]ProgramConfig, 0, len(programs))
+	for _, prog := range programs {
+		prog.mtx.Lock()
+		configs = append(configs, prog.Config)
+		prog.mtx.Unlock()
+	}
+	return configs
+}
+
+// StatusBy returns status of Program with specified id
+func (p *ProgramHandler) StatusBy(id string) (ProgramStatus, error) {
+	prog, err := p.programBy(id)
+	if err != nil {
+		return ProgramStatus{}, &ProgramError{ID: id, Op: "StatusBy.programBy", Err: err.Error()}
+	}
+	prog.mtx.Lock()
+	defer prog.mtx.Unlock()
+	return prog.Status, nil
+}
+
+// Status returns status of all programs
+func (p *ProgramHandler) Status() []ProgramStatus {
+	programs := p.all()
+	status := make([]ProgramStatus, 0, len(programs))
+	for _, prog := range programs {
+		prog.mtx.Lock()
+		status = append(status, prog.Status)
+		prog.mtx.Unlock()
+	}
+	return status
+}
+
+func (p *ProgramHandler) programBy(id string) (*program, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	prog, ok := p.programs[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return prog, nil
+}
+
+func (p *ProgramHandler) all() []*program {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	programs := make([]*program, 0, len(p.programs))
+	for _, prog := range p.programs {
+		programs = append(programs, prog)
+	}
+	return programs
+}
+
+// verify checks binding in the same way as PID does, then steps and fills defaults
+func (p *ProgramHandler) verify(cfg *ProgramConfig) error {
+	binding := PIDConfig{HeaterID: cfg.HeaterID, SensorID: cfg.SensorID, SensorType: cfg.SensorType, Interval: cfg.Interval, Config: cfg.Config}
+	if err := p.pid.verify(&binding); err != nil {
+		return err
+	}
+	cfg.Interval, cfg.Config = binding.Interval, binding.Config
+
+	if len(cfg.Steps) == 0 {
+		return ErrNoSteps
+	}
+	for _, step := range cfg.Steps {
+		switch step.Type {
+		case ProgramStepTemperature:
+		case ProgramStepPower:
+			if step.Power > pidPowerMax {
+				return ErrOutputOutOfRange
+			}
+		default:
+			return ErrUnknownStepType
+		}
+	}
+	if cfg.Tolerance <= 0 {
+		cfg.Tolerance = programDefaultTolerance
+	}
+	return nil
+}
+
+func (p *ProgramHandler) start(prog *program) error {
+	prog.mtx.Lock()
+	state := prog.Status.State
+	prog.mtx.Unlock()
+	if state == ProgramRunning || state == ProgramPaused {
+		return ErrProgramRunning
+	}
+	// Heater is kept by Program until it is finished or aborted
+	if err := p.heaters.claim(prog.Config.HeaterID, prog.owner()); err != nil {
+		return err
+	}
+
+	prog.mtx.Lock()
+	prog.Status = ProgramStatus{ID: prog.Config.ID, State: ProgramRunning, Started: time.Now()}
+	prog.Held, prog.SetPointValid = 0, false
+	prog.Status.Remaining = prog.remaining()
+	prog.pid.Reset()
+	prog.mtx.Unlock()
+
+	return p.run(prog)
+}
+
+func (p *ProgramHandler) pause(prog *program) error {
+	prog.mtx.Lock()
+	state := prog.Status.State
+	prog.mtx.Unlock()
+	if state != ProgramRunning {
+		return ErrProgramNotRunning
+	}
+	if !p.halt(prog) {
+		// Program finished on its own
+		return ErrProgramNotRunning
+	}
+
+	prog.mtx.Lock()
+	prog.Status.State = ProgramPaused
+	prog.Status.Power = 0
+	prog.mtx.Unlock()
+	return nil
+}
+
+func (p *ProgramHandler) resume(prog *program) error {
+	prog.mtx.Lock()
+	state := prog.Status.State
+	prog.mtx.Unlock()
+	if state != ProgramPaused {
+		return ErrProgramNotPaused
+	}
+
+	prog.mtx.Lock()
+	prog.Status.State = ProgramRunning
+	prog.mtx.Unlock()
+	return p.run(prog)
+}
+
+func (p *ProgramHandler) abort(prog *program) error {
+	prog.mtx.Lock()
+	state := prog.Status.State
+	prog.mtx.Unlock()
+	switch state {
+	case ProgramRunning:
+		if !p.halt(prog) {
+			return ErrProgramNotRunning
+		}
+	case ProgramPaused:
+	default:
+		return ErrProgramNotRunning
+	}
+
+	prog.mtx.Lock()
+	prog.Status.State = ProgramAborted
+	prog.Status.Power = 0
+	prog.Status.Remaining = prog.remaining()
+	p.heaters.release(prog.Config.HeaterID, prog.owner())
+	prog.mtx.Unlock()
+	return nil
+}
+
+// run enables heater and starts loop, Program must be in running state
+func (p *ProgramHandler) run(prog *program) error {
+	prog.mtx.Lock()
+	defer prog.mtx.Unlock()
+
+	if err := p.heaters.Enable(prog.Config.HeaterID, true); err != nil {
+		p.fail(prog, err)
+		return err
+	}
+	prog.last = time.Time{}
+	prog.stop = make(chan struct{})
+	prog.fin = make(chan struct{})
+	go p.loop(prog, prog.Config.Interval, prog.stop, prog.fin)
+	return nil
+}
+
+// fail aborts Program, which can't run, and releases its heater, prog.mtx must be locked
+func (p *ProgramHandler) fail(prog *program, err error) {
+	prog.Status.State = ProgramAborted
+	prog.Status.Fault = err.Error()
+	p.heaters.release(prog.Config.HeaterID, prog.owner())
+}
+
+// owner identifies Program as user of heater
+func (prog *program) owner() string {
+	return "program:" + prog.Config.ID
+}
+
+// halt stops loop and leaves heater in safe state, returns false if loop was already finished
+func (p *ProgramHandler) halt(prog *program) bool {
+	prog.mtx.Lock()
+	stop, fin := prog.stop, prog.fin
+	prog.mtx.Unlock()
+
+	close(stop)
+	<-fin
+
+	prog.mtx.Lock()
+	defer prog.mtx.Unlock()
+	p.off(prog)
+	return prog.Status.State == ProgramRunning
+}
+
+// off turns heater off, prog.mtx must be locked
+func (p *ProgramHandler) off(prog *program) {
+	if err := p.heaters.Power(prog.Config.HeaterID, 0); err != nil {
+		logger.Error("Program power", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+	}
+	if err := p.heaters.Enable(prog.Config.HeaterID, false); err != nil {
+		logger.Error("Program enable", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+	}
+}
+
+func (p *ProgramHandler) loop(prog *program, interval time.Duration, stop, fin chan struct{}) {
+	defer close(fin)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	saved := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			changed, finished := p.update(prog, now)
+			if changed || now.Sub(saved) >= programSaveInterval {
+				p.save()
+				saved = now
+			}
+			if finished {
+				return
+			}
+		}
+	}
+}
+
+// update moves Program forward, returns whether step changed and whether Program is finished
+func (p *ProgramHandler) update(prog *program, now time.Time) (changed bool, finished bool) {
+	prog.mtx.Lock()
+	defer prog.mtx.Unlock()
+
+	dt := time.Duration(0)
+	if !prog.last.IsZero() {
+		dt = now.Sub(prog.last)
+	}
+	prog.last = now
+	prog.Status.Elapsed += dt
+	prog.Status.StepElapsed += dt
+	prog.Status.Fault = ""
+
+	step := prog.Config.Steps[prog.Status.Step]
+	if prog.Status.Holding {
+		prog.Held += dt
+	}
+
+	power, ok := p.drive(prog, step, dt)
+	if !ok {
+		// Without feedback, safest option is to turn off heater
+		power = 0
+	}
+	if err := p.heaters.Power(prog.Config.HeaterID, power); err != nil {
+		prog.Status.Fault = err.Error()
+		logger.Error("Program power", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+	}
+	prog.Status.Power = power
+
+	if prog.Status.Holding && prog.Held >= step.Hold {
+		changed = true
+		prog.Status.Step++
+		prog.Status.StepElapsed = 0
+		prog.Status.Holding = false
+		prog.Held = 0
+		// Next ramp starts from current set point, only if it was used
+		prog.SetPointValid = prog.SetPointValid && step.Type == ProgramStepTemperature
+		if prog.Status.Step == len(prog.Config.Steps) {
+			prog.Status.Step = len(prog.Config.Steps) - 1
+			prog.Status.State = ProgramFinished
+			prog.Status.Power = 0
+			p.off(prog)
+			p.heaters.release(prog.Config.HeaterID, prog.owner())
+			finished = true
+		}
+	}
+	prog.Status.Remaining = prog.remaining()
+	return changed, finished
+}
+
+// drive returns power for current step, false on lack of temperature, prog.mtx must be locked
+func (p *ProgramHandler) drive(prog *program, step ProgramStep, dt time.Duration) (uint, bool) {
+	tmp, err := p.pid.temperature(prog.Config.SensorType, prog.Config.SensorID)
+	if err == nil {
+		prog.Status.Temperature = tmp
+	}
+
+	if step.Type == ProgramStepPower {
+		prog.Status.Holding = true
+		return step.Power, true
+	}
+
+	if err != nil {
+		prog.Status.Fault = err.Error()
+		logger.Error("Program temperature", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+		return 0, false
+	}
+
+	if !prog.SetPointValid {
+		prog.Status.SetPoint = tmp
+		prog.SetPointValid = true
+	}
+	sp := prog.Status.SetPoint
+	if step.Rate <= 0 {
+		sp = step.Target
+	} else if delta := step.Rate * dt.Minutes(); math.Abs(step.Target-sp) <= delta {
+		sp = step.Target
+	} else if step.Target > sp {
+		sp += delta
+	} else {
+		sp -= delta
+	}
+	prog.Status.SetPoint = sp
+
+	if !prog.Status.Holding && sp == step.Target && math.Abs(tmp-step.Target) <= prog.Config.Tolerance {
+		prog.Status.Holding = true
+	}
+
+	cfg := prog.pid.Config()
+	cfg.SetPoint = sp
+	_ = prog.pid.Configure(cfg)
+	return uint(math.Round(prog.pid.Update(tmp, dt))), true
+}
+
+// remaining estimates time left, ramps are calculated from set point, prog.mtx must be locked
+func (prog *program) remaining() time.Duration {
+	if state := prog.Status.State; state == ProgramFinished || state == ProgramAborted {
+		return 0
+	}
+
+	left := time.Duration(0)
+	sp, valid := prog.Status.SetPoint, prog.SetPointValid
+	for i := prog.Status.Step; i < len(prog.Config.Steps); i++ {
+		step := prog.Config.Steps[i]
+		hold := step.Hold
+		if i == prog.Status.Step {
+			hold -= prog.Held
+		}
+		if hold > 0 {
+			left += hold
+		}
+		if step.Type != ProgramStepTemperature {
+			valid = false
+			continue
+		}
+		if valid && step.Rate > 0 {
+			left += time.Duration(math.Abs(step.Target-sp) / step.Rate * float64(time.Minute))
+		}
+		sp, valid = step.Target, true
+	}
+	return left
+}
+
+// save writes all programs to file, if path was set
+func (p *ProgramHandler) save() {
+	if p.path == "" {
+		return
+	}
+	programs := p.all()
+	records := make([]programRecord, 0, len(programs))
+	for _, prog := range programs {
+		prog.mtx.Lock()
+		records = append(records, prog.programRecord)
+		prog.mtx.Unlock()
+	}
+
+	p.storeMtx.Lock()
+	defer p.storeMtx.Unlock()
+	if err := writeJSON(p.path, records); err != nil {
+		logger.Error("Program save", logging.String("path", p.path), logging.String("error", err.Error()))
+	}
+}
+
+// load reads programs from file, missing file is not an error
+func (p *ProgramHandler) load() error {
+	buf, err := os.ReadFile(p.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	var records []programRecord
+	if err := json.Unmarshal(buf, &records); err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.programs = make(map[string]*program, len(records))
+	for _, record := range records {
+		ctrl, err := pid.New(record.Config.Config)
+		if err != nil {
+			logger.Error("Program load", logging.String("ID", record.Config.ID), logging.String("error", err.Error()))
+			continue
+		}
+		prog := &program{programRecord: record, pid: ctrl}
+		p.programs[record.Config.ID] = prog
+	}
+	return nil
+}
+
+// writeJSON replaces file at once, so it is never left half written
+func writeJSON(path string, v any) error {
+	buf, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Open loads programs from file and resumes running ones
+func (p *ProgramHandler) Open() {
+	if p.path == "" {
+		return
+	}
+	if err := p.load(); err != nil {
+		logger.Error("Program load", logging.String("path", p.path), logging.String("error", err.Error()))
+		return
+	}
+	for _, prog := range p.all() {
+		prog.mtx.Lock()
+		state := prog.Status.State
+		prog.mtx.Unlock()
+		if state != ProgramRunning && state != ProgramPaused {
+			continue
+		}
+		// Paused Program keeps its heater too
+		err := p.heaters.claim(prog.Config.HeaterID, prog.owner())
+		if err == nil && state == ProgramPaused {
+			continue
+		}
+		logger.Debug("Resuming Program", logging.String("ID", prog.Config.ID))
+		if err == nil {
+			// Sensors are disabled after restart, Program can't run without its input
+			err = enableSensor(p.pid.ds, p.pid.pt, prog.Config.SensorType, prog.Config.SensorID)
+		}
+		if err != nil {
+			logger.Error("Program resume", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+			prog.mtx.Lock()
+			p.fail(prog, err)
+			prog.mtx.Unlock()
+			p.save()
+			continue
+		}
+		if err := p.run(prog); err != nil {
+			logger.Error("Program resume", logging.String("ID", prog.Config.ID), logging.String("error", err.Error()))
+		}
+	}
+}
+
+// Close stops running programs, they are still running in file, so they are resumed on next Open
+func (p *ProgramHandler) Close() {
+	for _, prog := range p.all() {
+		prog.cmd.Lock()
+		prog.mtx.Lock()
+		running := prog.Status.State == ProgramRunning
+		prog.mtx.Unlock()
+		if running {
+			p.halt(prog)
+		}
+		prog.cmd.Unlock()
+	}
+	p.save()
+}
@@ -0,0 +1,451 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/ds18b20"
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/pid"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProgramTestSuite struct {
+	suite.Suite
+	req  *http.Request
+	resp *httptest.ResponseRecorder
+}
+
+func TestProgramTestSuite(t *testing.T) {
+	suite.Run(t, new(ProgramTestSuite))
+}
+
+func (t *ProgramTestSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+	t.resp = httptest.NewRecorder()
+}
+
+func (t *ProgramTestSuite) handler(heater *HeaterMock, ds *DS18B20SensorMock, options ...embedded.Option) *embedded.Rest {
+	ds.On("ID").Return("ds")
+	ds.On("GetConfig").Return(ds18b20.SensorConfig{ID: "ds"})
+	options = append([]embedded.Option{
+		embedded.WithHeaters(map[string]embedded.Heater{"heater": heater}),
+		embedded.WithDS18B20([]embedded.DSSensor{ds}),
+	}, options...)
+	h, err := embedded.NewRest("", options...)
+	t.Require().Nil(err)
+	return h
+}
+
+// enable makes sensor usable by Program, temperature is constant
+func (t *ProgramTestSuite) enable(h *embedded.Rest, ds *DS18B20SensorMock, temperature float64) {
+	ds.On("Configure", mock.Anything).Return(nil)
+	ds.On("Poll").Return()
+	ds.On("Close").Return()
+	ds.On("Average").Return(temperature)
+	_, err := h.DS.SetConfig(embedded.DSSensorConfig{Enabled: true, SensorConfig: ds18b20.SensorConfig{ID: "ds"}})
+	t.Require().Nil(err)
+}
+
+func (t *ProgramTestSuite) config() embedded.ProgramConfig {
+	return embedded.ProgramConfig{
+		ID:         "program",
+		HeaterID:   "heater",
+		SensorID:   "ds",
+		SensorType: embedded.SensorDS18B20,
+		Interval:   5 * time.Millisecond,
+		Config:     pid.Config{Kp: 2, OutputMax: 100},
+	}
+}
+
+func (t *ProgramTestSuite) TestCreate_Verify() {
+	h := t.handler(new(HeaterMock), new(DS18B20SensorMock))
+
+	args := []struct {
+		name  string
+		steps []embedded.ProgramStep
+		err   error
+	}{
+		{
+			name: "no steps",
+			err:  embedded.ErrNoSteps,
+		},
+		{
+			name:  "unknown step",
+			steps: []embedded.ProgramStep{{Type: "cool"}},
+			err:   embedded.ErrUnknownStepType,
+		},
+		{
+			name:  "power over 100",
+			steps: []embedded.ProgramStep{{Type: embedded.ProgramStepPower, Power: 101}},
+			err:   embedded.ErrOutputOutOfRange,
+		},
+	}
+	for _, arg := range args {
+		cfg := t.config()
+		cfg.Steps = arg.steps
+		_, err := h.Program.Create(cfg)
+		t.ErrorContains(err, arg.err.Error(), arg.name)
+	}
+
+	// Binding is verified in the same way as in PID
+	cfg := t.config()
+	cfg.Steps = []embedded.ProgramStep{{Type: embedded.ProgramStepPower, Power: 10}}
+	cfg.HeaterID = "unknown"
+	_, err := h.Program.Create(cfg)
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+
+	cfg = embedded.ProgramConfig{ID: "program", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20,
+		Steps: []embedded.ProgramStep{{Type: embedded.ProgramStepTemperature, Target: 78, Rate: 2, Hold: 30 * time.Minute}}}
+	created, err := h.Program.Create(cfg)
+	t.Nil(err)
+	t.EqualValues(time.Second, created.Interval)
+	t.EqualValues(100, created.OutputMax)
+	t.EqualValues(0.5, created.Tolerance)
+
+	_, err = h.Program.Create(cfg)
+	t.ErrorContains(err, embedded.ErrIDAlreadyExists.Error())
+
+	status, err := h.Program.StatusBy(cfg.ID)
+	t.Nil(err)
+	t.Equal(embedded.ProgramIdle, status.State)
+	t.Equal(30*time.Minute, status.Remaining)
+}
+
+func (t *ProgramTestSuite) TestRun_HoldThenPower() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.enable(h, ds, 78)
+
+	heater.On("Enable", mock.Anything).Once()
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("Disable").Once()
+
+	cfg := t.config()
+	cfg.Steps = []embedded.ProgramStep{
+		{Type: embedded.ProgramStepTemperature, Target: 78, Hold: 20 * time.Millisecond},
+		{Type: embedded.ProgramStepPower, Power: 30, Hold: 20 * time.Millisecond},
+	}
+	_, err := h.Program.Create(cfg)
+	t.Require().Nil(err)
+	t.Require().Nil(h.Program.Command(cfg.ID, embedded.ProgramStart))
+
+	t.Eventually(func() bool {
+		status, _ := h.Program.StatusBy(cfg.ID)
+		return status.State == embedded.ProgramFinished
+	}, time.Second, 5*time.Millisecond)
+
+	status, _ := h.Program.StatusBy(cfg.ID)
+	t.Equal(1, status.Step)
+	t.Zero(status.Remaining)
+	t.Zero(status.Power)
+	t.InDelta(78, status.Temperature, 1e-9)
+	t.GreaterOrEqual(status.Elapsed, 40*time.Millisecond)
+	heater.AssertCalled(t.T(), "SetPower", uint(30))
+	heater.AssertExpectations(t.T())
+
+	// Finished program can't be paused, but can be started again
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramPause), embedded.ErrProgramNotRunning.Error())
+}
+
+func (t *ProgramTestSuite) TestRun_RampPauseResumeAbort() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.enable(h, ds, 20)
+
+	heater.On("Enable", mock.Anything)
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("Disable")
+
+	// 600 °C/min is 1 °C per 100 ms
+	cfg := t.config()
+	cfg.Steps = []embedded.ProgramStep{
+		{Type: embedded.ProgramStepTemperature, Target: 30, Rate: 600, Hold: time.Minute},
+	}
+	_, err := h.Program.Create(cfg)
+	t.Require().Nil(err)
+	t.Require().Nil(h.Program.Command(cfg.ID, embedded.ProgramStart))
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramStart), embedded.ErrProgramRunning.Error())
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramResume), embedded.ErrProgramNotPaused.Error())
+	t.ErrorContains(h.Program.Command(cfg.ID, "jump"), embedded.ErrUnknownCommand.Error())
+
+	<-time.After(100 * time.Millisecond)
+	status, _ := h.Program.StatusBy(cfg.ID)
+	t.Equal(embedded.ProgramRunning, status.State)
+	t.Greater(status.SetPoint, 20.0)
+	t.Less(status.SetPoint, 30.0)
+	// Temperature doesn't follow, so hold doesn't start
+	t.False(status.Holding)
+	t.Greater(status.Remaining, time.Minute)
+	t.Greater(status.Power, uint(0))
+
+	// Config can't be changed while program is running
+	_, err = h.Program.SetConfig(cfg)
+	t.ErrorContains(err, embedded.ErrProgramRunning.Error())
+
+	t.Nil(h.Program.Command(cfg.ID, embedded.ProgramPause))
+	paused, _ := h.Program.StatusBy(cfg.ID)
+	t.Equal(embedded.ProgramPaused, paused.State)
+	t.Zero(paused.Power)
+	heater.AssertCalled(t.T(), "SetPower", uint(0))
+	heater.AssertCalled(t.T(), "Disable")
+
+	// Time doesn't flow while paused
+	<-time.After(30 * time.Millisecond)
+	status, _ = h.Program.StatusBy(cfg.ID)
+	t.Equal(paused.Elapsed, status.Elapsed)
+	t.Equal(paused.SetPoint, status.SetPoint)
+
+	t.Nil(h.Program.Command(cfg.ID, embedded.ProgramResume))
+	<-time.After(30 * time.Millisecond)
+	status, _ = h.Program.StatusBy(cfg.ID)
+	t.Equal(embedded.ProgramRunning, status.State)
+	t.Greater(status.SetPoint, paused.SetPoint)
+
+	t.Nil(h.Program.Command(cfg.ID, embedded.ProgramAbort))
+	status, _ = h.Program.StatusBy(cfg.ID)
+	t.Equal(embedded.ProgramAborted, status.State)
+	t.Zero(status.Remaining)
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramAbort), embedded.ErrProgramNotRunning.Error())
+}
+
+func (t *ProgramTestSuite) TestRun_HeaterBusy() {
+	heater := new(HeaterMock)
+	ds := new(DS18B20SensorMock)
+	h := t.handler(heater, ds)
+	t.enable(h, ds, 20)
+	heater.On("Enable", mock.Anything)
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("Disable")
+
+	_, err := h.PID.Create(embedded.PIDConfig{ID: "pid", HeaterID: "heater", SensorID: "ds", SensorType: embedded.SensorDS18B20, Interval: time.Hour})
+	t.Require().Nil(err)
+	t.Require().Nil(h.PID.Enable("pid", true))
+
+	cfg := t.config()
+	cfg.Steps = []embedded.ProgramStep{{Type: embedded.ProgramStepPower, Power: 10, Hold: time.Hour}}
+	_, err = h.Program.Create(cfg)
+	t.Require().Nil(err)
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramStart), embedded.ErrHeaterBusy.Error())
+
+	t.Nil(h.PID.Enable("pid", false))
+	t.Nil(h.Program.Command(cfg.ID, embedded.ProgramStart))
+
+	// The same heater in another program
+	cfg.ID = "another"
+	_, err = h.Program.Create(cfg)
+	t.Require().Nil(err)
+	t.ErrorContains(h.Program.Command(cfg.ID, embedded.ProgramStart), embedded.ErrHeaterBusy.Error())
+
+	// PID can't take heater from running or paused program
+	t.ErrorContains(h.PID.Enable("pid", true), embedded.ErrHeaterBusy.Error())
+	t.Nil(h.Program.Command("program", embedded.ProgramPause))
+	t.ErrorContains(h.PID.Enable("pid", true), embedded.ErrHeaterBusy.Error())
+	cfgPID, err := h.PID.GetConfig("pid")
+	t.Nil(err)
+	t.False(cfgPID.Enabled)
+
+	t.Nil(h.Program.Command("program", embedded.ProgramAbort))
+	t.Nil(h.PID.Enable("pid", true))
+	t.Nil(h.PID.Enable("pid", false))
+}
+
+func (t *ProgramTestSuite) TestPersistence() {
+	path := filepath.Join(t.T().TempDir(), "programs.json")
+	heater := new(HeaterMock)
+	heater.On("Enable", mock.Anything)
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("Disable")
+
+	// Real sensor, so its state after restart is the same as on device
+	h := t.handlerWithSensor(heater, path)
+	_, err := h.DS.SetConfig(embedded.DSSensorConfig{Enabled: true, SensorConfig: ds18b20.SensorConfig{ID: "28-1", PollInterval: 5 * time.Millisecond, Samples: 1}})
+	t.Require().Nil(err)
+
+	cfg := t.config()
+	cfg.SensorID = "28-1"
+	cfg.Steps = []embedded.ProgramStep{
+		{Type: embedded.ProgramStepPower, Power: 40, Hold: 50 * time.Millisecond},
+		{Type: embedded.ProgramStepTemperature, Target: 50, Hold: time.Hour},
+	}
+	_, err = h.Program.Create(cfg)
+	t.Require().Nil(err)
+	idle := cfg
+	idle.ID = "idle"
+	_, err = h.Program.Create(idle)
+	t.Require().Nil(err)
+
+	t.Require().Nil(h.Program.Command(cfg.ID, embedded.ProgramStart))
+	t.Eventually(func() bool {
+		status, _ := h.Program.StatusBy(cfg.ID)
+		return status.Step == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// Service restart
+	h.Close()
+	_, err = os.Stat(path)
+	t.Require().Nil(err)
+	before, _ := h.Program.StatusBy(cfg.ID)
+
+	heater = new(HeaterMock)
+	heater.On("Enable", mock.Anything).Once()
+	heater.On("SetPower", mock.Anything).Return(nil)
+	heater.On("Disable")
+	h = t.handlerWithSensor(heater, path)
+
+	configs := h.Program.GetConfigs()
+	t.Len(configs, 2)
+	status, err := h.Program.StatusBy(cfg.ID)
+	t.Nil(err)
+	t.Equal(embedded.ProgramRunning, status.State)
+	t.Equal(1, status.Step)
+	t.GreaterOrEqual(status.Elapsed, before.Elapsed)
+	status, _ = h.Program.StatusBy(idle.ID)
+	t.Equal(embedded.ProgramIdle, status.State)
+
+	// Sensor is enabled on resume, so Program goes on with temperature step: 2 * (50 - 21.5)
+	sensor, err := h.DS.GetConfig("28-1")
+	t.Nil(err)
+	t.True(sensor.Enabled)
+	t.Eventually(func() bool {
+		status, _ = h.Program.StatusBy(cfg.ID)
+		return status.Power == 57 && status.StepElapsed > before.StepElapsed
+	}, time.Second, 5*time.Millisecond)
+	t.Empty(status.Fault)
+	t.InDelta(21.5, status.Temperature, 1e-9)
+	t.Nil(h.Program.Command(cfg.ID, embedded.ProgramAbort))
+	h.Close()
+	heater.AssertExpectations(t.T())
+}
+
+// handlerWithSensor uses real DS18B20, which reads 21.5 degrees
+func (t *ProgramTestSuite) handlerWithSensor(heater *HeaterMock, path string) *embedded.Rest {
+	s, err := ds18b20.NewSensor(fakeOnewire{}, "28-1", "w1")
+	t.Require().Nil(err)
+	h, err := embedded.NewRest("",
+		embedded.WithHeaters(map[string]embedded.Heater{"heater": heater}),
+		embedded.WithDS18B20([]embedded.DSSensor{s}),
+		embedded.WithProgramStore(path),
+	)
+	t.Require().Nil(err)
+	return h
+}
+
+func (t *ProgramTestSuite) TestRestAPI() {
+	h := t.handler(new(HeaterMock), new(DS18B20SensorMock))
+	cfg := t.config()
+	cfg.Tolerance = 1
+	cfg.Steps = []embedded.ProgramStep{
+		{Type: embedded.ProgramStepTemperature, Target: 78, Rate: 2, Hold: 30 * time.Minute},
+		{Type: embedded.ProgramStepTemperature, Target: 92},
+	}
+
+	// Create
+	var body bytes.Buffer
+	_ = json.NewEncoder(&body).Encode(cfg)
+	t.req, _ = http.NewRequest(http.MethodPost, embedded.RoutesCreateProgram, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ := io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(cfg), string(b))
+
+	// Update
+	t.resp = httptest.NewRecorder()
+	cfg.Steps[1].Hold = time.Minute
+	_ = json.NewEncoder(&body).Encode(cfg)
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesConfigProgram, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON(cfg), string(b))
+
+	// Get
+	t.resp = httptest.NewRecorder()
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetPrograms, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	t.JSONEq(toJSON([]embedded.ProgramConfig{cfg}), string(b))
+
+	// Command on wrong ID
+	t.resp = httptest.NewRecorder()
+	_ = json.NewEncoder(&body).Encode(embedded.ProgramCommand{ID: "another", Command: embedded.ProgramStart})
+	t.req, _ = http.NewRequest(http.MethodPut, embedded.RoutesCommandProgram, &body)
+	t.req.Header.Add("Content-Type", "application/json")
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusInternalServerError, t.resp.Code)
+	t.Contains(string(b), embedded.ErrNoSuchID.Error())
+
+	// Status
+	t.resp = httptest.NewRecorder()
+	t.req, _ = http.NewRequest(http.MethodGet, embedded.RoutesGetProgramStatus, nil)
+	h.Router.ServeHTTP(t.resp, t.req)
+	b, _ = io.ReadAll(t.resp.Body)
+	t.Equal(http.StatusOK, t.resp.Code)
+	var status []embedded.ProgramStatus
+	fromJSON(b, &status)
+	t.Len(status, 1)
+	t.Equal(cfg.ID, status[0].ID)
+	t.Equal(embedded.ProgramIdle, status[0].State)
+	// Only holds, first ramp depends on temperature at start and second one is immediate
+	t.Equal(31*time.Minute, status[0].Remaining)
+}
+
+func (t *ProgramTestSuite) TestClient() {
+	heater := new(HeaterMock)
+	h := t.handler(heater, new(DS18B20SensorMock))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	pc := embedded.NewProgramClient(srv.URL, 1*time.Second)
+	cfg := t.config()
+	cfg.Interval = time.Hour
+	cfg.Steps = []embedded.ProgramStep{{Type: embedded.ProgramStepPower, Power: 50, Hold: time.Hour}}
+
+	_, err := pc.Command(cfg.ID, embedded.ProgramStart)
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesCommandProgram)
+
+	created, err := pc.Create(cfg)
+	t.Nil(err)
+	t.Equal(cfg.ID, created.ID)
+
+	heater.On("Enable", mock.Anything).Once()
+	cmd, err := pc.Command(cfg.ID, embedded.ProgramStart)
+	t.Nil(err)
+	t.Equal(embedded.ProgramCommand{ID: cfg.ID, Command: embedded.ProgramStart}, cmd)
+
+	status, err := pc.Status()
+	t.Nil(err)
+	t.Len(status, 1)
+	t.Equal(embedded.ProgramRunning, status[0].State)
+
+	heater.On("SetPower", uint(0)).Return(nil).Once()
+	heater.On("Disable").Once()
+	_, err = pc.Command(cfg.ID, embedded.ProgramAbort)
+	t.Nil(err)
+
+	configs, err := pc.Get()
+	t.Nil(err)
+	t.Equal([]embedded.ProgramConfig{created}, configs)
+	heater.AssertExpectations(t.T())
+}
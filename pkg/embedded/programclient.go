@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type ProgramClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewProgramClient(addr string, timeout time.Duration) *ProgramClient {
+	return &ProgramClient{addr: addr, timeout: timeout}
+}
+
+func (p *ProgramClient) Get() ([]ProgramConfig, error) {
+	return restclient.Get[[]ProgramConfig, *Error](p.addr+RoutesGetPrograms, p.timeout)
+}
+
+func (p *ProgramClient) Create(cfg ProgramConfig) (ProgramConfig, error) {
+	return restclient.Post[ProgramConfig, *Error](p.addr+RoutesCreateProgram, p.timeout, cfg)
+}
+
+func (p *ProgramClient) Configure(setConfig ProgramConfig) (ProgramConfig, error) {
+	return restclient.Put[ProgramConfig, *Error](p.addr+RoutesConfigProgram, p.timeout, setConfig)
+}
+
+func (p *ProgramClient) Command(id, command string) (ProgramCommand, error) {
+	return restclient.Put[ProgramCommand, *Error](p.addr+RoutesCommandProgram, p.timeout, ProgramCommand{ID: id, Command: command})
+}
+
+func (p *ProgramClient) Status() ([]ProgramStatus, error) {
+	return restclient.Get[[]ProgramStatus, *Error](p.addr+RoutesGetProgramStatus, p.timeout)
+}
+
+type ProgramRPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.ProgramClient
+}
+
+func NewProgramRPCClient(addr string, timeout time.Duration) (*ProgramRPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &ProgramRPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewProgramClient(conn)}, nil
+}
+
+func (g *ProgramRPCClient) Get() ([]ProgramConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.ProgramGet(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	confs := make([]ProgramConfig, len(got.Configs))
+	for i, elem := range got.Configs {
+		confs[i] = rpcToProgramConfig(elem)
+	}
+	return confs, nil
+}
+
+func (g *ProgramRPCClient) Create(cfg ProgramConfig) (ProgramConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.ProgramCreate(ctx, programConfigToRPC(&cfg))
+	if err != nil {
+		return ProgramConfig{}, err
+	}
+	return rpcToProgramConfig(got), nil
+}
+
+func (g *ProgramRPCClient) Configure(setConfig ProgramConfig) (ProgramConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.ProgramConfigure(ctx, programConfigToRPC(&setConfig))
+	if err != nil {
+		return ProgramConfig{}, err
+	}
+	return rpcToProgramConfig(got), nil
+}
+
+func (g *ProgramRPCClient) Command(id, command string) (ProgramCommand, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.ProgramExecute(ctx, &embeddedproto.ProgramCommand{ID: id, Command: command})
+	if err != nil {
+		return ProgramCommand{}, err
+	}
+	return ProgramCommand{ID: got.ID, Command: got.Command}, nil
+}
+
+func (g *ProgramRPCClient) Status() ([]ProgramStatus, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.ProgramGetStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	status := make([]ProgramStatus, len(got.Status))
+	for i, elem := range got.Status {
+		status[i] = rpcToProgramStatus(elem)
+	}
+	return status, nil
+}
+
+func (g *ProgramRPCClient) Close() {
+	_ = g.conn.Close()
+}
@@ -6,6 +6,8 @@
 package embedded
 
 import (
+	"sync"
+
 	"github.com/a-clap/embedded/pkg/max31865"
 )
 
@@ -17,6 +19,9 @@ type PTSensor interface {
 	Average() float64
 	Temperature() (actual float64, average float64, err error)
 	GetReadings() []max31865.Readings
+	Latest() max31865.Readings
+	DetectFaults() (max31865.FaultStatus, error)
+	Fault() max31865.FaultStatus
 	Close() error
 }
 type PTError struct {
@@ -40,10 +45,14 @@ func (e *PTError) Error() string {
 type PTSensorConfig struct {
 	Enabled bool `json:"enabled"`
 	max31865.SensorConfig
+	// FaultStatus is read-only, it holds faults found by last fault detection cycle or conversion
+	max31865.FaultStatus
 }
 
+// ptSensor serializes changes of PTSensor, mtx protects PTSensorConfig
 type ptSensor struct {
 	PTSensor
+	mtx sync.Mutex
 	PTSensorConfig
 }
 
@@ -59,7 +68,7 @@ type PTHandler struct {
 func (p *PTHandler) GetTemperatures() []PTTemperature {
 	temps := make([]PTTemperature, 0, len(p.sensors))
 	for _, pt := range p.sensors {
-		if pt.Enabled {
+		if pt.enabled() {
 			tmp := PTTemperature{Readings: pt.GetReadings()}
 			temps = append(temps, tmp)
 		}
@@ -70,7 +79,10 @@ func (p *PTHandler) GetTemperatures() []PTTemperature {
 func (p *PTHandler) GetSensors() []PTSensorConfig {
 	sensors := make([]PTSensorConfig, 0, len(p.sensors))
 	for _, pt := range p.sensors {
+		pt.mtx.Lock()
+		pt.FaultStatus = pt.Fault()
 		sensors = append(sensors, pt.PTSensorConfig)
+		pt.mtx.Unlock()
 	}
 	return sensors
 }
@@ -81,6 +93,8 @@ func (p *PTHandler) SetConfig(cfg PTSensorConfig) (newCfg PTSensorConfig, err er
 		err = &PTError{ID: cfg.ID, Op: "SetConfig.sensorBy", Err: err.Error()}
 		return
 	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
 	
 	if err = sensor.Configure(cfg.SensorConfig); err != nil {
 		err = &PTError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
@@ -102,7 +116,21 @@ func (p *PTHandler) SetConfig(cfg PTSensorConfig) (newCfg PTSensorConfig, err er
 	}
 	sensor.Enabled = cfg.Enabled
 	
-	return p.GetConfig(cfg.ID)
+	return sensor.config(), nil
+}
+
+// DetectFaults runs fault detection cycle on sensor, result is in FaultStatus of returned config
+func (p *PTHandler) DetectFaults(id string) (PTSensorConfig, error) {
+	sensor, err := p.sensorBy(id)
+	if err != nil {
+		return PTSensorConfig{}, &PTError{ID: id, Op: "DetectFaults.sensorBy", Err: err.Error()}
+	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+	if _, err := sensor.DetectFaults(); err != nil {
+		return PTSensorConfig{}, &PTError{ID: id, Op: "DetectFaults", Err: err.Error()}
+	}
+	return sensor.config(), nil
 }
 
 func (p *PTHandler) GetConfig(id string) (PTSensorConfig, error) {
@@ -110,8 +138,9 @@ func (p *PTHandler) GetConfig(id string) (PTSensorConfig, error) {
 	if err != nil {
 		return PTSensorConfig{}, &PTError{ID: id, Op: "GetConfig.sensorBy", Err: err.Error()}
 	}
-	sensor.SensorConfig = sensor.GetConfig()
-	return sensor.PTSensorConfig, nil
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+	return sensor.config(), nil
 }
 
 func (p *PTHandler) sensorBy(id string) (*ptSensor, error) {
@@ -128,6 +157,7 @@ func (p *PTHandler) Open() {
 func (p *PTHandler) Close() []error {
 	var errs []error
 	for name, sensor := range p.sensors {
+		sensor.mtx.Lock()
 		if sensor.Enabled {
 			sensor.Enabled = false
 			if err := sensor.Close(); err != nil {
@@ -135,6 +165,21 @@ func (p *PTHandler) Close() []error {
 				errs = append(errs, err)
 			}
 		}
+		sensor.mtx.Unlock()
 	}
 	return errs
 }
+
+// config refreshes and returns snapshot of config, s.mtx must be locked
+func (s *ptSensor) config() PTSensorConfig {
+	s.SensorConfig = s.GetConfig()
+	s.FaultStatus = s.Fault()
+	return s.PTSensorConfig
+}
+
+// enabled returns true, if sensor is polling
+func (s *ptSensor) enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.Enabled
+}
@@ -104,6 +104,7 @@ func (t *PTTestSuite) TestPTRestAPI_ConfigSensor() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.old.ID)
 		m.On("GetConfig").Return(elem.new.SensorConfig)
 		m.On("Configure", elem.new.SensorConfig).Return(nil)
@@ -169,6 +170,7 @@ func (t *PTTestSuite) TestPTRestAPI_GetTemperatures() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.cfg.ID)
 		m.On("GetConfig").Return(elem.cfg.SensorConfig)
 		m.On("GetReadings").Return(elem.stat.Readings)
@@ -230,6 +232,7 @@ func (t *PTTestSuite) TestPTRestAPI_GetSensors() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.ID)
 		m.On("GetConfig").Return(elem.SensorConfig)
 		t.mock = append(t.mock, m)
@@ -273,6 +276,7 @@ func (t *PTTestSuite) TestPT_SetConfig_EnableDisable() {
 
 	t.mock = make([]*PTMock, 0, 1)
 	m := new(PTMock)
+	m.On("Fault").Return(max31865.FaultStatus{})
 	m.On("ID").Return(disabled.ID)
 	m.On("GetConfig").Return(disabled.SensorConfig)
 	m.On("Configure", enabled.SensorConfig).Return(nil)
@@ -346,6 +350,7 @@ func (t *PTTestSuite) TestPT_SetConfig() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.old.ID)
 		m.On("GetConfig").Return(elem.new.SensorConfig)
 		m.On("Configure", elem.new.SensorConfig).Return(nil)
@@ -389,6 +394,7 @@ func (t *PTTestSuite) TestPT_GetSensors() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.ID)
 		m.On("GetConfig").Return(elem.SensorConfig)
 		t.mock = append(t.mock, m)
@@ -426,6 +432,7 @@ func (t *PTTestSuite) TestPT_GetConfig() {
 	t.mock = make([]*PTMock, 0, len(args))
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.ID)
 		m.On("GetConfig").Return(elem.SensorConfig)
 		t.mock = append(t.mock, m)
@@ -464,6 +471,11 @@ func (p *PTMock) GetConfig() max31865.SensorConfig {
 	return args.Get(0).(max31865.SensorConfig)
 }
 
+func (p *PTMock) Latest() max31865.Readings {
+	args := p.Called()
+	return args.Get(0).(max31865.Readings)
+}
+
 func (p *PTMock) Average() float64 {
 	args := p.Called()
 	return args.Get(0).(float64)
@@ -479,6 +491,16 @@ func (p *PTMock) GetReadings() []max31865.Readings {
 	return args.Get(0).([]max31865.Readings)
 }
 
+func (p *PTMock) DetectFaults() (max31865.FaultStatus, error) {
+	args := p.Called()
+	return args.Get(0).(max31865.FaultStatus), args.Error(1)
+}
+
+func (p *PTMock) Fault() max31865.FaultStatus {
+	args := p.Called()
+	return args.Get(0).(max31865.FaultStatus)
+}
+
 func (p *PTMock) Close() error {
 	args := p.Called()
 	return args.Error(0)
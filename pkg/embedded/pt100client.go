@@ -10,6 +10,7 @@ import (
 	"time"
 	
 	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/max31865"
 	"github.com/a-clap/embedded/pkg/restclient"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
@@ -33,6 +34,11 @@ func (p *PTClient) Configure(setConfig PTSensorConfig) (PTSensorConfig, error) {
 	return restclient.Put[PTSensorConfig, *Error](p.addr+RoutesConfigPT100Sensor, p.timeout, setConfig)
 }
 
+// DetectFaults runs fault detection cycle on sensor
+func (p *PTClient) DetectFaults(id string) (PTSensorConfig, error) {
+	return restclient.Put[PTSensorConfig, *Error](p.addr+RoutesDetectPT100Faults, p.timeout, PTSensorConfig{SensorConfig: max31865.SensorConfig{ID: id}})
+}
+
 func (p *PTClient) Temperatures() ([]PTTemperature, error) {
 	return restclient.Get[[]PTTemperature, *Error](p.addr+RoutesGetPT100Temperatures, p.timeout)
 }
@@ -77,6 +83,17 @@ func (g *PTRPCClient) Configure(setConfig PTSensorConfig) (PTSensorConfig, error
 	return setConfig, nil
 }
 
+// DetectFaults runs fault detection cycle on sensor
+func (g *PTRPCClient) DetectFaults(id string) (PTSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.PTDetectFaults(ctx, &embeddedproto.PTConfig{ID: id})
+	if err != nil {
+		return PTSensorConfig{}, err
+	}
+	return rpcToPTConfig(got), nil
+}
+
 func (g *PTRPCClient) Temperatures() ([]PTTemperature, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
 	defer cancel()
@@ -85,6 +85,7 @@ func (p *PTClientSuite) Test_Temperatures() {
 	var readings []embedded.PTTemperature
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.cfg.ID)
 		m.On("GetConfig").Return(elem.cfg.SensorConfig)
 		m.On("GetReadings").Return(elem.readings)
@@ -152,6 +153,7 @@ func (p *PTClientSuite) Test_Configure() {
 	var mocks []*PTMock
 	for _, elem := range args {
 		m := new(PTMock)
+		m.On("Fault").Return(max31865.FaultStatus{})
 		m.On("ID").Return(elem.cfg.ID)
 		m.On("GetConfig").Return(elem.cfg.SensorConfig).Once()
 		cfgs = append(cfgs, elem.cfg)
@@ -193,6 +195,41 @@ func (p *PTClientSuite) Test_Configure() {
 
 }
 
+func (p *PTClientSuite) Test_DetectFaults() {
+	t := p.Require()
+	cfg := max31865.SensorConfig{ID: "pt", Samples: 10}
+	fault := max31865.FaultStatus{Fault: max31865.FaultRefInHigh, FaultText: "Open RTD element"}
+
+	m := new(PTMock)
+	m.On("ID").Return(cfg.ID)
+	m.On("GetConfig").Return(cfg)
+	m.On("DetectFaults").Return(fault, nil).Once()
+	m.On("Fault").Return(fault)
+
+	h, _ := embedded.NewRest("", embedded.WithPT([]embedded.PTSensor{m}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	pt := embedded.NewPTClient(srv.URL, 1*time.Second)
+	got, err := pt.DetectFaults(cfg.ID)
+	t.Nil(err)
+	t.Equal(embedded.PTSensorConfig{SensorConfig: cfg, FaultStatus: fault}, got)
+
+	// Last faults are also reported with sensors
+	sensors, err := pt.Get()
+	t.Nil(err)
+	t.Equal([]embedded.PTSensorConfig{got}, sensors)
+
+	_, err = pt.DetectFaults("not exist")
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesDetectPT100Faults)
+
+	errDetect := errors.New("hello world")
+	m.On("DetectFaults").Return(max31865.FaultStatus{}, errDetect).Once()
+	_, err = pt.DetectFaults(cfg.ID)
+	t.ErrorContains(err, errDetect.Error())
+}
+
 func (p *PTClientSuite) Test_NotImplemented() {
 	t := p.Require()
 	h, _ := embedded.NewRest("")
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"time"
 	
+	"github.com/a-clap/embedded/pkg/counter"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,30 +20,104 @@ type restRouter struct {
 const (
 	RoutesGetHeaters             = "/api/heater"
 	RoutesConfigHeater           = "/api/heater"
+	RoutesClearHeaterFault       = "/api/heater/fault"
+	RoutesSetHeaterPower         = "/api/heater/power"
+	RoutesGetHeaterStats         = "/api/heater/stats"
+	RoutesResetHeaterStats       = "/api/heater/stats"
+	RoutesGetHeaterGroups        = "/api/heater/group"
 	RoutesGetOnewireSensors      = "/api/onewire"
 	RoutesGetOnewireTemperatures = "/api/onewire/temperatures"
 	RoutesConfigOnewireSensor    = "/api/onewire"
+	RoutesGetOnewireEvents       = "/api/onewire/events"
+	RoutesGetOnewireAlarms       = "/api/onewire/alarms"
+	RoutesRestoreOnewireSensor   = "/api/onewire/restore"
+	RoutesGetOnewireDevices      = "/api/onewire/devices"
 	RoutesGetPT100Sensors        = "/api/pt100"
 	RoutesGetPT100Temperatures   = "/api/pt100/temperatures"
 	RoutesConfigPT100Sensor      = "/api/pt100"
+	RoutesDetectPT100Faults      = "/api/pt100/faults"
+	RoutesGetTCSensors           = "/api/thermocouple"
+	RoutesGetTCTemperatures      = "/api/thermocouple/temperatures"
+	RoutesConfigTCSensor         = "/api/thermocouple"
+	RoutesGetIIOSensors          = "/api/iio"
+	RoutesGetIIOValues           = "/api/iio/values"
+	RoutesConfigIIOSensor        = "/api/iio"
+	RoutesGetCounters            = "/api/counter"
+	RoutesConfigCounter          = "/api/counter"
+	RoutesGetCounterStatus       = "/api/counter/status"
+	RoutesResetCounter           = "/api/counter/reset"
 	RoutesGetGPIOs               = "/api/gpio"
 	RoutesConfigGPIO             = "/api/gpio"
+	RoutesGetPID                 = "/api/pid"
+	RoutesCreatePID              = "/api/pid"
+	RoutesConfigPID              = "/api/pid"
+	RoutesEnablePID              = "/api/pid/enable"
+	RoutesGetPIDStatus           = "/api/pid/status"
+	RoutesGetPrograms            = "/api/program"
+	RoutesCreateProgram          = "/api/program"
+	RoutesConfigProgram          = "/api/program"
+	RoutesCommandProgram         = "/api/program/command"
+	RoutesGetProgramStatus       = "/api/program/status"
+	RoutesGetCalibrations        = "/api/calibration"
+	RoutesCreateCalibration      = "/api/calibration"
+	RoutesCommandCalibration     = "/api/calibration/command"
+	RoutesGetCalibrationHistory  = "/api/calibration/history"
 )
 
 func (r *restRouter) routes(e *Embedded) {
 	r.GET(RoutesGetHeaters, r.getHeaters(e))
 	r.PUT(RoutesConfigHeater, r.configHeater(e))
+	r.PUT(RoutesClearHeaterFault, r.clearHeaterFault(e))
+	r.PUT(RoutesSetHeaterPower, r.setHeaterPower(e))
+	r.GET(RoutesGetHeaterStats, r.getHeaterStats(e))
+	r.PUT(RoutesResetHeaterStats, r.resetHeaterStats(e))
+	r.GET(RoutesGetHeaterGroups, r.getHeaterGroups(e))
 	
 	r.GET(RoutesGetOnewireSensors, r.getOnewireSensors(e))
 	r.GET(RoutesGetOnewireTemperatures, r.getOnewireTemperatures(e))
 	r.PUT(RoutesConfigOnewireSensor, r.configOnewireSensor(e))
+	r.GET(RoutesGetOnewireEvents, r.getOnewireEvents(e))
+	r.GET(RoutesGetOnewireAlarms, r.getOnewireAlarms(e))
+	r.PUT(RoutesRestoreOnewireSensor, r.restoreOnewireSensor(e))
+	r.GET(RoutesGetOnewireDevices, r.getOnewireDevices(e))
 	
 	r.GET(RoutesGetPT100Sensors, r.getPTSensors(e))
 	r.GET(RoutesGetPT100Temperatures, r.getPTTemperatures(e))
 	r.PUT(RoutesConfigPT100Sensor, r.configPTSensor(e))
+	r.PUT(RoutesDetectPT100Faults, r.detectPTFaults(e))
+	
+	r.GET(RoutesGetTCSensors, r.getTCSensors(e))
+	r.GET(RoutesGetTCTemperatures, r.getTCTemperatures(e))
+	r.PUT(RoutesConfigTCSensor, r.configTCSensor(e))
+	
+	r.GET(RoutesGetIIOSensors, r.getIIOSensors(e))
+	r.GET(RoutesGetIIOValues, r.getIIOValues(e))
+	r.PUT(RoutesConfigIIOSensor, r.configIIOSensor(e))
+	
+	r.GET(RoutesGetCounters, r.getCounters(e))
+	r.PUT(RoutesConfigCounter, r.configCounter(e))
+	r.GET(RoutesGetCounterStatus, r.getCounterStatus(e))
+	r.PUT(RoutesResetCounter, r.resetCounter(e))
 	
 	r.GET(RoutesGetGPIOs, r.getGPIOS(e))
 	r.PUT(RoutesConfigGPIO, r.configGPIO(e))
+	
+	r.GET(RoutesGetPID, r.getPIDs(e))
+	r.POST(RoutesCreatePID, r.createPID(e))
+	r.PUT(RoutesConfigPID, r.configPID(e))
+	r.PUT(RoutesEnablePID, r.enablePID(e))
+	r.GET(RoutesGetPIDStatus, r.getPIDStatus(e))
+
+	r.GET(RoutesGetPrograms, r.getPrograms(e))
+	r.POST(RoutesCreateProgram, r.createProgram(e))
+	r.PUT(RoutesConfigProgram, r.configProgram(e))
+	r.PUT(RoutesCommandProgram, r.commandProgram(e))
+	r.GET(RoutesGetProgramStatus, r.getProgramStatus(e))
+
+	r.GET(RoutesGetCalibrations, r.getCalibrations(e))
+	r.POST(RoutesCreateCalibration, r.createCalibration(e))
+	r.PUT(RoutesCommandCalibration, r.commandCalibration(e))
+	r.GET(RoutesGetCalibrationHistory, r.getCalibrationHistory(e))
 }
 
 // common respond for whole rest API
@@ -91,6 +166,130 @@ func (r *restRouter) configHeater(e *Embedded) gin.HandlerFunc {
 	}
 }
 
+func (r *restRouter) clearHeaterFault(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// Only ID is used
+		cfg := HeaterConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind HeaterConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesClearHeaterFault,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+		
+		if err := e.Heaters.ClearFault(cfg.ID); err != nil {
+			err := &Error{
+				Title:     "Failed to ClearFault",
+				Detail:    err.Error(),
+				Instance:  RoutesClearHeaterFault,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		s, _ := e.Heaters.ConfigBy(cfg.ID)
+		r.respond(ctx, http.StatusOK, s)
+	}
+}
+
+func (r *restRouter) setHeaterPower(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		pwr := HeaterPower{}
+		if err := ctx.ShouldBind(&pwr); err != nil {
+			err := &Error{
+				Title:     "Failed to bind HeaterPower",
+				Detail:    err.Error(),
+				Instance:  RoutesSetHeaterPower,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+		
+		if err := e.Heaters.PowerPrecise(pwr.ID, pwr.Power); err != nil {
+			err := &Error{
+				Title:     "Failed to SetPower",
+				Detail:    err.Error(),
+				Instance:  RoutesSetHeaterPower,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		s, _ := e.Heaters.PowerBy(pwr.ID)
+		r.respond(ctx, http.StatusOK, s)
+	}
+}
+
+func (r *restRouter) getHeaterStats(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.Heaters.heaters) == 0 {
+			err := &Error{
+				Title:     "Failed to get Stats",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetHeaterStats,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, e.Heaters.Stats())
+	}
+}
+
+func (r *restRouter) getHeaterGroups(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.Heaters.groups) == 0 {
+			err := &Error{
+				Title:     "Failed to get Groups",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetHeaterGroups,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, e.Heaters.Groups())
+	}
+}
+
+func (r *restRouter) resetHeaterStats(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// Only ID is used
+		stats := HeaterStats{}
+		if err := ctx.ShouldBind(&stats); err != nil {
+			err := &Error{
+				Title:     "Failed to bind HeaterStats",
+				Detail:    err.Error(),
+				Instance:  RoutesResetHeaterStats,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+		
+		if err := e.Heaters.ResetStats(stats.ID); err != nil {
+			err := &Error{
+				Title:     "Failed to ResetStats",
+				Detail:    err.Error(),
+				Instance:  RoutesResetHeaterStats,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		s, _ := e.Heaters.StatsBy(stats.ID)
+		r.respond(ctx, http.StatusOK, s)
+	}
+}
+
 func (r *restRouter) getHeaters(e *Embedded) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		var heaters []HeaterConfig
@@ -188,6 +387,119 @@ func (r *restRouter) getOnewireSensors(e *Embedded) gin.HandlerFunc {
 	}
 }
 
+func (r *restRouter) getOnewireEvents(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.DS.buses) == 0 {
+			err := &Error{
+				Title:     "Failed to get Events",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetOnewireEvents,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, e.DS.Events())
+	}
+}
+
+// restoreOnewireSensor loads resolution and alarms of sensor specified by ID from its EEPROM
+func (r *restRouter) restoreOnewireSensor(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if e.DS.sensors == nil {
+			err := &Error{
+				Title:     "Failed to Restore",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesRestoreOnewireSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		cfg := DSSensorConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind DSSensorConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesRestoreOnewireSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.DS.Restore(cfg.ID)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to Restore",
+				Detail:    err.Error(),
+				Instance:  RoutesRestoreOnewireSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) getOnewireAlarms(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !e.DS.alarmsEnabled() {
+			err := &Error{
+				Title:     "Failed to get Alarms",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetOnewireAlarms,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		ids, err := e.DS.Alarms()
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to get Alarms",
+				Detail:    err.Error(),
+				Instance:  RoutesGetOnewireAlarms,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, ids)
+	}
+}
+
+// getOnewireDevices lists all devices on buses, including unsupported ones
+func (r *restRouter) getOnewireDevices(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.DS.buses) == 0 {
+			err := &Error{
+				Title:     "Failed to get Devices",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetOnewireDevices,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		devices, err := e.DS.Devices()
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to get Devices",
+				Detail:    err.Error(),
+				Instance:  RoutesGetOnewireDevices,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, devices)
+	}
+}
+
 // configPTSensor is middleware for configuring specified by ID PTSensor
 func (r *restRouter) configPTSensor(e *Embedded) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -229,6 +541,48 @@ func (r *restRouter) configPTSensor(e *Embedded) gin.HandlerFunc {
 		r.respond(ctx, http.StatusOK, cfg)
 	}
 }
+// detectPTFaults is middleware for running fault detection cycle on specified by ID PTSensor
+func (r *restRouter) detectPTFaults(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if e.PT.sensors == nil {
+			err := &Error{
+				Title:     "Failed to DetectFaults",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesDetectPT100Faults,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		cfg := PTSensorConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind PTSensorConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesDetectPT100Faults,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.PT.DetectFaults(cfg.ID)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to DetectFaults",
+				Detail:    err.Error(),
+				Instance:  RoutesDetectPT100Faults,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
 func (r *restRouter) getPTTemperatures(e *Embedded) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		if e.PT.sensors == nil {
@@ -266,76 +620,606 @@ func (r *restRouter) getPTSensors(e *Embedded) gin.HandlerFunc {
 	}
 }
 
-func (r *restRouter) configGPIO(e *Embedded) gin.HandlerFunc {
+// configTCSensor is middleware for configuring specified by ID TCSensor
+func (r *restRouter) configTCSensor(e *Embedded) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		if len(e.GPIO.io) == 0 {
+		if e.TC.sensors == nil {
 			err := &Error{
-				Title:     "Failed to Config GPIO",
+				Title:     "Failed to Configure",
 				Detail:    ErrNotImplemented.Error(),
-				Instance:  RoutesConfigGPIO,
+				Instance:  RoutesConfigTCSensor,
 				Timestamp: time.Now(),
 			}
 			r.respond(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		
-		cfg := GPIOConfig{}
+
+		cfg := TCSensorConfig{}
 		if err := ctx.ShouldBind(&cfg); err != nil {
 			err := &Error{
-				Title:     "Failed to bind GPIOConfig",
+				Title:     "Failed to bind TCSensorConfig",
 				Detail:    err.Error(),
-				Instance:  RoutesConfigGPIO,
+				Instance:  RoutesConfigTCSensor,
 				Timestamp: time.Now(),
 			}
 			r.respond(ctx, http.StatusBadRequest, err)
 			return
 		}
-		
-		err := e.GPIO.SetConfig(cfg)
+
+		cfg, err := e.TC.SetConfig(cfg)
 		if err != nil {
 			err := &Error{
 				Title:     "Failed to SetConfig",
 				Detail:    err.Error(),
-				Instance:  RoutesConfigGPIO,
+				Instance:  RoutesConfigTCSensor,
 				Timestamp: time.Now(),
 			}
 			r.respond(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		
-		cfg, err = e.GPIO.GetConfig(cfg.ID)
-		if err != nil {
+
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) getTCTemperatures(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if e.TC.sensors == nil {
 			err := &Error{
-				Title:     "Failed to GetConfig",
-				Detail:    err.Error(),
-				Instance:  RoutesConfigGPIO,
+				Title:     "Failed to GetTemperatures",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetTCTemperatures,
 				Timestamp: time.Now(),
 			}
 			r.respond(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		r.respond(ctx, http.StatusOK, cfg)
+		temperatures := e.TC.GetTemperatures()
+		r.respond(ctx, http.StatusOK, temperatures)
 	}
 }
-func (r *restRouter) getGPIOS(e *Embedded) gin.HandlerFunc {
+
+func (r *restRouter) getTCSensors(e *Embedded) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		if len(e.GPIO.io) == 0 {
+		var sensors []TCSensorConfig
+		if e.TC.sensors != nil {
+			sensors = e.TC.GetSensors()
+		}
+		if len(sensors) == 0 {
 			err := &Error{
-				Title:     "Failed to GetGPIO",
+				Title:     "Failed to GetSensors",
 				Detail:    ErrNotImplemented.Error(),
-				Instance:  RoutesConfigGPIO,
+				Instance:  RoutesGetTCSensors,
 				Timestamp: time.Now(),
 			}
 			r.respond(ctx, http.StatusInternalServerError, err)
 			return
 		}
-		
-		gpios, err := e.GPIO.GetConfigAll()
-		if len(gpios) == 0 || err != nil {
-			notImpl := GPIOError{ID: "", Op: "GetConfigAll", Err: ErrNotImplemented.Error()}
-			r.respond(ctx, http.StatusInternalServerError, &notImpl)
+		r.respond(ctx, http.StatusOK, sensors)
+	}
+}
+
+// configIIOSensor is middleware for configuring specified by ID IIOSensor
+func (r *restRouter) configIIOSensor(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if e.IIO.sensors == nil {
+			err := &Error{
+				Title:     "Failed to Configure",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesConfigIIOSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		cfg := IIOSensorConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind IIOSensorConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigIIOSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.IIO.SetConfig(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to SetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigIIOSensor,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) getIIOValues(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if e.IIO.sensors == nil {
+			err := &Error{
+				Title:     "Failed to GetValues",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetIIOValues,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		values := e.IIO.GetValues()
+		r.respond(ctx, http.StatusOK, values)
+	}
+}
+
+func (r *restRouter) getIIOSensors(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var sensors []IIOSensorConfig
+		if e.IIO.sensors != nil {
+			sensors = e.IIO.GetSensors()
+		}
+		if len(sensors) == 0 {
+			err := &Error{
+				Title:     "Failed to GetSensors",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetIIOSensors,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, sensors)
+	}
+}
+
+func (r *restRouter) getCounters(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.Counter.counters) == 0 {
+			err := &Error{
+				Title:     "Failed to Get",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetCounters,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, e.Counter.Get())
+	}
+}
+
+// configCounter is middleware for configuring specified by ID PulseCounter
+func (r *restRouter) configCounter(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.Counter.counters) == 0 {
+			err := &Error{
+				Title:     "Failed to Configure",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesConfigCounter,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		cfg := counter.Config{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind Config",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigCounter,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.Counter.SetConfig(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to SetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigCounter,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) getCounterStatus(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.Counter.counters) == 0 {
+			err := &Error{
+				Title:     "Failed to Status",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesGetCounterStatus,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, e.Counter.Status())
+	}
+}
+
+// resetCounter is middleware for resetting totalizer of specified by ID PulseCounter
+func (r *restRouter) resetCounter(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// Only ID is used
+		status := counter.Status{}
+		if err := ctx.ShouldBind(&status); err != nil {
+			err := &Error{
+				Title:     "Failed to bind Status",
+				Detail:    err.Error(),
+				Instance:  RoutesResetCounter,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		status, err := e.Counter.Reset(status.ID)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to Reset",
+				Detail:    err.Error(),
+				Instance:  RoutesResetCounter,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, status)
+	}
+}
+
+func (r *restRouter) configGPIO(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.GPIO.io) == 0 {
+			err := &Error{
+				Title:     "Failed to Config GPIO",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesConfigGPIO,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		cfg := GPIOConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind GPIOConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigGPIO,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+		
+		err := e.GPIO.SetConfig(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to SetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigGPIO,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		cfg, err = e.GPIO.GetConfig(cfg.ID)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to GetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigGPIO,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+func (r *restRouter) getGPIOS(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if len(e.GPIO.io) == 0 {
+			err := &Error{
+				Title:     "Failed to GetGPIO",
+				Detail:    ErrNotImplemented.Error(),
+				Instance:  RoutesConfigGPIO,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		
+		gpios, err := e.GPIO.GetConfigAll()
+		if len(gpios) == 0 || err != nil {
+			notImpl := GPIOError{ID: "", Op: "GetConfigAll", Err: ErrNotImplemented.Error()}
+			r.respond(ctx, http.StatusInternalServerError, &notImpl)
 			return
 		}
 		r.respond(ctx, http.StatusOK, gpios)
 	}
 }
+
+func (r *restRouter) getPIDs(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.PID.GetConfigs())
+	}
+}
+
+func (r *restRouter) getPIDStatus(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.PID.Status())
+	}
+}
+
+func (r *restRouter) createPID(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cfg := PIDConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind PIDConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesCreatePID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.PID.Create(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to Create",
+				Detail:    err.Error(),
+				Instance:  RoutesCreatePID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) configPID(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cfg := PIDConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind PIDConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigPID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.PID.SetConfig(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to SetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigPID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) enablePID(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ena := PIDEnabled{}
+		if err := ctx.ShouldBind(&ena); err != nil {
+			err := &Error{
+				Title:     "Failed to bind PIDEnabled",
+				Detail:    err.Error(),
+				Instance:  RoutesEnablePID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := e.PID.Enable(ena.ID, ena.Enabled); err != nil {
+			err := &Error{
+				Title:     "Failed to Enable",
+				Detail:    err.Error(),
+				Instance:  RoutesEnablePID,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		cfg, _ := e.PID.GetConfig(ena.ID)
+		r.respond(ctx, http.StatusOK, PIDEnabled{ID: cfg.ID, Enabled: cfg.Enabled})
+	}
+}
+
+func (r *restRouter) getPrograms(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.Program.GetConfigs())
+	}
+}
+
+func (r *restRouter) getProgramStatus(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.Program.Status())
+	}
+}
+
+func (r *restRouter) createProgram(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cfg := ProgramConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind ProgramConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesCreateProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.Program.Create(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to Create",
+				Detail:    err.Error(),
+				Instance:  RoutesCreateProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) configProgram(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cfg := ProgramConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind ProgramConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.Program.SetConfig(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to SetConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesConfigProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) commandProgram(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cmd := ProgramCommand{}
+		if err := ctx.ShouldBind(&cmd); err != nil {
+			err := &Error{
+				Title:     "Failed to bind ProgramCommand",
+				Detail:    err.Error(),
+				Instance:  RoutesCommandProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := e.Program.Command(cmd.ID, cmd.Command); err != nil {
+			err := &Error{
+				Title:     "Failed to Command",
+				Detail:    err.Error(),
+				Instance:  RoutesCommandProgram,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cmd)
+	}
+}
+
+func (r *restRouter) getCalibrations(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.Calibration.Status())
+	}
+}
+
+func (r *restRouter) getCalibrationHistory(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		r.respond(ctx, http.StatusOK, e.Calibration.History())
+	}
+}
+
+func (r *restRouter) createCalibration(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cfg := CalibrationConfig{}
+		if err := ctx.ShouldBind(&cfg); err != nil {
+			err := &Error{
+				Title:     "Failed to bind CalibrationConfig",
+				Detail:    err.Error(),
+				Instance:  RoutesCreateCalibration,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		cfg, err := e.Calibration.Create(cfg)
+		if err != nil {
+			err := &Error{
+				Title:     "Failed to Create",
+				Detail:    err.Error(),
+				Instance:  RoutesCreateCalibration,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cfg)
+	}
+}
+
+func (r *restRouter) commandCalibration(e *Embedded) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		cmd := CalibrationCommand{}
+		if err := ctx.ShouldBind(&cmd); err != nil {
+			err := &Error{
+				Title:     "Failed to bind CalibrationCommand",
+				Detail:    err.Error(),
+				Instance:  RoutesCommandCalibration,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := e.Calibration.Command(cmd.ID, cmd.Command); err != nil {
+			err := &Error{
+				Title:     "Failed to Command",
+				Detail:    err.Error(),
+				Instance:  RoutesCommandCalibration,
+				Timestamp: time.Now(),
+			}
+			r.respond(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		r.respond(ctx, http.StatusOK, cmd)
+	}
+}
@@ -3,10 +3,17 @@ package embedded
 import (
 	"time"
 	
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
+	"github.com/a-clap/embedded/pkg/counter"
 	"github.com/a-clap/embedded/pkg/ds18b20"
 	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
 	"github.com/a-clap/embedded/pkg/gpio"
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/a-clap/embedded/pkg/iio"
+	"github.com/a-clap/embedded/pkg/max31856"
 	"github.com/a-clap/embedded/pkg/max31865"
+	"github.com/a-clap/embedded/pkg/pid"
 )
 
 func gpioConfigToRPC(config *GPIOConfig) *embeddedproto.GPIOConfig {
@@ -30,6 +37,8 @@ func rpcToGPIOConfig(config *embeddedproto.GPIOConfig) GPIOConfig {
 func rpcToDSConfig(elem *embeddedproto.DSConfig) DSSensorConfig {
 	return DSSensorConfig{
 		Enabled: elem.Enabled,
+		Missing: elem.Missing,
+		Persist: elem.Persist,
 		SensorConfig: ds18b20.SensorConfig{
 			Name:         elem.Name,
 			ID:           elem.ID,
@@ -37,6 +46,11 @@ func rpcToDSConfig(elem *embeddedproto.DSConfig) DSSensorConfig {
 			Resolution:   ds18b20.Resolution(elem.Resolution),
 			PollInterval: time.Duration(elem.PollInterval),
 			Samples:      uint(elem.Samples),
+			AlarmLow:     int(elem.AlarmLow),
+			AlarmHigh:    int(elem.AlarmHigh),
+			Parasitic:    elem.Parasitic,
+			Calibration:  rpcToCalibration(elem.Calibration),
+			Filters:      rpcToFilters(elem.Filters),
 		},
 	}
 }
@@ -50,9 +64,72 @@ func dsConfigToRPC(d *DSSensorConfig) *embeddedproto.DSConfig {
 		PollInterval: int32(d.PollInterval),
 		Samples:      uint32(d.Samples),
 		Enabled:      d.Enabled,
+		Missing:      d.Missing,
+		AlarmLow:     int32(d.AlarmLow),
+		AlarmHigh:    int32(d.AlarmHigh),
+		Persist:      d.Persist,
+		Parasitic:    d.Parasitic,
+		Calibration:  calibrationToRPC(d.Calibration),
+		Filters:      filtersToRPC(d.Filters),
 	}
 }
 
+func dsEventsToRPC(events []DSEvent) *embeddedproto.DSEvents {
+	e := make([]*embeddedproto.DSEvent, len(events))
+	for i, event := range events {
+		e[i] = &embeddedproto.DSEvent{
+			ID:          event.ID,
+			Bus:         event.Bus,
+			Type:        event.Type,
+			StampMillis: event.Stamp.UnixMilli(),
+		}
+	}
+	return &embeddedproto.DSEvents{Events: e}
+}
+
+func rpcToDSEvents(r *embeddedproto.DSEvents) []DSEvent {
+	events := make([]DSEvent, len(r.Events))
+	for i, event := range r.Events {
+		events[i] = DSEvent{
+			ID:    event.ID,
+			Bus:   event.Bus,
+			Type:  event.Type,
+			Stamp: time.UnixMilli(event.StampMillis),
+		}
+	}
+	return events
+}
+
+func dsDevicesToRPC(devices []DSDevice) *embeddedproto.DSDevices {
+	d := make([]*embeddedproto.DSDevice, len(devices))
+	for i, dev := range devices {
+		d[i] = &embeddedproto.DSDevice{
+			Bus:       dev.Bus,
+			ID:        dev.ID,
+			Family:    string(dev.Family),
+			Name:      dev.Name,
+			Supported: dev.Supported,
+		}
+	}
+	return &embeddedproto.DSDevices{Devices: d}
+}
+
+func rpcToDSDevices(r *embeddedproto.DSDevices) []DSDevice {
+	devices := make([]DSDevice, len(r.Devices))
+	for i, dev := range r.Devices {
+		devices[i] = DSDevice{
+			Bus: dev.Bus,
+			Device: ds18b20.Device{
+				ID:        dev.ID,
+				Family:    ds18b20.Family(dev.Family),
+				Name:      dev.Name,
+				Supported: dev.Supported,
+			},
+		}
+	}
+	return devices
+}
+
 func rpcToDSTemperature(r *embeddedproto.DSTemperatures) []DSTemperature {
 	temperatures := make([]DSTemperature, len(r.Temps))
 	for i, temp := range r.Temps {
@@ -61,9 +138,11 @@ func rpcToDSTemperature(r *embeddedproto.DSTemperatures) []DSTemperature {
 			readings[j] = ds18b20.Readings{
 				ID:          r.ID,
 				Temperature: float64(r.Temperature),
+				Filtered:    float64(r.Filtered),
 				Average:     float64(r.Average),
 				Stamp:       time.UnixMilli(r.StampMillis),
 				Error:       r.Error,
+				Alarm:       r.Alarm,
 			}
 		}
 		temperatures[i] = DSTemperature{Readings: readings}
@@ -80,9 +159,11 @@ func dsTemperatureToRPC(t []DSTemperature) *embeddedproto.DSTemperatures {
 			readings[j] = &embeddedproto.DSReadings{
 				ID:          r.ID,
 				Temperature: float32(r.Temperature),
+				Filtered:    float32(r.Filtered),
 				Average:     float32(r.Average),
 				StampMillis: r.Stamp.UnixMilli(),
 				Error:       r.Error,
+				Alarm:       r.Alarm,
 			}
 		}
 		temperatures.Temps[i] = &embeddedproto.DSTemperature{Readings: readings}
@@ -94,25 +175,45 @@ func rpcToPTConfig(elem *embeddedproto.PTConfig) PTSensorConfig {
 	return PTSensorConfig{
 		Enabled: elem.Enabled,
 		SensorConfig: max31865.SensorConfig{
-			Name:         elem.Name,
-			ID:           elem.ID,
-			Correction:   float64(elem.Correction),
-			ASyncPoll:    elem.Async,
-			PollInterval: time.Duration(elem.PollInterval),
-			Samples:      uint(elem.Samples),
+			Name:                elem.Name,
+			ID:                  elem.ID,
+			Correction:          float64(elem.Correction),
+			ASyncPoll:           elem.Async,
+			PollInterval:        time.Duration(elem.PollInterval),
+			Samples:             uint(elem.Samples),
+			Calibration:         rpcToCalibration(elem.Calibration),
+			Filters:             rpcToFilters(elem.Filters),
+			Filter:              max31865.Filter(elem.Filter),
+			Mode:                max31865.Mode(elem.Mode),
+			FaultLow:            elem.FaultLow,
+			FaultHigh:           elem.FaultHigh,
+			FaultDetectInterval: time.Duration(elem.FaultDetectInterval),
+		},
+		FaultStatus: max31865.FaultStatus{
+			Fault:     max31865.Fault(elem.Fault),
+			FaultText: elem.FaultText,
 		},
 	}
 }
 
 func ptConfigToRPC(d *PTSensorConfig) *embeddedproto.PTConfig {
 	return &embeddedproto.PTConfig{
-		ID:           d.ID,
-		Name:         d.Name,
-		Correction:   float32(d.Correction),
-		Async:        d.ASyncPoll,
-		PollInterval: int32(d.PollInterval),
-		Samples:      uint32(d.Samples),
-		Enabled:      d.Enabled,
+		ID:                  d.ID,
+		Name:                d.Name,
+		Correction:          float32(d.Correction),
+		Async:               d.ASyncPoll,
+		PollInterval:        int32(d.PollInterval),
+		Samples:             uint32(d.Samples),
+		Enabled:             d.Enabled,
+		Calibration:         calibrationToRPC(d.Calibration),
+		Filters:             filtersToRPC(d.Filters),
+		Filter:              int32(d.Filter),
+		Mode:                string(d.Mode),
+		FaultLow:            d.FaultLow,
+		FaultHigh:           d.FaultHigh,
+		FaultDetectInterval: int64(d.FaultDetectInterval),
+		Fault:               uint32(d.Fault),
+		FaultText:           d.FaultText,
 	}
 }
 
@@ -124,9 +225,16 @@ func rpcToPTTemperature(r *embeddedproto.PTTemperatures) []PTTemperature {
 			readings[j] = max31865.Readings{
 				ID:          r.ID,
 				Temperature: float64(r.Temperature),
+				Filtered:    float64(r.Filtered),
 				Average:     float64(r.Average),
 				Stamp:       time.UnixMilli(r.StampMillis),
 				Error:       r.Error,
+				Code:        uint16(r.Code),
+				Resistance:  r.Resistance,
+				FaultStatus: max31865.FaultStatus{
+					Fault:     max31865.Fault(r.Fault),
+					FaultText: r.FaultText,
+				},
 			}
 		}
 		temperatures[i] = PTTemperature{Readings: readings}
@@ -143,9 +251,14 @@ func ptTemperatureToRPC(t []PTTemperature) *embeddedproto.PTTemperatures {
 			readings[j] = &embeddedproto.PTReadings{
 				ID:          r.ID,
 				Temperature: float32(r.Temperature),
+				Filtered:    float32(r.Filtered),
 				Average:     float32(r.Average),
 				StampMillis: r.Stamp.UnixMilli(),
 				Error:       r.Error,
+				Fault:       uint32(r.Fault),
+				FaultText:   r.FaultText,
+				Code:        uint32(r.Code),
+				Resistance:  r.Resistance,
 			}
 		}
 		temperatures.Temps[i] = &embeddedproto.PTTemperature{Readings: readings}
@@ -153,11 +266,231 @@ func ptTemperatureToRPC(t []PTTemperature) *embeddedproto.PTTemperatures {
 	return temperatures
 }
 
+func rpcToTCConfig(elem *embeddedproto.TCConfig) TCSensorConfig {
+	return TCSensorConfig{
+		Enabled: elem.Enabled,
+		SensorConfig: max31856.SensorConfig{
+			Name:         elem.Name,
+			ID:           elem.ID,
+			Correction:   float64(elem.Correction),
+			ASyncPoll:    elem.Async,
+			PollInterval: time.Duration(elem.PollInterval),
+			Samples:      uint(elem.Samples),
+			Type:         max31856.Type(elem.Type),
+			Filter:       max31856.Filter(elem.Filter),
+			Averaging:    uint(elem.Averaging),
+		},
+		FaultStatus: max31856.FaultStatus{
+			Fault:     max31856.Fault(elem.Fault),
+			FaultText: elem.FaultText,
+		},
+	}
+}
+
+func tcConfigToRPC(d *TCSensorConfig) *embeddedproto.TCConfig {
+	return &embeddedproto.TCConfig{
+		ID:           d.ID,
+		Name:         d.Name,
+		Correction:   float32(d.Correction),
+		Async:        d.ASyncPoll,
+		PollInterval: int32(d.PollInterval),
+		Samples:      uint32(d.Samples),
+		Enabled:      d.Enabled,
+		Type:         string(d.Type),
+		Filter:       int32(d.Filter),
+		Averaging:    uint32(d.Averaging),
+		Fault:        uint32(d.Fault),
+		FaultText:    d.FaultText,
+	}
+}
+
+func rpcToTCTemperature(r *embeddedproto.TCTemperatures) []TCTemperature {
+	temperatures := make([]TCTemperature, len(r.Temps))
+	for i, temp := range r.Temps {
+		readings := make([]max31856.Readings, len(temp.Readings))
+		for j, r := range temp.Readings {
+			readings[j] = max31856.Readings{
+				ID:           r.ID,
+				Temperature:  float64(r.Temperature),
+				Average:      float64(r.Average),
+				ColdJunction: float64(r.ColdJunction),
+				Stamp:        time.UnixMilli(r.StampMillis),
+				Error:        r.Error,
+				FaultStatus: max31856.FaultStatus{
+					Fault:     max31856.Fault(r.Fault),
+					FaultText: r.FaultText,
+				},
+			}
+		}
+		temperatures[i] = TCTemperature{Readings: readings}
+	}
+	return temperatures
+}
+
+func tcTemperatureToRPC(t []TCTemperature) *embeddedproto.TCTemperatures {
+	temperatures := &embeddedproto.TCTemperatures{}
+	temperatures.Temps = make([]*embeddedproto.TCTemperature, len(t))
+	for i, temp := range t {
+		readings := make([]*embeddedproto.TCReadings, len(temp.Readings))
+		for j, r := range temp.Readings {
+			readings[j] = &embeddedproto.TCReadings{
+				ID:           r.ID,
+				Temperature:  float32(r.Temperature),
+				Average:      float32(r.Average),
+				ColdJunction: float32(r.ColdJunction),
+				StampMillis:  r.Stamp.UnixMilli(),
+				Error:        r.Error,
+				Fault:        uint32(r.Fault),
+				FaultText:    r.FaultText,
+			}
+		}
+		temperatures.Temps[i] = &embeddedproto.TCTemperature{Readings: readings}
+	}
+	return temperatures
+}
+
+func rpcToIIOConfig(elem *embeddedproto.IIOConfig) IIOSensorConfig {
+	cfg := IIOSensorConfig{
+		Enabled: elem.Enabled,
+		SensorConfig: iio.SensorConfig{
+			Name:         elem.Name,
+			ID:           elem.ID,
+			PollInterval: time.Duration(elem.PollInterval),
+			Samples:      uint(elem.Samples),
+		},
+	}
+	if elem.Scaling != nil {
+		cfg.Scaling = iio.Scaling{
+			Gain:   elem.Scaling.Gain,
+			Offset: elem.Scaling.Offset,
+			Unit:   elem.Scaling.Unit,
+		}
+	}
+	return cfg
+}
+
+func iioConfigToRPC(d *IIOSensorConfig) *embeddedproto.IIOConfig {
+	return &embeddedproto.IIOConfig{
+		ID:           d.ID,
+		Name:         d.Name,
+		PollInterval: int64(d.PollInterval),
+		Samples:      uint32(d.Samples),
+		Enabled:      d.Enabled,
+		Scaling: &embeddedproto.IIOScaling{
+			Gain:   d.Scaling.Gain,
+			Offset: d.Scaling.Offset,
+			Unit:   d.Scaling.Unit,
+		},
+	}
+}
+
+func rpcToIIOValue(r *embeddedproto.IIOValues) []IIOValue {
+	values := make([]IIOValue, len(r.Values))
+	for i, value := range r.Values {
+		readings := make([]iio.Readings, len(value.Readings))
+		for j, r := range value.Readings {
+			readings[j] = iio.Readings{
+				ID:      r.ID,
+				Raw:     r.Raw,
+				Voltage: r.Voltage,
+				Value:   r.Value,
+				Average: r.Average,
+				Stamp:   time.UnixMilli(r.StampMillis),
+				Error:   r.Error,
+			}
+		}
+		values[i] = IIOValue{Readings: readings}
+	}
+	return values
+}
+
+func iioValueToRPC(v []IIOValue) *embeddedproto.IIOValues {
+	values := &embeddedproto.IIOValues{}
+	values.Values = make([]*embeddedproto.IIOValue, len(v))
+	for i, value := range v {
+		readings := make([]*embeddedproto.IIOReadings, len(value.Readings))
+		for j, r := range value.Readings {
+			readings[j] = &embeddedproto.IIOReadings{
+				ID:          r.ID,
+				Raw:         r.Raw,
+				Voltage:     r.Voltage,
+				Value:       r.Value,
+				Average:     r.Average,
+				StampMillis: r.Stamp.UnixMilli(),
+				Error:       r.Error,
+			}
+		}
+		values.Values[i] = &embeddedproto.IIOValue{Readings: readings}
+	}
+	return values
+}
+
+func rpcToCounterConfig(elem *embeddedproto.CounterConfig) counter.Config {
+	return counter.Config{
+		ID:             elem.ID,
+		Name:           elem.Name,
+		PulsesPerLitre: elem.PulsesPerLitre,
+		Window:         time.Duration(elem.Window),
+	}
+}
+
+func counterConfigToRPC(c *counter.Config) *embeddedproto.CounterConfig {
+	return &embeddedproto.CounterConfig{
+		ID:             c.ID,
+		Name:           c.Name,
+		PulsesPerLitre: c.PulsesPerLitre,
+		Window:         int64(c.Window),
+	}
+}
+
+func rpcToCounterStatus(elem *embeddedproto.CounterStatus) counter.Status {
+	return counter.Status{
+		ID:              elem.ID,
+		Started:         millisToTime(elem.StartedMillis),
+		Count:           elem.Count,
+		LastPulse:       millisToTime(elem.LastPulseMillis),
+		Frequency:       elem.Frequency,
+		Flow:            elem.Flow,
+		Reset:           millisToTime(elem.ResetMillis),
+		CountSinceReset: elem.CountSinceReset,
+		Total:           elem.Total,
+	}
+}
+
+func counterStatusToRPC(s *counter.Status) *embeddedproto.CounterStatus {
+	return &embeddedproto.CounterStatus{
+		ID:              s.ID,
+		StartedMillis:   timeToMillis(s.Started),
+		Count:           s.Count,
+		LastPulseMillis: timeToMillis(s.LastPulse),
+		Frequency:       s.Frequency,
+		Flow:            s.Flow,
+		ResetMillis:     timeToMillis(s.Reset),
+		CountSinceReset: s.CountSinceReset,
+		Total:           s.Total,
+	}
+}
+
 func heaterConfigToRPC(config *HeaterConfig) *embeddedproto.HeaterConfig {
 	return &embeddedproto.HeaterConfig{
 		ID:      config.ID,
 		Enabled: config.Enabled,
 		Power:   uint32(config.Power),
+		Modulation: &embeddedproto.HeaterModulation{
+			Mode:       config.Modulation.Mode,
+			Window:     uint32(config.Modulation.Window),
+			Resolution: uint32(config.Modulation.Resolution),
+		},
+		Fault: &embeddedproto.HeaterFault{
+			LastError:    config.Fault.LastError,
+			Count:        uint32(config.Fault.Count),
+			Consecutive:  uint32(config.Fault.Consecutive),
+			FirstMillis:  timeToMillis(config.Fault.First),
+			LastMillis:   timeToMillis(config.Fault.Last),
+			AutoDisabled: config.Fault.AutoDisabled,
+		},
+		EffectivePower: config.EffectivePower,
+		RampRate:       config.RampRate,
 	}
 }
 
@@ -166,5 +499,474 @@ func rpcToHeaterConfig(config *embeddedproto.HeaterConfig) HeaterConfig {
 		ID:      config.ID,
 		Enabled: config.Enabled,
 		Power:   uint(config.Power),
+		Modulation: heater.ModulationConfig{
+			Mode:       config.GetModulation().GetMode(),
+			Window:     uint(config.GetModulation().GetWindow()),
+			Resolution: uint(config.GetModulation().GetResolution()),
+		},
+		Fault: heater.Fault{
+			LastError:    config.GetFault().GetLastError(),
+			Count:        uint(config.GetFault().GetCount()),
+			Consecutive:  uint(config.GetFault().GetConsecutive()),
+			First:        millisToTime(config.GetFault().GetFirstMillis()),
+			Last:         millisToTime(config.GetFault().GetLastMillis()),
+			AutoDisabled: config.GetFault().GetAutoDisabled(),
+		},
+		EffectivePower: config.GetEffectivePower(),
+		RampRate:       config.GetRampRate(),
+	}
+}
+
+func heaterStatsToRPC(stats *HeaterStats) *embeddedproto.HeaterStats {
+	return &embeddedproto.HeaterStats{
+		ID:                 stats.ID,
+		StartedMillis:      timeToMillis(stats.Started),
+		OnTime:             int64(stats.OnTime),
+		ResetMillis:        timeToMillis(stats.Reset),
+		OnTimeSinceReset:   int64(stats.OnTimeSinceReset),
+		Duty:               stats.Duty,
+		RatedWatts:         stats.RatedWatts,
+		EnergyWh:           stats.EnergyWh,
+		EnergyWhSinceReset: stats.EnergyWhSinceReset,
+	}
+}
+
+func rpcToHeaterStats(stats *embeddedproto.HeaterStats) HeaterStats {
+	return HeaterStats{
+		ID: stats.ID,
+		Stats: heater.Stats{
+			Started:            millisToTime(stats.StartedMillis),
+			OnTime:             time.Duration(stats.OnTime),
+			Reset:              millisToTime(stats.ResetMillis),
+			OnTimeSinceReset:   time.Duration(stats.OnTimeSinceReset),
+			Duty:               stats.Duty,
+			RatedWatts:         stats.RatedWatts,
+			EnergyWh:           stats.EnergyWh,
+			EnergyWhSinceReset: stats.EnergyWhSinceReset,
+		},
+	}
+}
+
+func heaterGroupToRPC(group *HeaterGroupState) *embeddedproto.HeaterGroup {
+	members := make([]*embeddedproto.HeaterGroupMember, len(group.Members))
+	for i, member := range group.Members {
+		members[i] = &embeddedproto.HeaterGroupMember{
+			ID:        member.ID,
+			Requested: member.Requested,
+			Allocated: member.Allocated,
+		}
+	}
+	return &embeddedproto.HeaterGroup{
+		ID:        group.ID,
+		MaxPower:  group.MaxPower,
+		MaxWatts:  group.MaxWatts,
+		Scaling:   group.Scaling,
+		Requested: group.Requested,
+		Allocated: group.Allocated,
+		Limited:   group.Limited,
+		Members:   members,
+	}
+}
+
+func rpcToHeaterGroup(group *embeddedproto.HeaterGroup) HeaterGroupState {
+	members := make([]heater.GroupMember, len(group.Members))
+	for i, member := range group.Members {
+		members[i] = heater.GroupMember{
+			ID:        member.ID,
+			Requested: member.Requested,
+			Allocated: member.Allocated,
+		}
+	}
+	return HeaterGroupState{
+		ID: group.ID,
+		GroupState: heater.GroupState{
+			GroupConfig: heater.GroupConfig{
+				MaxPower: group.MaxPower,
+				MaxWatts: group.MaxWatts,
+				Scaling:  group.Scaling,
+			},
+			Requested: group.Requested,
+			Allocated: group.Allocated,
+			Limited:   group.Limited,
+			Members:   members,
+		},
+	}
+}
+
+// timeToMillis keeps zero time as 0, so it survives conversion back
+func timeToMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func millisToTime(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+func pidConfigToRPC(config *PIDConfig) *embeddedproto.PIDConfig {
+	return &embeddedproto.PIDConfig{
+		ID:         config.ID,
+		HeaterID:   config.HeaterID,
+		SensorID:   config.SensorID,
+		SensorType: config.SensorType,
+		Enabled:    config.Enabled,
+		Interval:   int64(config.Interval),
+		Kp:         float32(config.Kp),
+		Ki:         float32(config.Ki),
+		Kd:         float32(config.Kd),
+		SetPoint:   float32(config.SetPoint),
+		OutputMin:  float32(config.OutputMin),
+		OutputMax:  float32(config.OutputMax),
+		AntiWindup: config.AntiWindup,
+	}
+}
+
+func rpcToPIDConfig(config *embeddedproto.PIDConfig) PIDConfig {
+	return PIDConfig{
+		ID:         config.ID,
+		HeaterID:   config.HeaterID,
+		SensorID:   config.SensorID,
+		SensorType: config.SensorType,
+		Enabled:    config.Enabled,
+		Interval:   time.Duration(config.Interval),
+		Config: pid.Config{
+			Kp:         float64(config.Kp),
+			Ki:         float64(config.Ki),
+			Kd:         float64(config.Kd),
+			SetPoint:   float64(config.SetPoint),
+			OutputMin:  float64(config.OutputMin),
+			OutputMax:  float64(config.OutputMax),
+			AntiWindup: config.AntiWindup,
+		},
+	}
+}
+
+func pidStatusToRPC(status *PIDStatus) *embeddedproto.PIDStatus {
+	return &embeddedproto.PIDStatus{
+		ID:          status.ID,
+		Enabled:     status.Enabled,
+		Temperature: float32(status.Temperature),
+		StampMillis: status.Stamp.UnixMilli(),
+		Fault:       status.Fault,
+		Error:       float32(status.Error),
+		P:           float32(status.P),
+		I:           float32(status.I),
+		D:           float32(status.D),
+		Output:      float32(status.Output),
+	}
+}
+
+func rpcToPIDStatus(status *embeddedproto.PIDStatus) PIDStatus {
+	return PIDStatus{
+		ID:          status.ID,
+		Enabled:     status.Enabled,
+		Temperature: float64(status.Temperature),
+		Stamp:       time.UnixMilli(status.StampMillis),
+		Fault:       status.Fault,
+		Terms: pid.Terms{
+			Error:  float64(status.Error),
+			P:      float64(status.P),
+			I:      float64(status.I),
+			D:      float64(status.D),
+			Output: float64(status.Output),
+		},
+	}
+}
+
+func programConfigToRPC(config *ProgramConfig) *embeddedproto.ProgramConfig {
+	steps := make([]*embeddedproto.ProgramStep, len(config.Steps))
+	for i, step := range config.Steps {
+		steps[i] = &embeddedproto.ProgramStep{
+			Type:   step.Type,
+			Target: step.Target,
+			Rate:   step.Rate,
+			Power:  uint32(step.Power),
+			Hold:   int64(step.Hold),
+		}
+	}
+	return &embeddedproto.ProgramConfig{
+		ID:         config.ID,
+		HeaterID:   config.HeaterID,
+		SensorID:   config.SensorID,
+		SensorType: config.SensorType,
+		Interval:   int64(config.Interval),
+		Tolerance:  config.Tolerance,
+		Steps:      steps,
+		Kp:         config.Kp,
+		Ki:         config.Ki,
+		Kd:         config.Kd,
+		OutputMin:  config.OutputMin,
+		OutputMax:  config.OutputMax,
+		AntiWindup: config.AntiWindup,
+	}
+}
+
+func rpcToProgramConfig(config *embeddedproto.ProgramConfig) ProgramConfig {
+	steps := make([]ProgramStep, len(config.Steps))
+	for i, step := range config.Steps {
+		steps[i] = ProgramStep{
+			Type:   step.Type,
+			Target: step.Target,
+			Rate:   step.Rate,
+			Power:  uint(step.Power),
+			Hold:   time.Duration(step.Hold),
+		}
+	}
+	return ProgramConfig{
+		ID:         config.ID,
+		HeaterID:   config.HeaterID,
+		SensorID:   config.SensorID,
+		SensorType: config.SensorType,
+		Interval:   time.Duration(config.Interval),
+		Tolerance:  config.Tolerance,
+		Steps:      steps,
+		Config: pid.Config{
+			Kp:         config.Kp,
+			Ki:         config.Ki,
+			Kd:         config.Kd,
+			OutputMin:  config.OutputMin,
+			OutputMax:  config.OutputMax,
+			AntiWindup: config.AntiWindup,
+		},
+	}
+}
+
+func programStatusToRPC(status *ProgramStatus) *embeddedproto.ProgramStatus {
+	return &embeddedproto.ProgramStatus{
+		ID:            status.ID,
+		State:         status.State,
+		Step:          int32(status.Step),
+		Holding:       status.Holding,
+		SetPoint:      status.SetPoint,
+		Temperature:   status.Temperature,
+		Power:         uint32(status.Power),
+		StartedMillis: timeToMillis(status.Started),
+		Elapsed:       int64(status.Elapsed),
+		StepElapsed:   int64(status.StepElapsed),
+		Remaining:     int64(status.Remaining),
+		Fault:         status.Fault,
+	}
+}
+
+func rpcToProgramStatus(status *embeddedproto.ProgramStatus) ProgramStatus {
+	return ProgramStatus{
+		ID:          status.ID,
+		State:       status.State,
+		Step:        int(status.Step),
+		Holding:     status.Holding,
+		SetPoint:    status.SetPoint,
+		Temperature: status.Temperature,
+		Power:       uint(status.Power),
+		Started:     millisToTime(status.StartedMillis),
+		Elapsed:     time.Duration(status.Elapsed),
+		StepElapsed: time.Duration(status.StepElapsed),
+		Remaining:   time.Duration(status.Remaining),
+		Fault:       status.Fault,
+	}
+}
+
+func calibrationToRPC(c calibration.Calibration) *embeddedproto.Calibration {
+	points := make([]*embeddedproto.CalibrationPoint, len(c.Points))
+	for i, p := range c.Points {
+		points[i] = &embeddedproto.CalibrationPoint{Raw: p.Raw, Reference: p.Reference}
+	}
+	stamp := int64(0)
+	if !c.Stamp.IsZero() {
+		stamp = c.Stamp.UnixMilli()
+	}
+	return &embeddedproto.Calibration{
+		Model:        string(c.Model),
+		Offset:       c.Offset,
+		Points:       points,
+		Coefficients: c.Coefficients,
+		StampMillis:  stamp,
+	}
+}
+
+func rpcToCalibration(r *embeddedproto.Calibration) calibration.Calibration {
+	if r == nil {
+		return calibration.Calibration{}
+	}
+	c := calibration.Calibration{
+		Model:        calibration.Model(r.Model),
+		Offset:       r.Offset,
+		Coefficients: r.Coefficients,
+	}
+	for _, p := range r.Points {
+		c.Points = append(c.Points, calibration.Point{Raw: p.Raw, Reference: p.Reference})
+	}
+	if r.StampMillis != 0 {
+		c.Stamp = time.UnixMilli(r.StampMillis)
+	}
+	return c
+}
+
+func filtersToRPC(filters []avg.FilterConfig) []*embeddedproto.Filter {
+	if len(filters) == 0 {
+		return nil
+	}
+	r := make([]*embeddedproto.Filter, len(filters))
+	for i, f := range filters {
+		r[i] = &embeddedproto.Filter{
+			Type:             string(f.Type),
+			Alpha:            f.Alpha,
+			Window:           uint32(f.Window),
+			MaxDelta:         f.MaxDelta,
+			MaxRejected:      uint32(f.MaxRejected),
+			ProcessNoise:     f.ProcessNoise,
+			MeasurementNoise: f.MeasurementNoise,
+		}
+	}
+	return r
+}
+
+func rpcToFilters(r []*embeddedproto.Filter) []avg.FilterConfig {
+	if len(r) == 0 {
+		return nil
+	}
+	filters := make([]avg.FilterConfig, len(r))
+	for i, f := range r {
+		filters[i] = avg.FilterConfig{
+			Type:             avg.FilterType(f.Type),
+			Alpha:            f.Alpha,
+			Window:           uint(f.Window),
+			MaxDelta:         f.MaxDelta,
+			MaxRejected:      uint(f.MaxRejected),
+			ProcessNoise:     f.ProcessNoise,
+			MeasurementNoise: f.MeasurementNoise,
+		}
+	}
+	return filters
+}
+
+func calibrationSensorToRPC(s CalibrationSensor) *embeddedproto.CalibrationSensor {
+	return &embeddedproto.CalibrationSensor{ID: s.ID, SensorType: s.SensorType}
+}
+
+func rpcToCalibrationSensor(s *embeddedproto.CalibrationSensor) CalibrationSensor {
+	if s == nil {
+		return CalibrationSensor{}
+	}
+	return CalibrationSensor{ID: s.ID, SensorType: s.SensorType}
+}
+
+func calibrationConfigToRPC(config *CalibrationConfig) *embeddedproto.CalibrationConfig {
+	targets := make([]*embeddedproto.CalibrationSensor, len(config.Targets))
+	for i, target := range config.Targets {
+		targets[i] = calibrationSensorToRPC(target)
+	}
+	return &embeddedproto.CalibrationConfig{
+		ID:        config.ID,
+		Reference: calibrationSensorToRPC(config.Reference),
+		Targets:   targets,
+		Model:     string(config.Model),
+		Degree:    int32(config.Degree),
+		Samples:   uint32(config.Samples),
+		Interval:  int64(config.Interval),
+	}
+}
+
+func rpcToCalibrationConfig(config *embeddedproto.CalibrationConfig) CalibrationConfig {
+	targets := make([]CalibrationSensor, len(config.Targets))
+	for i, target := range config.Targets {
+		targets[i] = rpcToCalibrationSensor(target)
+	}
+	return CalibrationConfig{
+		ID:        config.ID,
+		Reference: rpcToCalibrationSensor(config.Reference),
+		Targets:   targets,
+		Model:     calibration.Model(config.Model),
+		Degree:    int(config.Degree),
+		Samples:   uint(config.Samples),
+		Interval:  time.Duration(config.Interval),
+	}
+}
+
+func calibrationPlateausToRPC(plateaus []CalibrationPlateau) []*embeddedproto.CalibrationPlateau {
+	r := make([]*embeddedproto.CalibrationPlateau, len(plateaus))
+	for i, p := range plateaus {
+		r[i] = &embeddedproto.CalibrationPlateau{
+			Reference:   p.Reference,
+			Raw:         p.Raw,
+			Samples:     uint32(p.Samples),
+			StampMillis: timeToMillis(p.Stamp),
+		}
+	}
+	return r
+}
+
+func rpcToCalibrationPlateaus(plateaus []*embeddedproto.CalibrationPlateau) []CalibrationPlateau {
+	p := make([]CalibrationPlateau, len(plateaus))
+	for i, r := range plateaus {
+		p[i] = CalibrationPlateau{
+			Reference: r.Reference,
+			Raw:       r.Raw,
+			Samples:   uint(r.Samples),
+			Stamp:     millisToTime(r.StampMillis),
+		}
+	}
+	return p
+}
+
+func calibrationStatusToRPC(status *CalibrationStatus) *embeddedproto.CalibrationStatus {
+	preview := make([]*embeddedproto.CalibrationPreview, len(status.Preview))
+	for i, p := range status.Preview {
+		preview[i] = &embeddedproto.CalibrationPreview{
+			Target:      calibrationSensorToRPC(p.Target),
+			Calibration: calibrationToRPC(p.Calibration),
+			MaxError:    p.MaxError,
+			Error:       p.Error,
+		}
+	}
+	return &embeddedproto.CalibrationStatus{
+		ID:       status.ID,
+		State:    status.State,
+		Plateaus: calibrationPlateausToRPC(status.Plateaus),
+		Preview:  preview,
+		Fault:    status.Fault,
+	}
+}
+
+func rpcToCalibrationStatus(status *embeddedproto.CalibrationStatus) CalibrationStatus {
+	preview := make([]CalibrationPreview, len(status.Preview))
+	for i, p := range status.Preview {
+		preview[i] = CalibrationPreview{
+			Target:      rpcToCalibrationSensor(p.Target),
+			Calibration: rpcToCalibration(p.Calibration),
+			MaxError:    p.MaxError,
+			Error:       p.Error,
+		}
+	}
+	return CalibrationStatus{
+		ID:       status.ID,
+		State:    status.State,
+		Plateaus: rpcToCalibrationPlateaus(status.Plateaus),
+		Preview:  preview,
+		Fault:    status.Fault,
+	}
+}
+
+func calibrationRecordToRPC(record *CalibrationRecord) *embeddedproto.CalibrationRecord {
+	return &embeddedproto.CalibrationRecord{
+		Sensor:      calibrationSensorToRPC(record.Sensor),
+		Reference:   calibrationSensorToRPC(record.Reference),
+		Calibration: calibrationToRPC(record.Calibration),
+		Plateaus:    calibrationPlateausToRPC(record.Plateaus),
+		MaxError:    record.MaxError,
+	}
+}
+
+func rpcToCalibrationRecord(record *embeddedproto.CalibrationRecord) CalibrationRecord {
+	return CalibrationRecord{
+		Sensor:      rpcToCalibrationSensor(record.Sensor),
+		Reference:   rpcToCalibrationSensor(record.Reference),
+		Calibration: rpcToCalibration(record.Calibration),
+		Plateaus:    rpcToCalibrationPlateaus(record.Plateaus),
+		MaxError:    record.MaxError,
 	}
 }
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"sync"
+
+	"github.com/a-clap/embedded/pkg/max31856"
+)
+
+type TCSensor interface {
+	ID() string
+	Poll() (err error)
+	Configure(config max31856.SensorConfig) error
+	GetConfig() max31856.SensorConfig
+	Average() float64
+	Temperature() (actual float64, average float64, err error)
+	ColdJunction() (float64, error)
+	GetReadings() []max31856.Readings
+	Fault() max31856.FaultStatus
+	Close() error
+}
+
+type TCError struct {
+	ID  string `json:"ID"`
+	Op  string `json:"op"`
+	Err string `json:"error"`
+}
+
+func (e *TCError) Error() string {
+	if e.Err == "" {
+		return "<nil>"
+	}
+	s := e.Op
+	if e.ID != "" {
+		s += ":" + e.ID
+	}
+	s += ": " + e.Err
+	return s
+}
+
+type TCSensorConfig struct {
+	Enabled bool `json:"enabled"`
+	max31856.SensorConfig
+	// FaultStatus is read-only, it holds faults found by last conversion
+	max31856.FaultStatus
+}
+
+// tcSensor serializes changes of TCSensor, mtx protects TCSensorConfig
+type tcSensor struct {
+	TCSensor
+	mtx sync.Mutex
+	TCSensorConfig
+}
+
+type TCTemperature struct {
+	Readings []max31856.Readings
+}
+
+// TCHandler is responsible for handling thermocouples
+type TCHandler struct {
+	sensors map[string]*tcSensor
+}
+
+func (t *TCHandler) GetTemperatures() []TCTemperature {
+	temps := make([]TCTemperature, 0, len(t.sensors))
+	for _, tc := range t.sensors {
+		if tc.enabled() {
+			tmp := TCTemperature{Readings: tc.GetReadings()}
+			temps = append(temps, tmp)
+		}
+	}
+	return temps
+}
+
+func (t *TCHandler) GetSensors() []TCSensorConfig {
+	sensors := make([]TCSensorConfig, 0, len(t.sensors))
+	for _, tc := range t.sensors {
+		tc.mtx.Lock()
+		sensors = append(sensors, tc.config())
+		tc.mtx.Unlock()
+	}
+	return sensors
+}
+
+func (t *TCHandler) SetConfig(cfg TCSensorConfig) (newCfg TCSensorConfig, err error) {
+	sensor, err := t.sensorBy(cfg.ID)
+	if err != nil {
+		err = &TCError{ID: cfg.ID, Op: "SetConfig.sensorBy", Err: err.Error()}
+		return
+	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+
+	if err = sensor.Configure(cfg.SensorConfig); err != nil {
+		err = &TCError{ID: cfg.ID, Op: "SetConfig.Configure", Err: err.Error()}
+		return
+	}
+
+	if cfg.Enabled != sensor.Enabled {
+		if cfg.Enabled {
+			if err = sensor.Poll(); err != nil {
+				err = &TCError{ID: cfg.ID, Op: "SetConfig.Poll", Err: err.Error()}
+				return
+			}
+		} else {
+			if err = sensor.Close(); err != nil {
+				err = &TCError{ID: cfg.ID, Op: "SetConfig.Close", Err: err.Error()}
+				return
+			}
+		}
+	}
+	sensor.Enabled = cfg.Enabled
+	sensor.SensorConfig = sensor.GetConfig()
+
+	return sensor.config(), nil
+}
+
+func (t *TCHandler) GetConfig(id string) (TCSensorConfig, error) {
+	sensor, err := t.sensorBy(id)
+	if err != nil {
+		return TCSensorConfig{}, &TCError{ID: id, Op: "GetConfig.sensorBy", Err: err.Error()}
+	}
+	sensor.mtx.Lock()
+	defer sensor.mtx.Unlock()
+	return sensor.config(), nil
+}
+
+func (t *TCHandler) sensorBy(id string) (*tcSensor, error) {
+	maybeSensor, ok := t.sensors[id]
+	if !ok {
+		return nil, ErrNoSuchID
+	}
+	return maybeSensor, nil
+}
+
+func (t *TCHandler) Open() {
+}
+
+func (t *TCHandler) Close() []error {
+	var errs []error
+	for name, sensor := range t.sensors {
+		sensor.mtx.Lock()
+		if sensor.Enabled {
+			sensor.Enabled = false
+			if err := sensor.Close(); err != nil {
+				err = &TCError{ID: name, Op: "Close", Err: err.Error()}
+				errs = append(errs, err)
+			}
+		}
+		sensor.mtx.Unlock()
+	}
+	return errs
+}
+
+// config returns copy of config with current faults, s.mtx must be locked
+func (s *tcSensor) config() TCSensorConfig {
+	cfg := s.TCSensorConfig
+	cfg.FaultStatus = s.Fault()
+	return cfg
+}
+
+// enabled returns true, if sensor is polling
+func (s *tcSensor) enabled() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.Enabled
+}
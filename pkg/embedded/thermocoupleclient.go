@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded/embeddedproto"
+	"github.com/a-clap/embedded/pkg/restclient"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type TCClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+func NewTCClient(addr string, timeout time.Duration) *TCClient {
+	return &TCClient{addr: addr, timeout: timeout}
+}
+
+func (t *TCClient) Get() ([]TCSensorConfig, error) {
+	return restclient.Get[[]TCSensorConfig, *Error](t.addr+RoutesGetTCSensors, t.timeout)
+}
+
+func (t *TCClient) Configure(setConfig TCSensorConfig) (TCSensorConfig, error) {
+	return restclient.Put[TCSensorConfig, *Error](t.addr+RoutesConfigTCSensor, t.timeout, setConfig)
+}
+
+func (t *TCClient) Temperatures() ([]TCTemperature, error) {
+	return restclient.Get[[]TCTemperature, *Error](t.addr+RoutesGetTCTemperatures, t.timeout)
+}
+
+type TCRPCClient struct {
+	timeout time.Duration
+	conn    *grpc.ClientConn
+	client  embeddedproto.TCClient
+}
+
+func NewTCRPCClient(addr string, timeout time.Duration) (*TCRPCClient, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &TCRPCClient{timeout: timeout, conn: conn, client: embeddedproto.NewTCClient(conn)}, nil
+}
+
+func (g *TCRPCClient) Get() ([]TCSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.TCGet(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	confs := make([]TCSensorConfig, len(got.Configs))
+	for i, elem := range got.Configs {
+		confs[i] = rpcToTCConfig(elem)
+	}
+	return confs, nil
+}
+
+func (g *TCRPCClient) Configure(setConfig TCSensorConfig) (TCSensorConfig, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	set := tcConfigToRPC(&setConfig)
+	got, err := g.client.TCConfigure(ctx, set)
+	if err != nil {
+		return TCSensorConfig{}, err
+	}
+	return rpcToTCConfig(got), nil
+}
+
+func (g *TCRPCClient) Temperatures() ([]TCTemperature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+	got, err := g.client.TCGetTemperatures(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return rpcToTCTemperature(got), nil
+}
+
+func (g *TCRPCClient) Close() {
+	_ = g.conn.Close()
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embedded_test
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/embedded"
+	"github.com/a-clap/embedded/pkg/max31856"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TCClientSuite struct {
+	suite.Suite
+}
+
+// TCMock implements embedded.TCSensor
+type TCMock struct {
+	mock.Mock
+}
+
+func TestTCClient(t *testing.T) {
+	suite.Run(t, new(TCClientSuite))
+}
+
+func (p *TCClientSuite) SetupTest() {
+	gin.DefaultWriter = io.Discard
+}
+
+func (p *TCClientSuite) Test_Temperatures() {
+	t := p.Require()
+
+	args := []struct {
+		cfg      embedded.TCSensorConfig
+		readings []max31856.Readings
+	}{
+		{
+			cfg: embedded.TCSensorConfig{
+				SensorConfig: max31856.SensorConfig{
+					ID:           "tc",
+					Correction:   1.0,
+					PollInterval: 100,
+					Samples:      13,
+					Type:         max31856.TypeK,
+				},
+			},
+			readings: []max31856.Readings{
+				{
+					ID:           "tc",
+					Temperature:  250.5,
+					Average:      249,
+					ColdJunction: 21.25,
+				},
+			},
+		}, {
+			cfg: embedded.TCSensorConfig{
+				SensorConfig: max31856.SensorConfig{
+					ID:           "tc 2",
+					PollInterval: 101,
+					Samples:      15,
+					Type:         max31856.TypeJ,
+				},
+			},
+			readings: []max31856.Readings{
+				{
+					ID:          "tc 2",
+					Error:       "thermocouple fault",
+					FaultStatus: max31856.FaultStatus{Fault: max31856.FaultOpen, FaultText: "Open thermocouple"},
+				},
+			},
+		},
+	}
+
+	var sensors []embedded.TCSensor
+	var readings []embedded.TCTemperature
+	for _, elem := range args {
+		m := new(TCMock)
+		m.On("Fault").Return(max31856.FaultStatus{})
+		m.On("ID").Return(elem.cfg.ID)
+		m.On("GetConfig").Return(elem.cfg.SensorConfig)
+		m.On("GetReadings").Return(elem.readings)
+		m.On("Configure", mock.Anything).Return(nil)
+		m.On("Poll").Return(nil)
+
+		readings = append(readings, embedded.TCTemperature{Readings: elem.readings})
+		sensors = append(sensors, m)
+	}
+
+	h, _ := embedded.NewRest("", embedded.WithTC(sensors))
+	// enable sensor, so we can get temperatures
+	for _, elem := range args {
+		cfg, err := h.TC.GetConfig(elem.cfg.ID)
+		t.Nil(err)
+		cfg.Enabled = true
+		_, err = h.TC.SetConfig(cfg)
+		t.Nil(err)
+	}
+
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	tc := embedded.NewTCClient(srv.URL, 1*time.Second)
+	s, err := tc.Temperatures()
+	t.Nil(err)
+	t.ElementsMatch(readings, s)
+}
+
+func (p *TCClientSuite) Test_Configure() {
+	t := p.Require()
+	cfg := embedded.TCSensorConfig{
+		SensorConfig: max31856.SensorConfig{
+			ID:           "tc",
+			PollInterval: 100,
+			Samples:      10,
+			Type:         max31856.TypeK,
+			Filter:       max31856.Filter50Hz,
+			Averaging:    1,
+		},
+	}
+	fault := max31856.FaultStatus{Fault: max31856.FaultTCRange, FaultText: "Thermocouple temperature out of range"}
+
+	m := new(TCMock)
+	m.On("Fault").Return(fault)
+	m.On("ID").Return(cfg.ID)
+	m.On("GetConfig").Return(cfg.SensorConfig).Once()
+
+	h, _ := embedded.NewRest("", embedded.WithTC([]embedded.TCSensor{m}))
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	tc := embedded.NewTCClient(srv.URL, 1*time.Second)
+	s, err := tc.Get()
+	t.Nil(err)
+	cfg.FaultStatus = fault
+	t.Equal([]embedded.TCSensorConfig{cfg}, s)
+
+	// Expected error - sensor doesn't exist
+	_, err = tc.Configure(embedded.TCSensorConfig{})
+	t.ErrorContains(err, embedded.ErrNoSuchID.Error())
+	t.ErrorContains(err, embedded.RoutesConfigTCSensor)
+
+	errSet := errors.New("hello world")
+	m.On("Configure", mock.Anything).Return(errSet).Once()
+	_, err = tc.Configure(cfg)
+	t.ErrorContains(err, errSet.Error())
+
+	// All good now
+	cfg.Type = max31856.TypeT
+	cfg.Averaging = 8
+	m.On("Configure", cfg.SensorConfig).Return(nil).Once()
+	m.On("GetConfig").Return(cfg.SensorConfig).Once()
+	got, err := tc.Configure(cfg)
+	t.Nil(err)
+	t.Equal(cfg, got)
+}
+
+func (p *TCClientSuite) Test_NotImplemented() {
+	t := p.Require()
+	h, _ := embedded.NewRest("")
+	srv := httptest.NewServer(h.Router)
+	defer srv.Close()
+
+	tc := embedded.NewTCClient(srv.URL, 1*time.Second)
+
+	s, err := tc.Get()
+	t.Nil(s)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetTCSensors)
+
+	_, err = tc.Configure(embedded.TCSensorConfig{})
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesConfigTCSensor)
+
+	temps, err := tc.Temperatures()
+	t.Nil(temps)
+	t.ErrorContains(err, embedded.ErrNotImplemented.Error())
+	t.ErrorContains(err, embedded.RoutesGetTCTemperatures)
+}
+
+func (m *TCMock) ID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *TCMock) Poll() (err error) {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *TCMock) Configure(config max31856.SensorConfig) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
+
+func (m *TCMock) GetConfig() max31856.SensorConfig {
+	args := m.Called()
+	return args.Get(0).(max31856.SensorConfig)
+}
+
+func (m *TCMock) Average() float64 {
+	args := m.Called()
+	return args.Get(0).(float64)
+}
+
+func (m *TCMock) Temperature() (actual float64, average float64, err error) {
+	args := m.Called()
+	return args.Get(0).(float64), args.Get(1).(float64), args.Error(2)
+}
+
+func (m *TCMock) ColdJunction() (float64, error) {
+	args := m.Called()
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func (m *TCMock) GetReadings() []max31856.Readings {
+	args := m.Called()
+	return args.Get(0).([]max31856.Readings)
+}
+
+func (m *TCMock) Fault() max31856.FaultStatus {
+	args := m.Called()
+	return args.Get(0).(max31856.FaultStatus)
+}
+
+func (m *TCMock) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embeddedmock
+
+import (
+	"time"
+
+	"github.com/a-clap/embedded/pkg/counter"
+)
+
+// Counter simulates flow meter with pulses at constant frequency
+type Counter struct {
+	*counter.Counter
+	last time.Time
+}
+
+func NewCounter(id string) *Counter {
+	c, _ := counter.New(counter.WithID(id))
+	return &Counter{Counter: c, last: time.Now()}
+}
+
+func (c *Counter) Status() counter.Status {
+	// 15 pulses per second is 2 l/min with default K-factor
+	const period = time.Second / 15
+
+	now := time.Now()
+	for ; c.last.Add(period).Before(now); c.last = c.last.Add(period) {
+		c.Pulse(c.last.Add(period))
+	}
+	return c.Counter.Status()
+}
@@ -19,6 +19,7 @@ type DS struct {
 	cfg     ds18b20.SensorConfig
 	average *avg.Avg
 	r       ds18b20.Readings
+	eeprom  ds18b20.SensorConfig
 }
 
 func NewDS(bus, id string) *DS {
@@ -37,6 +38,7 @@ func NewDS(bus, id string) *DS {
 		average: nil,
 	}
 	d.average = avg.New(10)
+	d.eeprom = d.cfg
 	return d
 }
 func (d *DS) ID() string {
@@ -68,6 +70,7 @@ func (d *DS) GetReadings() []ds18b20.Readings {
 		d.r = ds18b20.Readings{
 			ID:          d.id,
 			Temperature: t,
+			Filtered:    t,
 			Average:     d.Average(),
 			Stamp:       time.Now(),
 			Error:       "",
@@ -77,6 +80,10 @@ func (d *DS) GetReadings() []ds18b20.Readings {
 	return nil
 }
 
+func (d *DS) Latest() ds18b20.Readings {
+	return d.r
+}
+
 func (d *DS) Average() float64 {
 	return d.average.Average()
 }
@@ -91,6 +98,17 @@ func (d *DS) GetConfig() ds18b20.SensorConfig {
 	return d.cfg
 }
 
+func (d *DS) Save() error {
+	d.eeprom = d.cfg
+	return nil
+}
+
+func (d *DS) Restore() error {
+	d.cfg.Resolution = d.eeprom.Resolution
+	d.cfg.AlarmLow, d.cfg.AlarmHigh = d.eeprom.AlarmLow, d.eeprom.AlarmHigh
+	return nil
+}
+
 func (d *DS) Close() {
 	d.polling = false
 }
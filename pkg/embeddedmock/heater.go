@@ -5,15 +5,23 @@
 
 package embeddedmock
 
+import (
+	"math"
+
+	"github.com/a-clap/embedded/pkg/heater"
+)
+
 type Heater struct {
-	enabled bool
-	pwr     uint
+	enabled    bool
+	pwr        float64
+	modulation heater.ModulationConfig
 }
 
 func NewHeater() *Heater {
 	return &Heater{
-		enabled: false,
-		pwr:     0,
+		enabled:    false,
+		pwr:        0,
+		modulation: heater.ModulationConfig{Mode: heater.ModulationBurst, Window: heater.DefaultWindow, Resolution: heater.DefaultResolution},
 	}
 }
 
@@ -26,6 +34,11 @@ func (h *Heater) Disable() {
 }
 
 func (h *Heater) SetPower(pwr uint) error {
+	h.pwr = float64(pwr)
+	return nil
+}
+
+func (h *Heater) SetPowerPrecise(pwr float64) error {
 	h.pwr = pwr
 	return nil
 }
@@ -35,5 +48,43 @@ func (h *Heater) Enabled() bool {
 }
 
 func (h *Heater) Power() uint {
+	return uint(math.Round(h.pwr))
+}
+
+func (h *Heater) PowerPrecise() float64 {
+	return h.pwr
+}
+
+func (h *Heater) Modulation() heater.ModulationConfig {
+	return h.modulation
+}
+
+func (h *Heater) SetModulation(cfg heater.ModulationConfig) error {
+	h.modulation = cfg
+	return nil
+}
+
+func (h *Heater) Fault() heater.Fault {
+	return heater.Fault{}
+}
+
+func (h *Heater) ClearFault() {
+}
+
+func (h *Heater) Stats() heater.Stats {
+	return heater.Stats{}
+}
+
+func (h *Heater) ResetStats() {
+}
+
+func (h *Heater) EffectivePower() float64 {
+	if !h.enabled {
+		return 0
+	}
 	return h.pwr
 }
+
+func (h *Heater) RampRate() float64 {
+	return 0
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embeddedmock
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/iio"
+)
+
+type IIO struct {
+	id      string
+	cfg     iio.SensorConfig
+	polling bool
+	r       iio.Readings
+	average *avg.Avg
+}
+
+func NewIIO(id string) *IIO {
+	i := &IIO{
+		id: id,
+		cfg: iio.SensorConfig{
+			Name:         id,
+			ID:           id,
+			PollInterval: 0,
+			Samples:      10,
+			Scaling:      iio.Volts,
+		},
+		polling: false,
+		r:       iio.Readings{},
+		average: nil,
+	}
+	i.average = avg.New(i.cfg.Samples)
+	return i
+}
+
+func (i *IIO) ID() string {
+	return i.id
+}
+
+func (i *IIO) Poll() error {
+	if i.polling {
+		return errors.New("already polling")
+	}
+	i.polling = true
+	return nil
+}
+
+func (i *IIO) Configure(config iio.SensorConfig) error {
+	i.cfg = config
+	i.average.Resize(i.cfg.Samples)
+	return nil
+}
+
+func (i *IIO) GetConfig() iio.SensorConfig {
+	return i.cfg
+}
+
+func (i *IIO) Average() float64 {
+	return i.average.Average()
+}
+
+func (i *IIO) Value() (actual float64, average float64, err error) {
+	return i.r.Value, i.Average(), nil
+}
+
+func (i *IIO) GetReadings() []iio.Readings {
+
+	const min = 2.0
+	const max = 2.1
+
+	if i.polling {
+		v := min + rand.Float64()*(max-min)
+		value := i.cfg.Scaling.Apply(v)
+
+		i.average.Add(value)
+
+		i.r = iio.Readings{
+			ID:      i.id,
+			Raw:     int64(v * 16000),
+			Voltage: v,
+			Value:   value,
+			Average: i.Average(),
+			Stamp:   time.Now(),
+			Error:   "",
+		}
+		return []iio.Readings{i.r}
+	}
+	return nil
+}
+
+func (i *IIO) Close() error {
+	i.polling = false
+	return nil
+}
@@ -84,6 +84,7 @@ func (p *PT) GetReadings() []max31865.Readings {
 		p.r = max31865.Readings{
 			ID:          p.id,
 			Temperature: t,
+			Filtered:    t,
 			Average:     p.Average(),
 			Stamp:       time.Now(),
 			Error:       "",
@@ -93,6 +94,18 @@ func (p *PT) GetReadings() []max31865.Readings {
 	return nil
 }
 
+func (p *PT) Latest() max31865.Readings {
+	return p.r
+}
+
+func (p *PT) DetectFaults() (max31865.FaultStatus, error) {
+	return max31865.FaultStatus{}, nil
+}
+
+func (p *PT) Fault() max31865.FaultStatus {
+	return max31865.FaultStatus{}
+}
+
 func (p *PT) Close() error {
 	p.polling = false
 	return nil
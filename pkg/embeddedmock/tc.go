@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package embeddedmock
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/max31856"
+)
+
+type TC struct {
+	id      string
+	cfg     max31856.SensorConfig
+	polling bool
+	r       max31856.Readings
+	average *avg.Avg
+}
+
+func NewTC(id string) *TC {
+	tc := &TC{
+		id: id,
+		cfg: max31856.SensorConfig{
+			ID:           id,
+			Correction:   0,
+			ASyncPoll:    false,
+			PollInterval: 0,
+			Samples:      10,
+			Type:         max31856.TypeK,
+			Filter:       max31856.Filter50Hz,
+			Averaging:    1,
+		},
+		polling: false,
+		r:       max31856.Readings{},
+		average: nil,
+	}
+	tc.average = avg.New(tc.cfg.Samples)
+	return tc
+}
+
+func (t *TC) ID() string {
+	return t.id
+}
+
+func (t *TC) Poll() (err error) {
+	if t.polling {
+		return errors.New("already polling")
+	}
+	t.polling = true
+	return nil
+}
+
+func (t *TC) Configure(config max31856.SensorConfig) error {
+	t.cfg = config
+	t.average.Resize(t.cfg.Samples)
+	return nil
+}
+
+func (t *TC) GetConfig() max31856.SensorConfig {
+	return t.cfg
+}
+
+func (t *TC) Average() float64 {
+	return t.average.Average()
+}
+
+func (t *TC) Temperature() (actual float64, average float64, err error) {
+	return t.r.Temperature, t.Average(), nil
+}
+
+func (t *TC) ColdJunction() (float64, error) {
+	return t.r.ColdJunction, nil
+}
+
+func (t *TC) GetReadings() []max31856.Readings {
+
+	const min = 250.0
+	const max = 252.0
+
+	if t.polling {
+		tmp := min + rand.Float64()*(max-min)
+		tmp += t.cfg.Correction
+
+		t.average.Add(tmp)
+
+		t.r = max31856.Readings{
+			ID:           t.id,
+			Temperature:  tmp,
+			Average:      t.Average(),
+			ColdJunction: 25.0,
+			Stamp:        time.Now(),
+			Error:        "",
+		}
+		return []max31856.Readings{t.r}
+	}
+	return nil
+}
+
+func (t *TC) Fault() max31856.FaultStatus {
+	return max31856.FaultStatus{}
+}
+
+func (t *TC) Close() error {
+	t.polling = false
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTooManyFailures = errors.New("too many consecutive failures, heater disabled")
+)
+
+// Fault describes failures of Heater since last ClearFault
+type Fault struct {
+	LastError    string    `json:"last_error"`
+	Count        uint      `json:"count"`
+	Consecutive  uint      `json:"consecutive"`
+	First        time.Time `json:"first"`
+	Last         time.Time `json:"last"`
+	AutoDisabled bool      `json:"auto_disabled"`
+}
+
+type faultState struct {
+	mtx         sync.Mutex
+	fault       Fault
+	maxFailures uint
+}
+
+// failure records error, returns true if heater should be disabled
+func (f *faultState) failure(err error) bool {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	now := time.Now()
+	if f.fault.Count == 0 {
+		f.fault.First = now
+	}
+	f.fault.Last = now
+	f.fault.Count++
+	f.fault.Consecutive++
+	f.fault.LastError = err.Error()
+
+	if f.maxFailures > 0 && f.fault.Consecutive >= f.maxFailures {
+		f.fault.AutoDisabled = true
+		return true
+	}
+	return false
+}
+
+func (f *faultState) success() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.fault.Consecutive = 0
+}
+
+func (f *faultState) get() Fault {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.fault
+}
+
+func (f *faultState) clear() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.fault = Fault{}
+}
+
+// Fault returns failures recorded since last ClearFault
+func (h *Heater) Fault() Fault {
+	return h.fault.get()
+}
+
+// ClearFault resets recorded failures
+func (h *Heater) ClearFault() {
+	h.fault.clear()
+}
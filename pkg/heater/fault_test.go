@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FaultSuite struct {
+	suite.Suite
+	heating  *HeatingMock
+	ticker   *TickerMock
+	tickerCh chan time.Time
+}
+
+func TestFaultSuite(t *testing.T) {
+	suite.Run(t, new(FaultSuite))
+}
+
+func (t *FaultSuite) SetupTest() {
+	t.heating = new(HeatingMock)
+	t.ticker = new(TickerMock)
+	t.tickerCh = make(chan time.Time)
+
+	t.heating.On("Open").Return(nil)
+	t.ticker.On("Start", mock.Anything)
+	t.ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(t.tickerCh))
+	t.ticker.On("Stop", mock.Anything)
+}
+
+// tick waits until loop handles tick
+func (t *FaultSuite) tick() {
+	t.tickerCh <- time.Now()
+	<-time.After(time.Millisecond)
+}
+
+func (t *FaultSuite) TestFault_Recorded() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker))
+	r.Nil(err)
+	r.Nil(h.SetPower(100))
+	r.Equal(heater.Fault{}, h.Fault())
+
+	errCh := make(chan error, 10)
+	h.Enable(errCh)
+
+	before := time.Now()
+	t.heating.On("Set", true).Return(io.ErrClosedPipe).Twice()
+	t.tick()
+	t.tick()
+
+	fault := h.Fault()
+	r.EqualValues(2, fault.Count)
+	r.EqualValues(2, fault.Consecutive)
+	r.Contains(fault.LastError, io.ErrClosedPipe.Error())
+	r.False(fault.AutoDisabled)
+	r.True(fault.First.After(before))
+	r.False(fault.Last.Before(fault.First))
+
+	// Successful write resets only consecutive failures
+	t.heating.On("Set", mock.Anything).Return(nil)
+	t.tick()
+	fault = h.Fault()
+	r.EqualValues(2, fault.Count)
+	r.EqualValues(0, fault.Consecutive)
+
+	h.Disable()
+	r.False(h.Enabled())
+	r.Len(errCh, 2)
+
+	// Channel belongs to caller, so it must stay open
+	select {
+	case _, ok := <-errCh:
+		r.True(ok)
+	default:
+		r.Fail("errors should be there")
+	}
+
+	h.ClearFault()
+	r.Equal(heater.Fault{}, h.Fault())
+}
+
+func (t *FaultSuite) TestFault_AutoDisable() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithMaxFailures(3))
+	r.Nil(err)
+	r.Nil(h.SetPower(100))
+
+	errCh := make(chan error, 10)
+	h.Enable(errCh)
+
+	t.heating.On("Set", true).Return(io.ErrClosedPipe)
+	t.heating.On("Set", false).Return(nil)
+	for i := 0; i < 3; i++ {
+		t.tick()
+	}
+
+	r.False(h.Enabled())
+	fault := h.Fault()
+	r.True(fault.AutoDisabled)
+	r.EqualValues(3, fault.Consecutive)
+
+	var last error
+	for len(errCh) > 0 {
+		last = <-errCh
+	}
+	r.ErrorIs(last, heater.ErrTooManyFailures)
+	t.heating.AssertCalled(t.T(), "Set", false)
+
+	// Disable on auto disabled heater is no-op, Heater can be enabled again
+	h.Disable()
+	h.ClearFault()
+	t.heating.On("Set", true).Unset()
+	t.heating.On("Set", true).Return(nil)
+	h.Enable(errCh)
+	t.tick()
+	r.True(h.Enabled())
+	r.Equal(heater.Fault{}, h.Fault())
+	h.Disable()
+}
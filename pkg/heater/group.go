@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Available scaling of requested powers, when Group budget is exceeded
+const (
+	// ScalingProportional scales down powers of all members by the same factor
+	ScalingProportional = "proportional"
+	// ScalingPriority serves members in order of Add, the last ones are limited first
+	ScalingPriority = "priority"
+)
+
+var (
+	ErrInvalidBudget  = errors.New("exactly one of max power and max watts must be greater than 0")
+	ErrUnknownScaling = errors.New("unknown scaling")
+	ErrNoRatedWatts   = errors.New("max watts requires rated watts of heater")
+	ErrAlreadyInGroup = errors.New("heater already belongs to group")
+)
+
+// GroupConfig describes budget shared by heaters:
+// - MaxPower is limit of sum of powers in %, e.g. 200 for two heaters at full power,
+// - MaxWatts is limit of sum of powers in W, each heater must have RatedWatts.
+type GroupConfig struct {
+	MaxPower float64 `json:"max_power" mapstructure:"max_power"`
+	MaxWatts float64 `json:"max_watts" mapstructure:"max_watts"`
+	Scaling  string  `json:"scaling" mapstructure:"scaling"`
+}
+
+// GroupMember reports requested and allocated power of Heater in %
+type GroupMember struct {
+	ID        string  `json:"id"`
+	Requested float64 `json:"requested"`
+	Allocated float64 `json:"allocated"`
+}
+
+// GroupState reports usage of budget, Requested and Allocated are in units of budget (% or W).
+// Only enabled heaters take part in budget.
+type GroupState struct {
+	GroupConfig
+	Requested float64       `json:"requested"`
+	Allocated float64       `json:"allocated"`
+	Limited   bool          `json:"limited"`
+	Members   []GroupMember `json:"members"`
+}
+
+// Group limits total power of its members. Members are limited inside Heater loop,
+// so it works regardless of what sets power of Heater.
+type Group struct {
+	mtx     sync.Mutex
+	cfg     GroupConfig
+	ids     []string
+	members []*Heater
+}
+
+// NewGroup creates Group with empty list of members
+func NewGroup(cfg GroupConfig) (*Group, error) {
+	if (cfg.MaxPower > 0) == (cfg.MaxWatts > 0) {
+		return nil, fmt.Errorf("NewGroup {MaxPower: %v, MaxWatts: %v}: %w", cfg.MaxPower, cfg.MaxWatts, ErrInvalidBudget)
+	}
+	if cfg.Scaling == "" {
+		cfg.Scaling = ScalingProportional
+	}
+	if cfg.Scaling != ScalingProportional && cfg.Scaling != ScalingPriority {
+		return nil, fmt.Errorf("NewGroup {Scaling: %v}: %w", cfg.Scaling, ErrUnknownScaling)
+	}
+	return &Group{cfg: cfg}, nil
+}
+
+// Add appends Heater to Group, order of Add is priority of members. Heater must be disabled.
+func (g *Group) Add(id string, h *Heater) error {
+	h.ctl.Lock()
+	defer h.ctl.Unlock()
+	if h.Enabled() {
+		return fmt.Errorf("Add {ID: %v}: %w", id, ErrHeaterEnabled)
+	}
+	if h.group != nil {
+		return fmt.Errorf("Add {ID: %v}: %w", id, ErrAlreadyInGroup)
+	}
+	if g.cfg.MaxWatts > 0 && h.RatedWatts() <= 0 {
+		return fmt.Errorf("Add {ID: %v}: %w", id, ErrNoRatedWatts)
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.ids = append(g.ids, id)
+	g.members = append(g.members, h)
+	h.group = g
+	return nil
+}
+
+// Config returns GroupConfig with defaults applied
+func (g *Group) Config() GroupConfig {
+	return g.cfg
+}
+
+// State returns current allocation of budget
+func (g *Group) State() GroupState {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	requested, allocated := g.allocate()
+	state := GroupState{
+		GroupConfig: g.cfg,
+		Members:     make([]GroupMember, len(g.members)),
+	}
+	for i, h := range g.members {
+		state.Members[i] = GroupMember{ID: g.ids[i], Requested: requested[i], Allocated: allocated[i]}
+		if h.Enabled() {
+			state.Requested += requested[i] * g.weight(h)
+			state.Allocated += allocated[i] * g.weight(h)
+		}
+	}
+	state.Limited = state.Allocated < state.Requested
+	return state
+}
+
+// allowed returns power, which Heater is allowed to use right now
+func (g *Group) allowed(h *Heater) float64 {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	_, allocated := g.allocate()
+	for i, member := range g.members {
+		if member == h {
+			return allocated[i]
+		}
+	}
+	return h.PowerPrecise()
+}
+
+// phase returns position in window of Heater, so windows of members are spread evenly
+func (g *Group) phase(h *Heater, window uint, now time.Time) uint {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	slot := uint(0)
+	for i, member := range g.members {
+		if member == h {
+			slot = uint(i)
+			break
+		}
+	}
+	// Ticks counted since epoch are common reference for all members
+	global := uint(now.UnixNano()/int64(tickPeriod)) % window
+	offset := (slot * window / uint(len(g.members))) % window
+	return (global + window - offset) % window
+}
+
+// weight converts power in % to units of budget
+func (g *Group) weight(h *Heater) float64 {
+	if g.cfg.MaxWatts > 0 {
+		return h.RatedWatts() / 100
+	}
+	return 1
+}
+
+// allocate returns requested and allocated power of each member in %, g.mtx must be locked
+func (g *Group) allocate() (requested []float64, allocated []float64) {
+	requested = make([]float64, len(g.members))
+	allocated = make([]float64, len(g.members))
+	budget := g.cfg.MaxPower
+	if g.cfg.MaxWatts > 0 {
+		budget = g.cfg.MaxWatts
+	}
+
+	demand := 0.0
+	for i, h := range g.members {
+		requested[i] = h.PowerPrecise()
+		if h.Enabled() {
+			demand += requested[i] * g.weight(h)
+		}
+	}
+
+	for i, h := range g.members {
+		if !h.Enabled() {
+			continue
+		}
+		switch {
+		case demand <= budget:
+			allocated[i] = requested[i]
+		case g.cfg.Scaling == ScalingPriority:
+			allocated[i] = math.Min(requested[i], math.Max(budget, 0)/g.weight(h))
+			budget -= allocated[i] * g.weight(h)
+		default:
+			allocated[i] = requested[i] * budget / demand
+		}
+	}
+	return requested, allocated
+}
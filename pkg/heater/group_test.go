@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GroupSuite struct {
+	suite.Suite
+}
+
+func TestGroupSuite(t *testing.T) {
+	suite.Run(t, new(GroupSuite))
+}
+
+// member is Heater with own fake ticker
+type member struct {
+	*heater.Heater
+	tickerCh chan time.Time
+	heating  *recordingHeating
+}
+
+func (t *GroupSuite) newMember(options ...heater.Option) member {
+	ticker := new(TickerMock)
+	tickerCh := make(chan time.Time)
+	ticker.On("Start", mock.Anything)
+	ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(tickerCh))
+	ticker.On("Stop", mock.Anything)
+	heating := &recordingHeating{}
+
+	h, err := heater.New(append([]heater.Option{heater.WithHeating(heating), heater.WithTicker(ticker)}, options...)...)
+	t.Require().Nil(err)
+	return member{Heater: h, tickerCh: tickerCh, heating: heating}
+}
+
+// tick sends the same stamp to each member and waits until it is handled
+func tick(members ...member) {
+	stamp := time.Now()
+	for _, m := range members {
+		m.tickerCh <- stamp
+	}
+	<-time.After(time.Millisecond)
+}
+
+func (t *GroupSuite) TestNewGroup_Errors() {
+	r := t.Require()
+	_, err := heater.NewGroup(heater.GroupConfig{})
+	r.ErrorIs(err, heater.ErrInvalidBudget)
+	_, err = heater.NewGroup(heater.GroupConfig{MaxPower: 100, MaxWatts: 3000})
+	r.ErrorIs(err, heater.ErrInvalidBudget)
+	_, err = heater.NewGroup(heater.GroupConfig{MaxPower: 100, Scaling: "random"})
+	r.ErrorIs(err, heater.ErrUnknownScaling)
+
+	g, err := heater.NewGroup(heater.GroupConfig{MaxPower: 100})
+	r.Nil(err)
+	r.Equal(heater.ScalingProportional, g.Config().Scaling)
+
+	first := t.newMember()
+	r.Nil(g.Add("first", first.Heater))
+	r.ErrorIs(g.Add("first", first.Heater), heater.ErrAlreadyInGroup)
+
+	second := t.newMember()
+	second.Enable(nil)
+	r.ErrorIs(g.Add("second", second.Heater), heater.ErrHeaterEnabled)
+	second.Disable()
+
+	watts, err := heater.NewGroup(heater.GroupConfig{MaxWatts: 3000})
+	r.Nil(err)
+	r.ErrorIs(watts.Add("second", second.Heater), heater.ErrNoRatedWatts)
+}
+
+func (t *GroupSuite) TestGroup_Proportional() {
+	r := t.Require()
+	g, err := heater.NewGroup(heater.GroupConfig{MaxPower: 100, Scaling: heater.ScalingProportional})
+	r.Nil(err)
+	first, second := t.newMember(), t.newMember()
+	r.Nil(g.Add("first", first.Heater))
+	r.Nil(g.Add("second", second.Heater))
+
+	r.Nil(first.SetPower(80))
+	r.Nil(second.SetPower(80))
+
+	// Disabled heaters don't use budget
+	state := g.State()
+	r.False(state.Limited)
+	r.Zero(state.Requested)
+	r.Equal([]heater.GroupMember{{ID: "first", Requested: 80}, {ID: "second", Requested: 80}}, state.Members)
+
+	// Single enabled heater fits in budget
+	first.Enable(nil)
+	defer first.Disable()
+	tick(first)
+	r.InDelta(80, first.EffectivePower(), 1e-9)
+
+	second.Enable(nil)
+	defer second.Disable()
+	tick(first, second)
+	r.InDelta(50, first.EffectivePower(), 1e-9)
+	r.InDelta(50, second.EffectivePower(), 1e-9)
+	// Requested power is untouched
+	r.EqualValues(80, first.Power())
+
+	state = g.State()
+	r.True(state.Limited)
+	r.InDelta(160, state.Requested, 1e-9)
+	r.InDelta(100, state.Allocated, 1e-9)
+	r.InDelta(50, state.Members[1].Allocated, 1e-9)
+
+	// Budget is released
+	r.Nil(second.SetPower(20))
+	tick(first, second)
+	r.InDelta(80, first.EffectivePower(), 1e-9)
+	r.InDelta(20, second.EffectivePower(), 1e-9)
+	r.False(g.State().Limited)
+}
+
+func (t *GroupSuite) TestGroup_PriorityWatts() {
+	r := t.Require()
+	g, err := heater.NewGroup(heater.GroupConfig{MaxWatts: 3000, Scaling: heater.ScalingPriority})
+	r.Nil(err)
+	first := t.newMember(heater.WithRatedWatts(2000))
+	second := t.newMember(heater.WithRatedWatts(2000))
+	third := t.newMember(heater.WithRatedWatts(1000))
+	for i, m := range []member{first, second, third} {
+		r.Nil(g.Add([]string{"first", "second", "third"}[i], m.Heater))
+		r.Nil(m.SetPower(100))
+		m.Enable(nil)
+		defer m.Disable()
+	}
+
+	tick(first, second, third)
+	r.InDelta(100, first.EffectivePower(), 1e-9)
+	// 1000 W left out of 2000 W
+	r.InDelta(50, second.EffectivePower(), 1e-9)
+	r.InDelta(0, third.EffectivePower(), 1e-9)
+
+	state := g.State()
+	r.True(state.Limited)
+	r.InDelta(5000, state.Requested, 1e-9)
+	r.InDelta(3000, state.Allocated, 1e-9)
+
+	// Heater with higher priority gives budget back
+	first.Disable()
+	tick(second, third)
+	r.InDelta(100, second.EffectivePower(), 1e-9)
+	r.InDelta(100, third.EffectivePower(), 1e-9)
+}
+
+func (t *GroupSuite) TestGroup_BurstStaggered() {
+	r := t.Require()
+	g, err := heater.NewGroup(heater.GroupConfig{MaxPower: 200})
+	r.Nil(err)
+	cfg := heater.ModulationConfig{Mode: heater.ModulationBurst, Window: 10}
+	first := t.newMember(heater.WithModulation(cfg))
+	second := t.newMember(heater.WithModulation(cfg))
+	r.Nil(g.Add("first", first.Heater))
+	r.Nil(g.Add("second", second.Heater))
+
+	r.Nil(first.SetPower(50))
+	r.Nil(second.SetPower(50))
+	first.Enable(nil)
+	second.Enable(nil)
+	for i := 0; i < 20; i++ {
+		tick(first, second)
+	}
+	first.Disable()
+	second.Disable()
+
+	first.heating.mtx.Lock()
+	defer first.heating.mtx.Unlock()
+	second.heating.mtx.Lock()
+	defer second.heating.mtx.Unlock()
+	// Each tick sets output once, the last one is turning off on Disable
+	r.Len(first.heating.states, 21)
+	r.Len(second.heating.states, 21)
+
+	both := 0
+	for i := 0; i < 20; i++ {
+		if first.heating.states[i] && second.heating.states[i] {
+			both++
+		}
+	}
+	// Windows are shifted by half, only enable moments crossing tick boundary may cause overlap
+	r.LessOrEqual(both, 2)
+}
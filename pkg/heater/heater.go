@@ -8,6 +8,8 @@ package heater
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -16,13 +18,24 @@ type Heater struct {
 	heating Heating
 	ticker  Ticker
 
+	// ctl serializes Enable, Disable and SetModulation
+	ctl     sync.Mutex
 	enabled atomic.Bool
 
-	power        uint
-	currentPower uint
-	exit         chan struct{}
-	fin          chan struct{}
-	err          chan error
+	// mtx protects modulation, modulator and power, which are read by loop
+	mtx        sync.Mutex
+	modulation ModulationConfig
+	modulator  Modulator
+
+	power float64
+	exit  chan struct{}
+	fin   chan struct{}
+	err   chan error
+
+	fault faultState
+	stats statsState
+	ramp  rampState
+	group *Group
 }
 
 type Heating interface {
@@ -36,7 +49,19 @@ type Ticker interface {
 	Tick() <-chan time.Time
 }
 
+// FaultTicker is a Ticker, which is able to detect that ticks are missing, e.g. lack of mains.
+// On fault, Heater turns off output and reports error.
+type FaultTicker interface {
+	Ticker
+	Fault() <-chan error
+}
+
+// tickPeriod is nominal period of Ticker, which is a half-cycle of 50 Hz mains
+const tickPeriod = 10 * time.Millisecond
+
 var (
+	ErrHeaterEnabled   = errors.New("heater must be disabled")
+	ErrNeedsZeroCross  = errors.New("modulation requires zero cross ticker")
 	ErrPowerOutOfRange = errors.New("power out of range")
 	ErrNoHeating       = errors.New("lack of heating interface")
 	ErrNoTicker        = errors.New("lack of ticker interface")
@@ -45,15 +70,23 @@ var (
 // New creates new Heater with provided options
 func New(options ...Option) (*Heater, error) {
 	heater := &Heater{
-		heating:      nil,
-		ticker:       nil,
-		enabled:      atomic.Bool{},
-		power:        0,
-		currentPower: 0,
-		exit:         nil,
-		fin:          nil,
-		err:          nil,
+		heating:    nil,
+		ticker:     nil,
+		ctl:        sync.Mutex{},
+		enabled:    atomic.Bool{},
+		mtx:        sync.Mutex{},
+		modulation: ModulationConfig{},
+		modulator:  nil,
+		power:      0,
+		exit:       nil,
+		fin:        nil,
+		err:        nil,
+		fault:      faultState{},
+		stats:      statsState{},
+		ramp:       rampState{},
+		group:      nil,
 	}
+	heater.stats.init(time.Now())
 	for _, opt := range options {
 		opt(heater)
 	}
@@ -66,6 +99,10 @@ func New(options ...Option) (*Heater, error) {
 		return nil, fmt.Errorf("New: %w", ErrNoTicker)
 	}
 
+	if err := heater.SetModulation(heater.modulation); err != nil {
+		return nil, fmt.Errorf("New: %w", err)
+	}
+
 	if err := heater.heating.Open(); err != nil {
 		return nil, fmt.Errorf("New.Open: %w", err)
 	}
@@ -78,21 +115,39 @@ func (h *Heater) Enabled() bool {
 	return h.enabled.Load()
 }
 
-// Power returns current power of heater
+// Power returns requested power of heater, rounded to 1 %
 func (h *Heater) Power() uint {
+	return uint(math.Round(h.PowerPrecise()))
+}
+
+// PowerPrecise returns requested power of heater with Resolution of modulation
+func (h *Heater) PowerPrecise() float64 {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
 	return h.power
 }
 
-// Enable enables heater if it isn't enabled
+// Modulation returns ModulationConfig used by Heater
+func (h *Heater) Modulation() ModulationConfig {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	return h.modulation
+}
+
+// Enable enables heater if it isn't enabled.
+// Errors are written to err in non-blocking way, err is never closed by Heater.
 func (h *Heater) Enable(err chan error) {
+	h.ctl.Lock()
+	defer h.ctl.Unlock()
 	if !h.Enabled() {
-		h.err = err
-		h.enable()
+		h.enable(err)
 	}
 }
 
 // Disable disables heater, if it is enabled
 func (h *Heater) Disable() {
+	h.ctl.Lock()
+	defer h.ctl.Unlock()
 	if h.Enabled() {
 		h.disable()
 	}
@@ -103,43 +158,158 @@ func (h *Heater) SetPower(power uint) error {
 	if power > 100 {
 		return fmt.Errorf("SetPower {Power: %v}: %w", power, ErrPowerOutOfRange)
 	}
-	h.power = power
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.power = float64(power)
+	return nil
+}
+
+// SetModulation replaces Modulator, Heater must be disabled
+func (h *Heater) SetModulation(cfg ModulationConfig) error {
+	h.ctl.Lock()
+	defer h.ctl.Unlock()
+	if h.Enabled() {
+		return fmt.Errorf("SetModulation: %w", ErrHeaterEnabled)
+	}
+	m, cfg, err := NewModulator(cfg)
+	if err != nil {
+		return fmt.Errorf("SetModulation: %w", err)
+	}
+	// Firing in the middle of half-cycle makes sense only, if ticks are synchronized with mains
+	if _, firing := m.(Firing); firing {
+		if _, ok := h.ticker.(*zeroCrossTicker); !ok {
+			return fmt.Errorf("SetModulation {Mode: %v}: %w", cfg.Mode, ErrNeedsZeroCross)
+		}
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.modulator, h.modulation = m, cfg
+	h.power = cfg.quantize(h.power)
+	return nil
+}
+
+// SetPowerPrecise set current power of heater, power is rounded to the nearest step of Resolution
+func (h *Heater) SetPowerPrecise(power float64) error {
+	if power < 0 || power > 100 || math.IsNaN(power) {
+		return fmt.Errorf("SetPowerPrecise {Power: %v}: %w", power, ErrPowerOutOfRange)
+	}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.power = h.modulation.quantize(power)
 	return nil
 }
 
-func (h *Heater) enable() {
+// enable starts loop, h.ctl must be locked
+func (h *Heater) enable(err chan error) {
+	// Loop could have finished on its own (auto disable), make sure it is done
+	if h.fin != nil {
+		for range h.fin {
+		}
+	}
+	h.err = err
 	h.enabled.Store(true)
 	h.exit = make(chan struct{})
 	h.fin = make(chan struct{})
 
+	// Modulator can't be replaced while Heater is enabled, so loop uses it without lock
+	h.mtx.Lock()
+	modulator, window := h.modulator, h.modulation.Window
+	h.mtx.Unlock()
+
+	modulator.Reset()
+	if s, ok := modulator.(staggered); ok && h.group != nil {
+		s.stagger(h.group.phase(h, window, time.Now()))
+	}
+	h.stats.restart()
+	// Soft start: each enable begins ramping from 0
+	h.ramp.set(0)
+
+	var fault <-chan error
+	if f, ok := h.ticker.(FaultTicker); ok {
+		fault = f.Fault()
+	}
+
+	// Firing modulators turn output on in the middle of tick
+	firing, _ := modulator.(Firing)
+
 	loopStarted := make(chan struct{})
 	go func(h *Heater) {
-		h.ticker.Start(10 * time.Millisecond)
+		h.ticker.Start(tickPeriod)
 		close(loopStarted)
+
+		var (
+			fire       *time.Timer
+			fireCh     <-chan time.Time
+			lastTick   time.Time
+			halfPeriod = tickPeriod
+		)
 		for h.enabled.Load() {
 			select {
 			case <-h.exit:
-				h.ticker.Stop()
-			case <-h.ticker.Tick():
-				h.currentPower = (h.currentPower + 1) % 100
-				state := h.power > h.currentPower
-				if err := h.heating.Set(state); err != nil {
-					// non-blocking write
-					err = fmt.Errorf("Heater.Set {Value: %v}: %w", state, err)
-					select {
-					case h.err <- err:
-					default:
+				// loop condition is already false
+			case err := <-fault:
+				// Ticks won't come, so output must be turned off right now
+				if fire != nil {
+					fire.Stop()
+					fireCh = nil
+				}
+				_ = h.heating.Set(false)
+				h.stats.output(false, time.Now())
+				h.report(fmt.Errorf("Heater.Ticker: %w", err))
+			case stamp := <-h.ticker.Tick():
+				now := time.Now()
+				h.stats.tick(now)
+				// Measure real half-cycle, nominal one is used until then.
+				// Gaps (e.g. missing mains) are ignored, so ramp won't jump after them.
+				if !lastTick.IsZero() {
+					if d := stamp.Sub(lastTick); d > 0 && d < 2*tickPeriod {
+						halfPeriod = d
 					}
 				}
+				lastTick = stamp
+				power := h.PowerPrecise()
+				if h.group != nil {
+					power = h.group.allowed(h)
+				}
+				power = h.ramp.next(power, halfPeriod)
+
+				if firing == nil {
+					h.set(modulator.Next(power), now)
+					break
+				}
+				if fire != nil {
+					fire.Stop()
+					fireCh = nil
+				}
+
+				delay, ok := firing.Delay(power, halfPeriod)
+				if !ok {
+					h.set(false, now)
+					break
+				}
+				// Delay is relative to zero-cross, not to the moment we received it
+				wait := delay - time.Since(stamp)
+				if wait <= 0 {
+					h.set(true, now)
+					break
+				}
+				h.set(false, now)
+				fire = time.NewTimer(wait)
+				fireCh = fire.C
+			case now := <-fireCh:
+				fireCh = nil
+				h.set(true, now)
 			}
 
 		}
+		h.ticker.Stop()
+		if fire != nil {
+			fire.Stop()
+		}
 		_ = h.heating.Set(false)
+		h.stats.output(false, time.Now())
+		h.ramp.set(0)
 		close(h.fin)
-
-		if h.err != nil {
-			close(h.err)
-		}
 	}(h)
 
 	// make sure loop is running
@@ -147,11 +317,41 @@ func (h *Heater) enable() {
 	}
 }
 
+// set changes state of output, now is used for on-time accounting
+func (h *Heater) set(state bool, now time.Time) {
+	if err := h.heating.Set(state); err != nil {
+		h.report(fmt.Errorf("Heater.Set {Value: %v}: %w", state, err))
+		return
+	}
+	h.fault.success()
+	h.stats.output(state, now)
+}
+
+// report records failure and writes it in non-blocking way, too many failures disable Heater
+func (h *Heater) report(err error) {
+	disable := h.fault.failure(err)
+	h.send(err)
+	if disable {
+		_ = h.heating.Set(false)
+		h.stats.output(false, time.Now())
+		h.enabled.Store(false)
+		h.send(fmt.Errorf("Heater: %w", ErrTooManyFailures))
+	}
+}
+
+func (h *Heater) send(err error) {
+	select {
+	case h.err <- err:
+	default:
+	}
+}
+
+// disable stops loop, h.ctl must be locked
 func (h *Heater) disable() {
 	h.enabled.Store(false)
-	h.exit <- struct{}{}
+	// loop may notice disabled state on its own, so exit can't be a blocking send
+	close(h.exit)
 
 	for range h.fin {
 	}
-	close(h.exit)
 }
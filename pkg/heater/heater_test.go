@@ -7,6 +7,7 @@ package heater_test
 
 import (
 	"io"
+	"sync"
 	"testing"
 	"time"
 
@@ -190,6 +191,71 @@ func (t *HeaterSuite) TestHeater_New() {
 	}
 }
 
+func (t *HeaterSuite) TestHeater_Concurrent() {
+	r := t.Require()
+	heating := new(HeatingMock)
+	ticker := new(TickerMock)
+	tickerCh := make(chan time.Time)
+
+	heating.On("Open").Return(nil)
+	heating.On("Set", mock.Anything).Return(nil)
+	ticker.On("Start", mock.Anything)
+	ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(tickerCh))
+	ticker.On("Stop", mock.Anything)
+
+	h, err := heater.New(heater.WithHeating(heating), heater.WithTicker(ticker), heater.WithRampRate(1000))
+	r.Nil(err)
+
+	// Ticks are coming all the time, loop reads power while clients change it
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case tickerCh <- time.Now():
+			case <-time.After(time.Millisecond):
+			}
+		}
+	}()
+	defer close(done)
+
+	cfg := heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Resolution: 200}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				switch (i + j) % 5 {
+				case 0:
+					h.Enable(nil)
+				case 1:
+					h.Disable()
+				case 2:
+					_ = h.SetPower(uint(j))
+				case 3:
+					_ = h.SetPowerPrecise(float64(j) + 0.5)
+				case 4:
+					// Fails, if heater is enabled at the moment
+					_ = h.SetModulation(cfg)
+				}
+				_ = h.Power()
+				_ = h.Modulation()
+				_ = h.EffectivePower()
+				_ = h.Stats()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	h.Disable()
+	r.False(h.Enabled())
+	r.Zero(h.EffectivePower())
+	r.Nil(h.SetPower(40))
+	r.EqualValues(40, h.Power())
+}
+
 func (h *HeatingMock) Open() error {
 	args := h.Called()
 	return args.Error(0)
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Modulator decides, whether output should be turned on in the next tick
+type Modulator interface {
+	// Next returns state of output for the next tick, power is in range 0 - 100 %
+	Next(power float64) bool
+	// Reset clears internal state, called each time Heater is enabled
+	Reset()
+}
+
+// Available modulation modes
+const (
+	// ModulationBurst turns output on for the first ticks of window, then off for the rest of it
+	ModulationBurst = "burst"
+	// ModulationSigmaDelta spreads on ticks evenly, like Bresenham's line algorithm
+	ModulationSigmaDelta = "sigma_delta"
+	// ModulationPhaseAngle fires output at computed delay after each tick, requires ZeroCross Ticker
+	ModulationPhaseAngle = "phase_angle"
+)
+
+const (
+	DefaultWindow     = 100
+	DefaultResolution = 100
+)
+
+var (
+	ErrUnknownModulation = errors.New("unknown modulation mode")
+	ErrInvalidWindow     = errors.New("window must be greater than 0")
+)
+
+// ModulationConfig selects Modulator:
+// - Window is number of ticks in single burst window,
+// - Resolution is number of power steps, e.g. 1000 means 0.1 % step.
+type ModulationConfig struct {
+	Mode       string `json:"mode" mapstructure:"mode"`
+	Window     uint   `json:"window" mapstructure:"window"`
+	Resolution uint   `json:"resolution" mapstructure:"resolution"`
+}
+
+// NewModulator creates Modulator based on config, zeroed fields are replaced by defaults
+func NewModulator(cfg ModulationConfig) (Modulator, ModulationConfig, error) {
+	cfg = cfg.withDefaults()
+	switch cfg.Mode {
+	case ModulationBurst:
+		return &burst{window: cfg.Window}, cfg, nil
+	case ModulationSigmaDelta:
+		return &sigmaDelta{resolution: cfg.Resolution}, cfg, nil
+	case ModulationPhaseAngle:
+		return &phaseAngle{}, cfg, nil
+	}
+	return nil, cfg, fmt.Errorf("NewModulator {Mode: %v}: %w", cfg.Mode, ErrUnknownModulation)
+}
+
+func (m ModulationConfig) withDefaults() ModulationConfig {
+	if m.Mode == "" {
+		m.Mode = ModulationBurst
+	}
+	if m.Window == 0 {
+		m.Window = DefaultWindow
+	}
+	if m.Resolution == 0 {
+		m.Resolution = DefaultResolution
+	}
+	return m
+}
+
+// quantize rounds power to the nearest step
+func (m ModulationConfig) quantize(power float64) float64 {
+	steps := math.Round(power * float64(m.Resolution) / 100)
+	return steps * 100 / float64(m.Resolution)
+}
+
+// staggered Modulator can start in the middle of window, so heaters in Group don't turn on at the same tick
+type staggered interface {
+	stagger(tick uint)
+}
+
+// burst keeps output on for the first part of window
+type burst struct {
+	window uint
+	tick   uint
+}
+
+var _ Modulator = (*burst)(nil)
+
+func (b *burst) Next(power float64) bool {
+	b.tick = (b.tick + 1) % b.window
+	on := uint(math.Round(power * float64(b.window) / 100))
+	return on > b.tick
+}
+
+func (b *burst) Reset() {
+	b.tick = 0
+}
+
+func (b *burst) stagger(tick uint) {
+	b.tick = tick % b.window
+}
+
+// sigmaDelta accumulates power on each tick and turns output on, when accumulator overflows
+type sigmaDelta struct {
+	resolution uint
+	acc        uint
+}
+
+var _ Modulator = (*sigmaDelta)(nil)
+
+func (s *sigmaDelta) Next(power float64) bool {
+	s.acc += uint(math.Round(power * float64(s.resolution) / 100))
+	if s.acc >= s.resolution {
+		s.acc -= s.resolution
+		return true
+	}
+	return false
+}
+
+func (s *sigmaDelta) Reset() {
+	s.acc = 0
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModulatorSuite struct {
+	suite.Suite
+}
+
+func TestModulatorSuite(t *testing.T) {
+	suite.Run(t, new(ModulatorSuite))
+}
+
+func states(m heater.Modulator, power float64, ticks int) []bool {
+	s := make([]bool, ticks)
+	for i := range s {
+		s[i] = m.Next(power)
+	}
+	return s
+}
+
+func count(s []bool) int {
+	on := 0
+	for _, v := range s {
+		if v {
+			on++
+		}
+	}
+	return on
+}
+
+// longest returns longest sequence of the same state
+func longest(s []bool, state bool) int {
+	best, current := 0, 0
+	for _, v := range s {
+		if v == state {
+			current++
+			if current > best {
+				best = current
+			}
+		} else {
+			current = 0
+		}
+	}
+	return best
+}
+
+func (t *ModulatorSuite) TestNewModulator() {
+	r := t.Require()
+
+	m, cfg, err := heater.NewModulator(heater.ModulationConfig{})
+	r.Nil(err)
+	r.NotNil(m)
+	r.Equal(heater.ModulationConfig{Mode: heater.ModulationBurst, Window: heater.DefaultWindow, Resolution: heater.DefaultResolution}, cfg)
+
+	m, cfg, err = heater.NewModulator(heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Resolution: 1000})
+	r.Nil(err)
+	r.NotNil(m)
+	r.Equal(heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Window: heater.DefaultWindow, Resolution: 1000}, cfg)
+
+	m, _, err = heater.NewModulator(heater.ModulationConfig{Mode: "pwm"})
+	r.Nil(m)
+	r.ErrorIs(err, heater.ErrUnknownModulation)
+}
+
+func (t *ModulatorSuite) TestBurst() {
+	r := t.Require()
+	m, _, err := heater.NewModulator(heater.ModulationConfig{Mode: heater.ModulationBurst, Window: 50})
+	r.Nil(err)
+
+	s := states(m, 30, 50)
+	r.Equal(15, count(s))
+	// One block on, one block off
+	r.GreaterOrEqual(longest(s, true), 14)
+	r.GreaterOrEqual(longest(s, false), 35)
+}
+
+func (t *ModulatorSuite) TestSigmaDelta() {
+	args := []struct {
+		name    string
+		power   float64
+		on      int
+		maxOn   int
+		maxOff  int
+		ticks   int
+		resolve uint
+	}{
+		{name: "30%", power: 30, on: 30, maxOn: 1, maxOff: 3, ticks: 100, resolve: 100},
+		{name: "50%", power: 50, on: 50, maxOn: 1, maxOff: 1, ticks: 100, resolve: 100},
+		{name: "75%", power: 75, on: 75, maxOn: 3, maxOff: 1, ticks: 100, resolve: 100},
+		{name: "0.5%", power: 0.5, on: 5, maxOn: 1, maxOff: 200, ticks: 1000, resolve: 1000},
+		{name: "0%", power: 0, on: 0, maxOn: 0, maxOff: 100, ticks: 100, resolve: 100},
+		{name: "100%", power: 100, on: 100, maxOn: 100, maxOff: 0, ticks: 100, resolve: 100},
+	}
+	for _, arg := range args {
+		r := t.Require()
+		m, _, err := heater.NewModulator(heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Resolution: arg.resolve})
+		r.Nil(err, arg.name)
+
+		s := states(m, arg.power, arg.ticks)
+		r.Equal(arg.on, count(s), arg.name)
+		r.LessOrEqual(longest(s, true), arg.maxOn, arg.name)
+		r.LessOrEqual(longest(s, false), arg.maxOff, arg.name)
+	}
+}
+
+func (t *ModulatorSuite) TestHeater_Modulation() {
+	r := t.Require()
+	heating := new(HeatingMock)
+	ticker := new(TickerMock)
+	tickerCh := make(chan time.Time)
+
+	heating.On("Open").Return(nil)
+	ticker.On("Start", mock.Anything)
+	ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(tickerCh))
+	ticker.On("Stop", mock.Anything)
+
+	_, err := heater.New(heater.WithHeating(heating), heater.WithTicker(ticker), heater.WithModulation(heater.ModulationConfig{Mode: "pwm"}))
+	r.ErrorIs(err, heater.ErrUnknownModulation)
+
+	cfg := heater.ModulationConfig{Mode: heater.ModulationSigmaDelta, Window: 100, Resolution: 1000}
+	h, err := heater.New(heater.WithHeating(heating), heater.WithTicker(ticker), heater.WithModulation(cfg))
+	r.Nil(err)
+	r.Equal(cfg, h.Modulation())
+
+	// Power is rounded to resolution
+	r.Nil(h.SetPowerPrecise(12.34))
+	r.InDelta(12.3, h.PowerPrecise(), 1e-9)
+	r.EqualValues(12, h.Power())
+	r.ErrorIs(h.SetPowerPrecise(100.1), heater.ErrPowerOutOfRange)
+	r.ErrorIs(h.SetPowerPrecise(-1), heater.ErrPowerOutOfRange)
+
+	// 50% with sigma delta - output toggles on each tick
+	r.Nil(h.SetPower(50))
+	var got []bool
+	heating.On("Set", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		got = append(got, args.Bool(0))
+	})
+	h.Enable(nil)
+	for i := 0; i < 10; i++ {
+		tickerCh <- time.Now()
+	}
+	h.Disable()
+	r.Equal([]bool{false, true, false, true, false, true, false, true, false, true}, got[:10])
+}
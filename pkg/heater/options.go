@@ -6,6 +6,8 @@
 package heater
 
 import (
+	"math"
+
 	"github.com/a-clap/embedded/pkg/gpio"
 )
 
@@ -23,12 +25,46 @@ func WithTicker(t Ticker) Option {
 	}
 }
 
+func WithModulation(cfg ModulationConfig) Option {
+	return func(heater *Heater) {
+		heater.modulation = cfg
+	}
+}
+
+// WithMaxFailures disables Heater after n consecutive failures, 0 means never
+func WithMaxFailures(n uint) Option {
+	return func(heater *Heater) {
+		heater.fault.maxFailures = n
+	}
+}
+
+// WithRatedWatts sets power of heater at 100 %, which is used to calculate energy
+func WithRatedWatts(watts float64) Option {
+	return func(heater *Heater) {
+		heater.stats.ratedWatts = watts
+	}
+}
+
+// WithRampRate limits increase of power to rate %/s, 0 means no limit
+func WithRampRate(rate float64) Option {
+	return func(heater *Heater) {
+		heater.ramp.rate = math.Max(rate, 0)
+	}
+}
+
 func WithGpioHeating(pin gpio.Pin, id string, level gpio.ActiveLevel) Option {
 	return func(heater *Heater) {
 		heater.heating = newGpioHeating(pin, id, level)
 	}
 }
 
+// WithZeroCrossTicker makes Heater tick on each edge from zero-cross detector
+func WithZeroCrossTicker(z *ZeroCross) Option {
+	return func(heater *Heater) {
+		heater.ticker = z.Ticker()
+	}
+}
+
 func WitTimeTicker() Option {
 	return func(heater *Heater) {
 		heater.ticker = newTimeTicker()
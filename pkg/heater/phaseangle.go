@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"math"
+	"time"
+)
+
+// Firing is a Modulator, which turns output on in the middle of tick, instead of for the whole tick.
+// Output is turned off on each tick and turned on again after Delay.
+type Firing interface {
+	Modulator
+	// Delay returns time after tick, when output should be turned on, false means output stays off
+	Delay(power float64, halfPeriod time.Duration) (time.Duration, bool)
+}
+
+// phaseAngle fires thyristor at computed angle of each half-cycle, so each half-cycle delivers requested power.
+// It requires ticks synchronized with mains, see ZeroCross.
+type phaseAngle struct {
+}
+
+var _ Firing = (*phaseAngle)(nil)
+
+func (p *phaseAngle) Next(power float64) bool {
+	return power > 0
+}
+
+func (p *phaseAngle) Reset() {
+}
+
+func (p *phaseAngle) Delay(power float64, halfPeriod time.Duration) (time.Duration, bool) {
+	if power <= 0 {
+		return 0, false
+	}
+	alpha := FiringAngle(power)
+	return time.Duration(alpha / math.Pi * float64(halfPeriod)), true
+}
+
+// FiringAngle returns angle (0 - Pi) of half-cycle, at which resistive load should be fired to get requested power (0 - 100 %).
+// Power delivered after firing at angle a is P(a) = 1 - a/Pi + sin(2a)/(2Pi), which has no closed-form inverse.
+func FiringAngle(power float64) float64 {
+	p := power / 100
+	if p >= 1 {
+		return 0
+	}
+	if p <= 0 {
+		return math.Pi
+	}
+	// P(a) is monotonic, so bisection is enough
+	low, high := 0.0, math.Pi
+	for i := 0; i < 50; i++ {
+		mid := (low + high) / 2
+		if phasePower(mid) > p {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
+
+func phasePower(alpha float64) float64 {
+	return 1 - alpha/math.Pi + math.Sin(2*alpha)/(2*math.Pi)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/suite"
+)
+
+type PhaseAngleSuite struct {
+	suite.Suite
+}
+
+// recordingHeating stores each change of output with timestamp
+type recordingHeating struct {
+	mtx    sync.Mutex
+	states []bool
+	stamps []time.Time
+}
+
+func TestPhaseAngleSuite(t *testing.T) {
+	suite.Run(t, new(PhaseAngleSuite))
+}
+
+func (t *PhaseAngleSuite) TestFiringAngle() {
+	r := t.Require()
+	r.InDelta(math.Pi, heater.FiringAngle(0), 1e-9)
+	r.InDelta(0, heater.FiringAngle(100), 1e-9)
+	// Power curve is symmetric around half of half-cycle
+	r.InDelta(math.Pi/2, heater.FiringAngle(50), 1e-9)
+
+	prev := math.Pi
+	for power := 1.0; power < 100; power++ {
+		alpha := heater.FiringAngle(power)
+		r.Less(alpha, prev, power)
+		prev = alpha
+
+		got := 1 - alpha/math.Pi + math.Sin(2*alpha)/(2*math.Pi)
+		r.InDelta(power/100, got, 1e-9, power)
+	}
+}
+
+func (t *PhaseAngleSuite) TestHeater_FiresWithDelay() {
+	args := []struct {
+		name  string
+		power uint
+		delay time.Duration
+	}{
+		{name: "50%", power: 50, delay: 5 * time.Millisecond},
+		{name: "~9%", power: 9, delay: 7500 * time.Microsecond},
+	}
+	for _, arg := range args {
+		r := t.Require()
+		z := heater.NewZeroCross()
+		heating := &recordingHeating{}
+		h, err := heater.New(heater.WithHeating(heating), heater.WithZeroCrossTicker(z),
+			heater.WithModulation(heater.ModulationConfig{Mode: heater.ModulationPhaseAngle}))
+		r.Nil(err, arg.name)
+		r.Nil(h.SetPower(arg.power), arg.name)
+
+		h.Enable(nil)
+		var edges []time.Time
+		for i := 0; i < 5; i++ {
+			stamp := time.Now()
+			edges = append(edges, stamp)
+			z.Edge(stamp)
+			// Leave enough time for firing, even on busy machine - period is still nominal one
+			<-time.After(40 * time.Millisecond)
+		}
+		h.Disable()
+
+		heating.mtx.Lock()
+		var fired []time.Time
+		for i, state := range heating.states {
+			if state {
+				fired = append(fired, heating.stamps[i])
+			}
+		}
+		heating.mtx.Unlock()
+
+		r.Len(fired, len(edges), arg.name)
+		for i := range edges {
+			// Never too early, late only by scheduling latency
+			r.GreaterOrEqual(fired[i].Sub(edges[i]), arg.delay-100*time.Microsecond, arg.name)
+			r.Less(fired[i].Sub(edges[i]), arg.delay+25*time.Millisecond, arg.name)
+		}
+	}
+}
+
+func (t *PhaseAngleSuite) TestHeater_ZeroAndFullPower() {
+	r := t.Require()
+	z := heater.NewZeroCross()
+	heating := &recordingHeating{}
+	h, err := heater.New(heater.WithHeating(heating), heater.WithZeroCrossTicker(z),
+		heater.WithModulation(heater.ModulationConfig{Mode: heater.ModulationPhaseAngle}))
+	r.Nil(err)
+
+	r.Nil(h.SetPower(0))
+	h.Enable(nil)
+	for i := 0; i < 3; i++ {
+		z.Edge(time.Now())
+		<-time.After(5 * time.Millisecond)
+	}
+	h.Disable()
+	r.NotContains(heating.states, true)
+
+	heating.states = nil
+	r.Nil(h.SetPower(100))
+	h.Enable(nil)
+	for i := 0; i < 3; i++ {
+		z.Edge(time.Now())
+		<-time.After(5 * time.Millisecond)
+	}
+	h.Disable()
+	// Fired right on zero-cross, turned off on Disable
+	r.Equal([]bool{true, true, true, false}, heating.states)
+}
+
+func (t *PhaseAngleSuite) TestSetModulation() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(&recordingHeating{}), heater.WithZeroCrossTicker(heater.NewZeroCross()))
+	r.Nil(err)
+	r.Equal(heater.ModulationBurst, h.Modulation().Mode)
+
+	r.ErrorIs(h.SetModulation(heater.ModulationConfig{Mode: "pwm"}), heater.ErrUnknownModulation)
+
+	h.Enable(nil)
+	r.ErrorIs(h.SetModulation(heater.ModulationConfig{Mode: heater.ModulationPhaseAngle}), heater.ErrHeaterEnabled)
+	h.Disable()
+
+	r.Nil(h.SetModulation(heater.ModulationConfig{Mode: heater.ModulationPhaseAngle}))
+	r.Equal(heater.ModulationPhaseAngle, h.Modulation().Mode)
+
+	// Free-running ticker can't be used for phase angle
+	h, err = heater.New(heater.WithHeating(&recordingHeating{}), heater.WitTimeTicker(),
+		heater.WithModulation(heater.ModulationConfig{Mode: heater.ModulationPhaseAngle}))
+	r.Nil(h)
+	r.ErrorIs(err, heater.ErrNeedsZeroCross)
+}
+
+func (r *recordingHeating) Open() error {
+	return nil
+}
+
+func (r *recordingHeating) Set(b bool) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.states = append(r.states, b)
+	r.stamps = append(r.stamps, time.Now())
+	return nil
+}
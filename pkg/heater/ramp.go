@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// rampState limits how fast effective power rises toward requested power.
+// Decreasing power is never limited - it doesn't cause inrush and safety (e.g. overheat) can't wait.
+type rampState struct {
+	rate      float64 // %/s, 0 means no limit
+	effective atomic.Uint64
+}
+
+// next moves effective power toward target, dt is time elapsed since previous step
+func (r *rampState) next(target float64, dt time.Duration) float64 {
+	effective := r.get()
+	if r.rate <= 0 || target <= effective {
+		effective = target
+	} else {
+		effective = math.Min(target, effective+r.rate*dt.Seconds())
+	}
+	r.set(effective)
+	return effective
+}
+
+func (r *rampState) get() float64 {
+	return math.Float64frombits(r.effective.Load())
+}
+
+func (r *rampState) set(power float64) {
+	r.effective.Store(math.Float64bits(power))
+}
+
+// RampRate returns limit of power increase in %/s, 0 means no limit
+func (h *Heater) RampRate() float64 {
+	return h.ramp.rate
+}
+
+// EffectivePower returns power, which is currently delivered by Heater.
+// It differs from requested power while ramping up, and it is 0, when Heater is disabled.
+func (h *Heater) EffectivePower() float64 {
+	return h.ramp.get()
+}
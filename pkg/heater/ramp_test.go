@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RampSuite struct {
+	suite.Suite
+	heating  *HeatingMock
+	ticker   *TickerMock
+	tickerCh chan time.Time
+	stamp    time.Time
+}
+
+func TestRampSuite(t *testing.T) {
+	suite.Run(t, new(RampSuite))
+}
+
+func (t *RampSuite) SetupTest() {
+	t.heating = new(HeatingMock)
+	t.ticker = new(TickerMock)
+	t.tickerCh = make(chan time.Time)
+	t.stamp = time.Now()
+
+	t.heating.On("Open").Return(nil)
+	t.heating.On("Set", mock.Anything).Return(nil)
+	t.ticker.On("Start", mock.Anything)
+	t.ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(t.tickerCh))
+	t.ticker.On("Stop", mock.Anything)
+}
+
+// ticks sends n ticks spaced by period and waits until last one is handled
+func (t *RampSuite) ticks(n int, period time.Duration) {
+	for i := 0; i < n; i++ {
+		t.stamp = t.stamp.Add(period)
+		t.tickerCh <- t.stamp
+	}
+	<-time.After(time.Millisecond)
+}
+
+func (t *RampSuite) TestRamp_Up() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRampRate(100))
+	r.Nil(err)
+	r.EqualValues(100, h.RampRate())
+
+	r.Nil(h.SetPower(50))
+	r.Zero(h.EffectivePower())
+	h.Enable(nil)
+	defer h.Disable()
+
+	// 100 %/s is 1 % per 10 ms
+	t.ticks(10, 10*time.Millisecond)
+	r.InDelta(10, h.EffectivePower(), 1e-9)
+	r.EqualValues(50, h.Power())
+
+	// Effective power stops at requested one
+	t.ticks(50, 10*time.Millisecond)
+	r.InDelta(50, h.EffectivePower(), 1e-9)
+
+	// Requested power can change while ramping
+	r.Nil(h.SetPower(60))
+	t.ticks(5, 10*time.Millisecond)
+	r.InDelta(55, h.EffectivePower(), 1e-9)
+}
+
+func (t *RampSuite) TestRamp_DownIsImmediate() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRampRate(100))
+	r.Nil(err)
+
+	r.Nil(h.SetPower(20))
+	h.Enable(nil)
+	defer h.Disable()
+	t.ticks(30, 10*time.Millisecond)
+	r.InDelta(20, h.EffectivePower(), 1e-9)
+
+	r.Nil(h.SetPower(5))
+	t.ticks(1, 10*time.Millisecond)
+	r.InDelta(5, h.EffectivePower(), 1e-9)
+}
+
+func (t *RampSuite) TestRamp_SoftStartOnEnable() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRampRate(100))
+	r.Nil(err)
+
+	r.Nil(h.SetPower(100))
+	h.Enable(nil)
+	t.ticks(20, 10*time.Millisecond)
+	r.InDelta(20, h.EffectivePower(), 1e-9)
+
+	h.Disable()
+	r.Zero(h.EffectivePower())
+	r.EqualValues(100, h.Power())
+
+	h.Enable(nil)
+	defer h.Disable()
+	t.ticks(3, 10*time.Millisecond)
+	r.InDelta(3, h.EffectivePower(), 1e-9)
+}
+
+func (t *RampSuite) TestRamp_GapDoesntJump() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRampRate(100))
+	r.Nil(err)
+
+	r.Nil(h.SetPower(100))
+	h.Enable(nil)
+	defer h.Disable()
+	t.ticks(5, 10*time.Millisecond)
+	r.InDelta(5, h.EffectivePower(), 1e-9)
+
+	// Missing ticks are not taken into account
+	t.ticks(1, time.Second)
+	r.InDelta(6, h.EffectivePower(), 1e-9)
+}
+
+func (t *RampSuite) TestRamp_Disabled() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRampRate(-5))
+	r.Nil(err)
+	r.Zero(h.RampRate())
+
+	r.Nil(h.SetPower(80))
+	h.Enable(nil)
+	defer h.Disable()
+	t.ticks(1, 10*time.Millisecond)
+	r.InDelta(80, h.EffectivePower(), 1e-9)
+}
+
+func (t *RampSuite) TestRamp_ModulatorUsesEffectivePower() {
+	r := t.Require()
+	heating := &recordingHeating{}
+	h, err := heater.New(heater.WithHeating(heating), heater.WithTicker(t.ticker), heater.WithRampRate(100),
+		heater.WithModulation(heater.ModulationConfig{Mode: heater.ModulationSigmaDelta}))
+	r.Nil(err)
+
+	r.Nil(h.SetPower(100))
+	h.Enable(nil)
+	// First ticks deliver only few percent, so output is mostly off
+	t.ticks(10, 10*time.Millisecond)
+	h.Disable()
+
+	heating.mtx.Lock()
+	defer heating.mtx.Unlock()
+	on := 0
+	for _, state := range heating.states {
+		if state {
+			on++
+		}
+	}
+	r.Less(on, 5)
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"sync"
+	"time"
+)
+
+// DutyWindow is number of ticks, which are used to calculate rolling duty cycle
+const DutyWindow = 100
+
+// Stats describes how long output was on. Energy is calculated only if RatedWatts is set.
+type Stats struct {
+	Started            time.Time     `json:"started"`
+	OnTime             time.Duration `json:"on_time"`
+	Reset              time.Time     `json:"reset"`
+	OnTimeSinceReset   time.Duration `json:"on_time_since_reset"`
+	Duty               float64       `json:"duty"`
+	RatedWatts         float64       `json:"rated_watts"`
+	EnergyWh           float64       `json:"energy_wh"`
+	EnergyWhSinceReset float64       `json:"energy_wh_since_reset"`
+}
+
+type statsState struct {
+	mtx        sync.Mutex
+	ratedWatts float64
+	started    time.Time
+	reset      time.Time
+	onTime     time.Duration
+	onReset    time.Duration
+	on         bool
+	mark       time.Time
+	// rolling duty, each element is fraction of tick, when output was on
+	tickStart time.Time
+	tickOn    time.Duration
+	duty      [DutyWindow]float64
+	pos       int
+	filled    int
+}
+
+func (s *statsState) init(now time.Time) {
+	s.started, s.reset, s.mark = now, now, now
+}
+
+// accumulate adds on-time since last mark
+func (s *statsState) accumulate(now time.Time) {
+	if s.on {
+		d := now.Sub(s.mark)
+		s.onTime += d
+		s.onReset += d
+		s.tickOn += d
+	}
+	s.mark = now
+}
+
+// output is called after state of output was changed
+func (s *statsState) output(on bool, now time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.accumulate(now)
+	s.on = on
+}
+
+// tick closes previous tick in rolling duty
+func (s *statsState) tick(now time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.accumulate(now)
+	if !s.tickStart.IsZero() {
+		if interval := now.Sub(s.tickStart); interval > 0 {
+			s.duty[s.pos] = float64(s.tickOn) / float64(interval)
+			s.pos = (s.pos + 1) % DutyWindow
+			if s.filled < DutyWindow {
+				s.filled++
+			}
+		}
+	}
+	s.tickStart = now
+	s.tickOn = 0
+}
+
+// restart clears rolling duty, so time when Heater was disabled isn't taken into account
+func (s *statsState) restart() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.tickStart = time.Time{}
+	s.tickOn = 0
+	s.pos = 0
+	s.filled = 0
+}
+
+func (s *statsState) get(now time.Time) Stats {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.accumulate(now)
+
+	duty := 0.0
+	if s.filled > 0 {
+		for i := 0; i < s.filled; i++ {
+			duty += s.duty[i]
+		}
+		duty = 100 * duty / float64(s.filled)
+	}
+
+	return Stats{
+		Started:            s.started,
+		OnTime:             s.onTime,
+		Reset:              s.reset,
+		OnTimeSinceReset:   s.onReset,
+		Duty:               duty,
+		RatedWatts:         s.ratedWatts,
+		EnergyWh:           s.ratedWatts * s.onTime.Hours(),
+		EnergyWhSinceReset: s.ratedWatts * s.onReset.Hours(),
+	}
+}
+
+func (s *statsState) clear(now time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.accumulate(now)
+	s.onReset = 0
+	s.reset = now
+}
+
+// Stats returns on-time and energy used by Heater
+func (h *Heater) Stats() Stats {
+	return h.stats.get(time.Now())
+}
+
+// ResetStats starts new period of on-time and energy accounting, values since start are kept
+func (h *Heater) ResetStats() {
+	h.stats.clear(time.Now())
+}
+
+// RatedWatts returns power of heater at 100 %, 0 if unknown
+func (h *Heater) RatedWatts() float64 {
+	return h.stats.ratedWatts
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsSuite struct {
+	suite.Suite
+	heating  *HeatingMock
+	ticker   *TickerMock
+	tickerCh chan time.Time
+}
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(StatsSuite))
+}
+
+func (t *StatsSuite) SetupTest() {
+	t.heating = new(HeatingMock)
+	t.ticker = new(TickerMock)
+	t.tickerCh = make(chan time.Time)
+
+	t.heating.On("Open").Return(nil)
+	t.heating.On("Set", mock.Anything).Return(nil)
+	t.ticker.On("Start", mock.Anything)
+	t.ticker.On("Tick", mock.Anything).Return((<-chan time.Time)(t.tickerCh))
+	t.ticker.On("Stop", mock.Anything)
+}
+
+func (t *StatsSuite) TestStats_OnTimeAndEnergy() {
+	r := t.Require()
+	created := time.Now()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker), heater.WithRatedWatts(3600))
+	r.Nil(err)
+	r.EqualValues(3600, h.RatedWatts())
+
+	stats := h.Stats()
+	r.False(stats.Started.Before(created))
+	r.Zero(stats.OnTime)
+	r.Zero(stats.EnergyWh)
+
+	// Output is on all the time
+	r.Nil(h.SetPower(100))
+	h.Enable(nil)
+	start := time.Now()
+	t.tickerCh <- time.Now()
+	<-time.After(50 * time.Millisecond)
+	h.Disable()
+	elapsed := time.Since(start)
+
+	stats = h.Stats()
+	r.Greater(stats.OnTime, 40*time.Millisecond)
+	r.LessOrEqual(stats.OnTime, elapsed)
+	r.Equal(stats.OnTime, stats.OnTimeSinceReset)
+	// 3600 W for 1 second is 1 Wh
+	r.InDelta(stats.OnTime.Seconds(), stats.EnergyWh, 1e-9)
+
+	// Disabled heater doesn't count
+	<-time.After(10 * time.Millisecond)
+	r.Equal(stats.OnTime, h.Stats().OnTime)
+
+	// Reset keeps total on-time
+	h.ResetStats()
+	reset := h.Stats()
+	r.Equal(stats.OnTime, reset.OnTime)
+	r.Zero(reset.OnTimeSinceReset)
+	r.Zero(reset.EnergyWhSinceReset)
+	r.True(reset.Reset.After(stats.Reset))
+}
+
+func (t *StatsSuite) TestStats_Duty() {
+	r := t.Require()
+	h, err := heater.New(heater.WithHeating(t.heating), heater.WithTicker(t.ticker),
+		heater.WithModulation(heater.ModulationConfig{Mode: heater.ModulationSigmaDelta}))
+	r.Nil(err)
+	r.Zero(h.Stats().Duty)
+
+	r.Nil(h.SetPower(25))
+	h.Enable(nil)
+	// Each tick is either on or off, so after full window duty is exactly as power
+	for i := 0; i < heater.DutyWindow+1; i++ {
+		t.tickerCh <- time.Now()
+	}
+	// Make sure last tick is handled
+	t.tickerCh <- time.Now()
+	r.InDelta(25, h.Stats().Duty, 1.01)
+	h.Disable()
+
+	r.Nil(h.SetPower(75))
+	h.Enable(nil)
+	for i := 0; i < heater.DutyWindow+2; i++ {
+		t.tickerCh <- time.Now()
+	}
+	r.InDelta(75, h.Stats().Duty, 1.01)
+	h.Disable()
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/gpio"
+	"github.com/warthog618/gpiod"
+)
+
+// ZeroCrossTimeout is number of periods without edge, after which mains is considered missing
+const ZeroCrossTimeout = 5
+
+var (
+	ErrNoZeroCross = errors.New("zero cross not detected, mains missing")
+)
+
+// ZeroCross distributes edges from single zero-cross detector to many Tickers
+type ZeroCross struct {
+	mtx     sync.Mutex
+	tickers map[*zeroCrossTicker]struct{}
+	in      *gpio.In
+}
+
+// zeroCrossTicker ticks on each edge from ZeroCross, instead of free-running timer
+type zeroCrossTicker struct {
+	source  *ZeroCross
+	tick    chan time.Time
+	err     chan error
+	mtx     sync.Mutex
+	timeout time.Duration
+	wdt     *time.Timer
+	missing bool
+}
+
+var _ FaultTicker = (*zeroCrossTicker)(nil)
+
+// NewZeroCross creates ZeroCross without any source, edges must be provided via Edge
+func NewZeroCross() *ZeroCross {
+	return &ZeroCross{
+		tickers: make(map[*zeroCrossTicker]struct{}),
+	}
+}
+
+// NewGpioZeroCross creates ZeroCross, which relies on rising edge of zero-cross detector connected to pin
+func NewGpioZeroCross(pin gpio.Pin, id string) (*ZeroCross, error) {
+	z := NewZeroCross()
+	var err error
+	z.in, err = gpio.Input(pin, id, gpiod.WithRisingEdge, gpiod.WithEventHandler(z.eventHandler))
+	if err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Close releases gpio, if ZeroCross was created on it
+func (z *ZeroCross) Close() error {
+	if z.in != nil {
+		return z.in.Close()
+	}
+	return nil
+}
+
+// Edge passes zero-cross event to all started Tickers
+func (z *ZeroCross) Edge(stamp time.Time) {
+	z.mtx.Lock()
+	defer z.mtx.Unlock()
+	for t := range z.tickers {
+		t.edge(stamp)
+	}
+}
+
+// Ticker returns new Ticker, which ticks on each zero-cross
+func (z *ZeroCross) Ticker() Ticker {
+	return &zeroCrossTicker{
+		source: z,
+		tick:   make(chan time.Time, 1),
+		err:    make(chan error, 1),
+	}
+}
+
+func (z *ZeroCross) eventHandler(gpiod.LineEvent) {
+	z.Edge(time.Now())
+}
+
+func (z *ZeroCross) subscribe(t *zeroCrossTicker) {
+	z.mtx.Lock()
+	defer z.mtx.Unlock()
+	z.tickers[t] = struct{}{}
+}
+
+func (z *ZeroCross) unsubscribe(t *zeroCrossTicker) {
+	z.mtx.Lock()
+	defer z.mtx.Unlock()
+	delete(z.tickers, t)
+}
+
+// Start subscribes for edges, d is expected period between edges (10 ms for 50 Hz mains)
+func (t *zeroCrossTicker) Start(d time.Duration) {
+	t.mtx.Lock()
+	t.timeout = ZeroCrossTimeout * d
+	t.missing = false
+	// Drop leftovers from previous run
+	select {
+	case <-t.err:
+	default:
+	}
+	if t.wdt == nil {
+		t.wdt = time.AfterFunc(t.timeout, t.watchdog)
+	} else {
+		t.wdt.Reset(t.timeout)
+	}
+	t.mtx.Unlock()
+
+	t.source.subscribe(t)
+}
+
+func (t *zeroCrossTicker) Stop() {
+	t.source.unsubscribe(t)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.wdt != nil {
+		t.wdt.Stop()
+	}
+}
+
+func (t *zeroCrossTicker) Tick() <-chan time.Time {
+	return t.tick
+}
+
+func (t *zeroCrossTicker) Fault() <-chan error {
+	return t.err
+}
+
+func (t *zeroCrossTicker) edge(stamp time.Time) {
+	t.mtx.Lock()
+	t.missing = false
+	t.wdt.Reset(t.timeout)
+	t.mtx.Unlock()
+
+	// Don't block other Tickers, if consumer is late
+	select {
+	case t.tick <- stamp:
+	default:
+	}
+}
+
+func (t *zeroCrossTicker) watchdog() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	// Report only once, until edges are back
+	if t.missing {
+		return
+	}
+	t.missing = true
+	select {
+	case t.err <- ErrNoZeroCross:
+	default:
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package heater_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/heater"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ZeroCrossSuite struct {
+	suite.Suite
+}
+
+func TestZeroCrossSuite(t *testing.T) {
+	suite.Run(t, new(ZeroCrossSuite))
+}
+
+func (t *ZeroCrossSuite) TestTicker_FanOut() {
+	r := t.Require()
+	z := heater.NewZeroCross()
+	first, second := z.Ticker(), z.Ticker()
+
+	// Not started tickers don't receive edges
+	z.Edge(time.Now())
+	r.Len(first.Tick(), 0)
+
+	first.Start(time.Second)
+	second.Start(time.Second)
+	defer first.Stop()
+	defer second.Stop()
+
+	stamp := time.Now()
+	z.Edge(stamp)
+	for _, ticker := range []heater.Ticker{first, second} {
+		select {
+		case got := <-ticker.Tick():
+			r.Equal(stamp, got)
+		case <-time.After(10 * time.Millisecond):
+			r.Fail("tick not received")
+		}
+	}
+}
+
+func (t *ZeroCrossSuite) TestTicker_MissingMains() {
+	r := t.Require()
+	z := heater.NewZeroCross()
+	ticker := z.Ticker().(heater.FaultTicker)
+
+	ticker.Start(5 * time.Millisecond)
+	defer ticker.Stop()
+
+	// Edges are coming - no fault
+	for i := 0; i < 10; i++ {
+		z.Edge(time.Now())
+		<-time.After(time.Millisecond)
+	}
+	r.Len(ticker.Fault(), 0)
+
+	// Mains is gone
+	select {
+	case err := <-ticker.Fault():
+		r.ErrorIs(err, heater.ErrNoZeroCross)
+	case <-time.After(100 * time.Millisecond):
+		r.Fail("fault not reported")
+	}
+	// Reported only once
+	select {
+	case <-ticker.Fault():
+		r.Fail("fault reported twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Mains is back, then gone again
+	z.Edge(time.Now())
+	select {
+	case err := <-ticker.Fault():
+		r.ErrorIs(err, heater.ErrNoZeroCross)
+	case <-time.After(100 * time.Millisecond):
+		r.Fail("fault not reported")
+	}
+}
+
+func (t *ZeroCrossSuite) TestHeater_MissingMains() {
+	r := t.Require()
+	z := heater.NewZeroCross()
+	heating := new(HeatingMock)
+	heating.On("Open").Return(nil)
+
+	h, err := heater.New(heater.WithHeating(heating), heater.WithZeroCrossTicker(z))
+	r.Nil(err)
+	r.Nil(h.SetPower(100))
+
+	on := make(chan struct{}, 10)
+	heating.On("Set", true).Return(nil).Run(func(mock.Arguments) {
+		on <- struct{}{}
+	})
+	off := make(chan struct{}, 10)
+	heating.On("Set", false).Return(nil).Run(func(mock.Arguments) {
+		off <- struct{}{}
+	})
+
+	errCh := make(chan error, 10)
+	h.Enable(errCh)
+	defer h.Disable()
+
+	z.Edge(time.Now())
+	select {
+	case <-on:
+	case <-time.After(10 * time.Millisecond):
+		r.Fail("heating not set on edge")
+	}
+
+	// No more edges, heater should turn off output and report error
+	select {
+	case err := <-errCh:
+		r.ErrorIs(err, heater.ErrNoZeroCross)
+	case <-time.After(100 * time.Millisecond):
+		r.Fail("missing mains not reported")
+	}
+	select {
+	case <-off:
+	case <-time.After(10 * time.Millisecond):
+		r.Fail("heating not turned off")
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/iio"
+)
+
+func main() {
+	// Pressure transducer: 0.5 V - 4.5 V is 0 - 10 bar
+	scaling, err := iio.NewScaling(0.5, 4.5, 0, 10, "bar")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Transducer is connected to channel 0 of ADS1115
+	sensor, err := iio.NewSensor(iio.Sysfs(), iio.DevicesPath+"/iio:device0", 0,
+		iio.WithName("pressure"),
+		iio.WithScaling(scaling),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Get few readings - on demand
+	for i := 0; i < 5; i++ {
+		actual, average, err := sensor.Value()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Printf("Actual: %v %s, average: %v %s\n", actual, scaling.Unit, average, scaling.Unit)
+		<-time.After(1 * time.Second)
+	}
+
+	// Handle sensor readings in background
+	if err := sensor.Poll(); err != nil {
+		log.Fatalln(err)
+	}
+
+	// Get few readings
+	<-time.After(3 * time.Second)
+	// Disable background polling
+	sensor.Close()
+
+	// Read stored readings
+	for _, readings := range sensor.GetReadings() {
+		fmt.Printf("id: %s, Voltage: %v, Value: %v. Time: %s, err: %v \n", readings.ID, readings.Voltage, readings.Value, readings.Stamp, readings.Error)
+	}
+
+	fmt.Println("All good!")
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package iio
+
+import (
+	"errors"
+	"os"
+
+	"github.com/a-clap/logging"
+)
+
+var (
+	logger = logging.GetLogger()
+)
+
+var (
+	ErrNoInterface    = errors.New("no interface")
+	ErrAlreadyPolling = errors.New("sensor is already polling")
+	ErrEmptyValue     = errors.New("file returned empty buffer")
+	ErrZeroGain       = errors.New("gain of scaling can't be 0")
+	ErrScalingRange   = errors.New("voltage range of scaling can't be empty")
+	ErrPollInterval   = errors.New("poll interval must be positive")
+)
+
+// DevicesPath is default location of IIO devices on Linux
+const DevicesPath = "/sys/bus/iio/devices"
+
+// FileReaderWriter is access to attributes of IIO device
+type FileReaderWriter interface {
+	WriteFile(name string, data []byte) error
+	ReadFile(name string) ([]byte, error)
+}
+
+var _ FileReaderWriter = (*sysfs)(nil)
+
+// STD implementation
+type sysfs struct {
+}
+
+// Sysfs returns FileReaderWriter, which accesses files directly
+func Sysfs() FileReaderWriter {
+	return &sysfs{}
+}
+
+func (s *sysfs) WriteFile(name string, data []byte) error {
+	return os.WriteFile(name, data, 0644)
+}
+
+func (s *sysfs) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package iio
+
+import (
+	"time"
+)
+
+type Option func(s *Sensor)
+
+// WithID sets unique ID for Sensor - cannot be changed, by default it is device:voltageX
+func WithID(id string) Option {
+	return func(s *Sensor) {
+		s.cfg.ID = id
+	}
+}
+
+// WithName sets Sensor name - it can be changed
+func WithName(name string) Option {
+	return func(s *Sensor) {
+		s.cfg.Name = name
+	}
+}
+
+// WithScaling sets conversion of voltage to engineering units, Volts by default
+func WithScaling(scaling Scaling) Option {
+	return func(s *Sensor) {
+		s.cfg.Scaling = scaling
+	}
+}
+
+// WithSamples sets number of samples used to calculate average
+func WithSamples(samples uint) Option {
+	return func(s *Sensor) {
+		s.cfg.Samples = samples
+	}
+}
+
+// WithPollInterval sets period of reading channel in Poll
+func WithPollInterval(interval time.Duration) Option {
+	return func(s *Sensor) {
+		s.cfg.PollInterval = interval
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package iio
+
+import (
+	"fmt"
+)
+
+// Scaling converts voltage of channel in volts to engineering units:
+// Value = Gain * Voltage + Offset
+type Scaling struct {
+	Gain   float64 `json:"gain"`
+	Offset float64 `json:"offset"`
+	Unit   string  `json:"unit"`
+}
+
+// Volts is Scaling, which reports voltage itself
+var Volts = Scaling{Gain: 1, Offset: 0, Unit: "V"}
+
+// NewScaling returns Scaling, which maps voltLow to low and voltHigh to high,
+// e.g. 0.5 V - 4.5 V output of pressure transducer to 0 - 10 bar
+func NewScaling(voltLow, voltHigh, low, high float64, unit string) (Scaling, error) {
+	if voltLow == voltHigh {
+		return Scaling{}, fmt.Errorf("NewScaling {VoltLow: %v, VoltHigh: %v}: %w", voltLow, voltHigh, ErrScalingRange)
+	}
+	gain := (high - low) / (voltHigh - voltLow)
+	return Scaling{
+		Gain:   gain,
+		Offset: low - gain*voltLow,
+		Unit:   unit,
+	}, nil
+}
+
+// Apply converts voltage in volts to engineering units
+func (s Scaling) Apply(voltage float64) float64 {
+	return s.Gain*voltage + s.Offset
+}
+
+// Validate returns error, if Scaling can't be used
+func (s Scaling) Validate() error {
+	if s.Gain == 0 {
+		return ErrZeroGain
+	}
+	return nil
+}
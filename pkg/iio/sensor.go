@@ -0,0 +1,315 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package iio
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/logging"
+)
+
+// Readings are returned, when Sensor is used in Poll mode
+type Readings struct {
+	ID string `json:"id"`
+	// Raw is value of in_voltageX_raw, Voltage is Raw multiplied by scale, in volts
+	Raw     int64   `json:"raw"`
+	Voltage float64 `json:"voltage"`
+	// Value is Voltage converted by Scaling, Average is computed from Value
+	Value   float64   `json:"value"`
+	Average float64   `json:"average"`
+	Stamp   time.Time `json:"stamp"`
+	Error   string    `json:"error"`
+}
+
+// Sensor represents single voltage channel of IIO device, e.g. ADS1115
+type Sensor struct {
+	FileReaderWriter
+	rawPath, scalePath string
+	polling            atomic.Bool
+	fin, stop          chan struct{}
+	data               chan Readings
+	average            *avg.Avg
+	cfg                SensorConfig
+	readings           []Readings
+	mtx                sync.Mutex
+	// ctl serializes Poll and Close, cfgMtx protects cfg and average
+	ctl    sync.Mutex
+	cfgMtx sync.Mutex
+}
+
+// SensorConfig allows user to configure Sensor (except ID, which is unique and can't be changed)
+type SensorConfig struct {
+	Name         string        `json:"name"`
+	ID           string        `json:"id"`
+	PollInterval time.Duration `json:"poll_interval"`
+	Samples      uint          `json:"samples"`
+	Scaling      Scaling       `json:"scaling"`
+}
+
+// NewSensor creates Sensor of channel on device (e.g. /sys/bus/iio/devices/iio:device0).
+// Channel must have in_voltageX_raw and scale: in_voltageX_scale or in_voltage_scale shared by all channels.
+func NewSensor(o FileReaderWriter, device string, channel uint, options ...Option) (*Sensor, error) {
+	if o == nil {
+		return nil, fmt.Errorf("NewSensor: %w", ErrNoInterface)
+	}
+	voltage := "voltage" + strconv.FormatUint(uint64(channel), 10)
+	id := path.Base(device) + ":" + voltage
+
+	s := &Sensor{
+		FileReaderWriter: o,
+		rawPath:          path.Join(device, "in_"+voltage+"_raw"),
+		scalePath:        path.Join(device, "in_"+voltage+"_scale"),
+		cfg: SensorConfig{
+			Name:         id,
+			ID:           id,
+			PollInterval: 100 * time.Millisecond,
+			Samples:      10,
+			Scaling:      Volts,
+		},
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	if err := s.cfg.validate(); err != nil {
+		return nil, fmt.Errorf("NewSensor {ID: %v}: %w", s.cfg.ID, err)
+	}
+	s.average = avg.New(s.cfg.Samples)
+
+	// Scale may be shared by all channels
+	if _, err := s.scale(); err != nil {
+		s.scalePath = path.Join(device, "in_voltage_scale")
+		if _, errShared := s.scale(); errShared != nil {
+			// Both are reported, otherwise reason of missing shared scale is lost
+			return nil, fmt.Errorf("NewSensor.scale {ID: %v}: %w (shared: %v)", s.cfg.ID, err, errShared)
+		}
+	}
+
+	return s, nil
+}
+
+// ID returns unique ID of Sensor
+func (s *Sensor) ID() string {
+	return s.cfg.ID
+}
+
+// Poll is an option to run updates in background
+// After calling Poll, user can get data from GetReadings.
+func (s *Sensor) Poll() error {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
+	if s.polling.Load() {
+		return fmt.Errorf("Poll {ID: %v}: %w", s.ID(), ErrAlreadyPolling)
+	}
+
+	s.polling.Store(true)
+	s.fin = make(chan struct{})
+	s.stop = make(chan struct{})
+	s.data = make(chan Readings, 10)
+	go s.poll()
+	return nil
+}
+
+// Value returns current value in engineering units and average (which is based on Samples)
+func (s *Sensor) Value() (actual, average float64, err error) {
+	r, err := s.measure()
+	return r.Value, r.Average, err
+}
+
+// Voltage returns current voltage of channel in volts, it is not averaged
+func (s *Sensor) Voltage() (float64, error) {
+	raw, scale, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	return voltage(raw, scale), nil
+}
+
+// Average returns current average value
+func (s *Sensor) Average() float64 {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.average.Average()
+}
+
+// GetReadings returns all collected readings and then clears data
+func (s *Sensor) GetReadings() []Readings {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.readings) == 0 {
+		return nil
+	}
+	c := make([]Readings, len(s.readings))
+	copy(c, s.readings)
+	s.readings = nil
+	return c
+}
+
+// Configure allows user to configure sensor with SensorConfig
+func (s *Sensor) Configure(config SensorConfig) error {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	if err := config.validate(); err != nil {
+		return fmt.Errorf("Configure {ID: %v}: %w", s.cfg.ID, err)
+	}
+
+	if s.cfg.Samples != config.Samples {
+		s.average.Resize(config.Samples)
+		s.cfg.Samples = config.Samples
+	}
+	// Average of previous values in other units is useless
+	if s.cfg.Scaling != config.Scaling {
+		s.average = avg.New(s.cfg.Samples)
+		s.cfg.Scaling = config.Scaling
+	}
+	s.cfg.Name = config.Name
+	s.cfg.PollInterval = config.PollInterval
+	return nil
+}
+
+func (c SensorConfig) validate() error {
+	if c.PollInterval <= 0 {
+		return fmt.Errorf("{PollInterval: %v}: %w", c.PollInterval, ErrPollInterval)
+	}
+	return c.Scaling.Validate()
+}
+
+// GetConfig returns current config
+func (s *Sensor) GetConfig() SensorConfig {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.cfg
+}
+
+// Close should be called, if user used Poll
+func (s *Sensor) Close() error {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
+	if !s.polling.Load() {
+		// Nothing to do
+		return nil
+	}
+
+	// Close stop channel to signal finish of polling
+	close(s.stop)
+	// Unblock poll
+	for range s.data {
+	}
+	// Wait until finish
+	for range s.fin {
+	}
+
+	return nil
+}
+
+// measure reads channel and converts it with Scaling, value is averaged.
+// ID, Stamp and Error of readings are left for caller
+func (s *Sensor) measure() (readings Readings, err error) {
+	raw, scale, err := s.read()
+	if err != nil {
+		return readings, err
+	}
+	readings.Raw, readings.Voltage = raw, voltage(raw, scale)
+
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	readings.Value = s.cfg.Scaling.Apply(readings.Voltage)
+	s.average.Add(readings.Value)
+	readings.Average = s.average.Average()
+	return readings, nil
+}
+
+// read returns raw value and scale of channel
+func (s *Sensor) read() (raw int64, scale float64, err error) {
+	buf, err := s.readFile(s.rawPath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("read.readFile {ID: %v, path: %v}: %w", s.ID(), s.rawPath, err)
+	}
+	if raw, err = strconv.ParseInt(buf, 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("read.ParseInt {ID: %v, path: %v, value: %v}: %w", s.ID(), s.rawPath, buf, err)
+	}
+	if scale, err = s.scale(); err != nil {
+		return 0, 0, fmt.Errorf("read: %w", err)
+	}
+	return raw, scale, nil
+}
+
+// scale reads scale of channel, Linux reports it in millivolts per LSB
+func (s *Sensor) scale() (float64, error) {
+	buf, err := s.readFile(s.scalePath)
+	if err != nil {
+		return 0, fmt.Errorf("scale.readFile {ID: %v, path: %v}: %w", s.ID(), s.scalePath, err)
+	}
+	scale, err := strconv.ParseFloat(buf, 64)
+	if err != nil {
+		return 0, fmt.Errorf("scale.ParseFloat {ID: %v, path: %v, value: %v}: %w", s.ID(), s.scalePath, buf, err)
+	}
+	return scale, nil
+}
+
+func (s *Sensor) poll() {
+	// Goroutine may outlive Close, so it can't read s.data, which is replaced by next Poll
+	go func(data chan Readings) {
+		for r := range data {
+			s.add(r)
+		}
+	}(s.data)
+
+	for s.polling.Load() {
+		select {
+		case <-s.stop:
+			s.polling.Store(false)
+		case <-time.After(s.GetConfig().PollInterval):
+			r, err := s.measure()
+			var e string
+			if err != nil {
+				e = err.Error()
+			}
+			r.ID, r.Stamp, r.Error = s.ID(), time.Now(), e
+			if e != "" {
+				logger.Error("error on iio.Poll", logging.Reflect("readings", r))
+			}
+			s.data <- r
+		}
+	}
+	// For sure there won't be more data
+	close(s.data)
+	// Notify we are done
+	close(s.fin)
+}
+
+func (s *Sensor) readFile(path string) (string, error) {
+	buf, err := s.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	r := strings.TrimSpace(string(buf))
+	if len(r) == 0 {
+		return "", ErrEmptyValue
+	}
+	return r, nil
+}
+
+func (s *Sensor) add(r Readings) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.readings = append(s.readings, r)
+	if len(s.readings) > 100 {
+		s.readings = s.readings[1:]
+	}
+}
+
+// voltage converts raw value to volts
+func voltage(raw int64, scale float64) float64 {
+	return float64(raw) * scale / 1000
+}
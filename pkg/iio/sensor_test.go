@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package iio_test
+
+import (
+	"io/fs"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/iio"
+	"github.com/stretchr/testify/suite"
+)
+
+type SensorSuite struct {
+	suite.Suite
+}
+
+// SysfsFake keeps attributes of IIO devices in map
+type SysfsFake struct {
+	mtx   sync.Mutex
+	files map[string]string
+}
+
+const device = "/sys/bus/iio/devices/iio:device0"
+
+func TestSensorSuite(t *testing.T) {
+	suite.Run(t, new(SensorSuite))
+}
+
+// newADS1115 returns ADS1115 with 4 channels, each with own scale (gain 2.048 V)
+func newADS1115() *SysfsFake {
+	f := &SysfsFake{files: make(map[string]string)}
+	for i := 0; i < 4; i++ {
+		ch := device + "/in_voltage" + strconv.Itoa(i)
+		f.files[ch+"_raw"] = "0\n"
+		f.files[ch+"_scale"] = "0.062500000\n"
+	}
+	return f
+}
+
+func (f *SysfsFake) set(name, value string) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.files[name] = value + "\n"
+}
+
+func (f *SysfsFake) remove(name string) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	delete(f.files, name)
+}
+
+func (f *SysfsFake) WriteFile(name string, data []byte) error {
+	f.set(name, string(data))
+	return nil
+}
+
+func (f *SysfsFake) ReadFile(name string) ([]byte, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	v, ok := f.files[name]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return []byte(v), nil
+}
+
+func (t *SensorSuite) TestNew() {
+	r := t.Require()
+	f := newADS1115()
+
+	s, err := iio.NewSensor(f, device, 1)
+	r.Nil(err)
+	r.Equal("iio:device0:voltage1", s.ID())
+	r.Equal(iio.SensorConfig{
+		Name:         "iio:device0:voltage1",
+		ID:           "iio:device0:voltage1",
+		PollInterval: 100 * time.Millisecond,
+		Samples:      10,
+		Scaling:      iio.Volts,
+	}, s.GetConfig())
+
+	scaling := iio.Scaling{Gain: 2.5, Offset: -1.25, Unit: "bar"}
+	s, err = iio.NewSensor(f, device, 2,
+		iio.WithID("pressure"),
+		iio.WithName("boiler"),
+		iio.WithScaling(scaling),
+		iio.WithSamples(3),
+		iio.WithPollInterval(time.Second),
+	)
+	r.Nil(err)
+	r.Equal(iio.SensorConfig{
+		Name:         "boiler",
+		ID:           "pressure",
+		PollInterval: time.Second,
+		Samples:      3,
+		Scaling:      scaling,
+	}, s.GetConfig())
+}
+
+func (t *SensorSuite) TestNew_Errors() {
+	r := t.Require()
+
+	s, err := iio.NewSensor(nil, device, 0)
+	r.Nil(s)
+	r.ErrorIs(err, iio.ErrNoInterface)
+
+	// Channel doesn't exist
+	s, err = iio.NewSensor(newADS1115(), device, 4)
+	r.Nil(s)
+	r.ErrorIs(err, fs.ErrNotExist)
+	r.ErrorContains(err, "in_voltage4_scale")
+	r.ErrorContains(err, "in_voltage_scale")
+
+	s, err = iio.NewSensor(newADS1115(), device, 0, iio.WithScaling(iio.Scaling{}))
+	r.Nil(s)
+	r.ErrorIs(err, iio.ErrZeroGain)
+
+	s, err = iio.NewSensor(newADS1115(), device, 0, iio.WithPollInterval(0))
+	r.Nil(s)
+	r.ErrorIs(err, iio.ErrPollInterval)
+
+	f := newADS1115()
+	f.set(device+"/in_voltage0_scale", "magic")
+	s, err = iio.NewSensor(f, device, 0)
+	r.Nil(s)
+	r.ErrorContains(err, "magic")
+}
+
+func (t *SensorSuite) TestValue() {
+	r := t.Require()
+	f := newADS1115()
+	scaling, err := iio.NewScaling(0.5, 4.5, 0, 10, "bar")
+	r.Nil(err)
+
+	s, err := iio.NewSensor(f, device, 3, iio.WithScaling(scaling), iio.WithSamples(2))
+	r.Nil(err)
+
+	args := []struct {
+		raw     string
+		scale   string
+		voltage float64
+		value   float64
+		average float64
+	}{
+		{raw: "8000", scale: "0.0625", voltage: 0.5, value: 0, average: 0},
+		{raw: "40000", scale: "0.0625", voltage: 2.5, value: 5, average: 2.5},
+		// gain changed to 4.096 V
+		{raw: "36000", scale: "0.125", voltage: 4.5, value: 10, average: 7.5},
+		{raw: "-800", scale: "0.125", voltage: -0.1, value: -1.5, average: 4.25},
+	}
+	for _, arg := range args {
+		f.set(device+"/in_voltage3_raw", arg.raw)
+		f.set(device+"/in_voltage3_scale", arg.scale)
+
+		v, err := s.Voltage()
+		r.Nil(err, arg.raw)
+		r.InDelta(arg.voltage, v, 1e-9, arg.raw)
+
+		value, average, err := s.Value()
+		r.Nil(err, arg.raw)
+		r.InDelta(arg.value, value, 1e-9, arg.raw)
+		r.InDelta(arg.average, average, 1e-9, arg.raw)
+		r.InDelta(arg.average, s.Average(), 1e-9, arg.raw)
+	}
+}
+
+func (t *SensorSuite) TestValue_SharedScale() {
+	r := t.Require()
+	f := newADS1115()
+	f.remove(device + "/in_voltage0_scale")
+	f.set(device+"/in_voltage_scale", "0.5")
+	f.set(device+"/in_voltage0_raw", "1000")
+
+	s, err := iio.NewSensor(f, device, 0)
+	r.Nil(err)
+	v, err := s.Voltage()
+	r.Nil(err)
+	r.InDelta(0.5, v, 1e-9)
+}
+
+func (t *SensorSuite) TestValue_Errors() {
+	r := t.Require()
+	f := newADS1115()
+	s, err := iio.NewSensor(f, device, 0)
+	r.Nil(err)
+
+	f.set(device+"/in_voltage0_raw", "")
+	_, _, err = s.Value()
+	r.ErrorIs(err, iio.ErrEmptyValue)
+
+	f.set(device+"/in_voltage0_raw", "12.5")
+	_, _, err = s.Value()
+	r.ErrorContains(err, "12.5")
+	r.ErrorContains(err, "in_voltage0_raw")
+
+	f.remove(device + "/in_voltage0_raw")
+	_, err = s.Voltage()
+	r.ErrorIs(err, fs.ErrNotExist)
+}
+
+func (t *SensorSuite) TestConfigure() {
+	r := t.Require()
+	f := newADS1115()
+	s, err := iio.NewSensor(f, device, 0, iio.WithSamples(4))
+	r.Nil(err)
+
+	f.set(device+"/in_voltage0_raw", "16000")
+	_, average, err := s.Value()
+	r.Nil(err)
+	r.InDelta(1.0, average, 1e-9)
+
+	cfg := s.GetConfig()
+	cfg.ID = "can't change"
+	cfg.Name = "level"
+	cfg.PollInterval = time.Second
+	cfg.Samples = 2
+	r.Nil(s.Configure(cfg))
+	cfg.ID = s.ID()
+	r.Equal(cfg, s.GetConfig())
+	// Same units, average is kept
+	r.InDelta(1.0, s.Average(), 1e-9)
+
+	cfg.Scaling = iio.Scaling{Gain: 100, Unit: "%"}
+	r.Nil(s.Configure(cfg))
+	r.Equal(cfg, s.GetConfig())
+	r.InDelta(0.0, s.Average(), 1e-9)
+	value, average, err := s.Value()
+	r.Nil(err)
+	r.InDelta(100.0, value, 1e-9)
+	r.InDelta(100.0, average, 1e-9)
+
+	wrong := cfg
+	wrong.Scaling.Gain = 0
+	r.ErrorIs(s.Configure(wrong), iio.ErrZeroGain)
+	r.Equal(cfg, s.GetConfig())
+
+	// Poll would spin without interval
+	for _, interval := range []time.Duration{0, -time.Second} {
+		wrong = cfg
+		wrong.PollInterval = interval
+		r.ErrorIs(s.Configure(wrong), iio.ErrPollInterval)
+		r.Equal(cfg, s.GetConfig())
+	}
+}
+
+func (t *SensorSuite) TestPoll() {
+	r := t.Require()
+	f := newADS1115()
+	f.set(device+"/in_voltage1_raw", "32000")
+	s, err := iio.NewSensor(f, device, 1, iio.WithPollInterval(5*time.Millisecond))
+	r.Nil(err)
+
+	r.Nil(s.Poll())
+	r.ErrorIs(s.Poll(), iio.ErrAlreadyPolling)
+	<-time.After(50 * time.Millisecond)
+	r.Nil(s.Close())
+	r.Nil(s.Close())
+
+	readings := s.GetReadings()
+	r.NotEmpty(readings)
+	for _, reading := range readings {
+		r.Equal("iio:device0:voltage1", reading.ID)
+		r.Empty(reading.Error)
+		r.Equal(int64(32000), reading.Raw)
+		r.InDelta(2.0, reading.Voltage, 1e-9)
+		r.InDelta(2.0, reading.Value, 1e-9)
+		r.InDelta(2.0, reading.Average, 1e-9)
+		r.False(reading.Stamp.IsZero())
+	}
+	r.Nil(s.GetReadings())
+
+	// Errors are reported in readings
+	f.remove(device + "/in_voltage1_raw")
+	r.Nil(s.Poll())
+	<-time.After(20 * time.Millisecond)
+	r.Nil(s.Close())
+	readings = s.GetReadings()
+	r.NotEmpty(readings)
+	r.NotEmpty(readings[0].Error)
+}
+
+func (t *SensorSuite) TestNewScaling() {
+	r := t.Require()
+	scaling, err := iio.NewScaling(0.5, 4.5, 0, 10, "bar")
+	r.Nil(err)
+	r.Equal(iio.Scaling{Gain: 2.5, Offset: -1.25, Unit: "bar"}, scaling)
+	r.InDelta(10.0, scaling.Apply(4.5), 1e-9)
+
+	// Inverted range
+	scaling, err = iio.NewScaling(0, 5, 100, 0, "%")
+	r.Nil(err)
+	r.InDelta(100.0, scaling.Apply(0), 1e-9)
+	r.InDelta(25.0, scaling.Apply(3.75), 1e-9)
+
+	_, err = iio.NewScaling(1, 1, 0, 10, "bar")
+	r.ErrorIs(err, iio.ErrScalingRange)
+}
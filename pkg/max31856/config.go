@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31856
+
+import (
+	"fmt"
+)
+
+// Type is type of thermocouple
+type Type string
+
+// Supported thermocouples
+const (
+	TypeB Type = "B"
+	TypeE Type = "E"
+	TypeJ Type = "J"
+	TypeK Type = "K"
+	TypeN Type = "N"
+	TypeR Type = "R"
+	TypeS Type = "S"
+	TypeT Type = "T"
+)
+
+// Filter is frequency of mains, which is rejected by ADC of Max
+type Filter int
+
+// Filter options
+const (
+	Filter50Hz Filter = 50
+	Filter60Hz Filter = 60
+)
+
+// Bits of CR0 register
+const (
+	filter50Hz uint8 = iota
+	faultClr
+	faultMode
+	cjDisable
+	ocFault0
+	ocFault1
+	oneShot
+	cMode
+)
+
+// Resolution of temperature registers
+const (
+	tcResolution = 0.0078125
+	cjResolution = 0.015625
+)
+
+var tcTypes = map[Type]uint8{
+	TypeB: 0,
+	TypeE: 1,
+	TypeJ: 2,
+	TypeK: 3,
+	TypeN: 4,
+	TypeR: 5,
+	TypeS: 6,
+	TypeT: 7,
+}
+
+// averaging maps number of samples averaged by Max to AVGSEL bits
+var averaging = map[uint]uint8{
+	1:  0,
+	2:  1,
+	4:  2,
+	8:  3,
+	16: 4,
+}
+
+// configReg holds values of CR0 and CR1 chosen by user
+type configReg struct {
+	tcType    Type
+	filter    Filter
+	averaging uint
+}
+
+func newConfig() *configReg {
+	return &configReg{
+		tcType:    TypeK,
+		filter:    Filter50Hz,
+		averaging: 1,
+	}
+}
+
+// cr0 returns value of CR0: continuous conversion and open-circuit detection are always on,
+// faults work in comparator mode, so they are cleared by Max, when reason is gone
+func (c *configReg) cr0() uint8 {
+	value := uint8(1<<cMode | 1<<ocFault0)
+	if c.filter != Filter60Hz {
+		value |= 1 << filter50Hz
+	}
+	return value
+}
+
+// cr1 returns value of CR1 - averaging and thermocouple type
+func (c *configReg) cr1() uint8 {
+	return averaging[c.averaging]<<4 | tcTypes[c.tcType]
+}
+
+// check verifies if configReg can be written to Max
+func (c *configReg) check() error {
+	if _, ok := tcTypes[c.tcType]; !ok {
+		return fmt.Errorf("{Type: %v}: %w", c.tcType, ErrUnknownType)
+	}
+	if c.filter != Filter50Hz && c.filter != Filter60Hz {
+		return fmt.Errorf("{Filter: %v}: %w", c.filter, ErrUnknownFilter)
+	}
+	if _, ok := averaging[c.averaging]; !ok {
+		return fmt.Errorf("{Averaging: %v}: %w", c.averaging, ErrAveraging)
+	}
+	return nil
+}
+
+// thermocouple converts LTCBH, LTCBM, LTCBL registers to temperature, value is 19-bit two's complement
+func thermocouple(h, m, l byte) float64 {
+	raw := int32(uint32(h)<<24|uint32(m)<<16|uint32(l)<<8) >> 13
+	return float64(raw) * tcResolution
+}
+
+// coldJunction converts CJTH, CJTL registers to temperature, value is 14-bit two's complement
+func coldJunction(h, l byte) float64 {
+	raw := int16(uint16(h)<<8|uint16(l)) >> 2
+	return float64(raw) * cjResolution
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31856
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInterface         = errors.New("error on interface usage")
+	ErrReadZeroes        = errors.New("read only zeroes from device")
+	ErrReadFF            = errors.New("read only 0xFF from device")
+	ErrFault             = errors.New("thermocouple fault")
+	ErrAlreadyPolling    = errors.New("max is already polling")
+	ErrNoReadyInterface  = errors.New("lack of Ready interface")
+	ErrNoReadWriteCloser = errors.New("lack of ReadWriterCloser interface")
+	ErrTooMuchTriggers   = errors.New("poll received too much triggers")
+	ErrUnknownType       = errors.New("unknown thermocouple type")
+	ErrUnknownFilter     = errors.New("unknown filter, expected 50 or 60 Hz")
+	ErrAveraging         = errors.New("averaging must be 1, 2, 4, 8 or 16")
+)
+
+// Fault is a bitmask of fault status register
+type Fault uint8
+
+// Possible faults, bits are the same as in fault status register
+const (
+	// FaultOpen is open thermocouple
+	FaultOpen Fault = 1 << iota
+	// FaultVoltage is overvoltage or undervoltage on inputs
+	FaultVoltage
+	// FaultTCLow and FaultTCHigh are thermocouple temperature outside of thresholds
+	FaultTCLow
+	FaultTCHigh
+	// FaultCJLow and FaultCJHigh are cold-junction temperature outside of thresholds
+	FaultCJLow
+	FaultCJHigh
+	// FaultTCRange is thermocouple temperature outside of range of its type
+	FaultTCRange
+	// FaultCJRange is cold-junction temperature outside of -55 to 125 °C
+	FaultCJRange
+)
+
+var faultCauses = [...]string{
+	"Open thermocouple",
+	"Overvoltage or undervoltage fault",
+	"Thermocouple temperature below low threshold",
+	"Thermocouple temperature above high threshold",
+	"Cold-junction temperature below low threshold",
+	"Cold-junction temperature above high threshold",
+	"Thermocouple temperature out of range",
+	"Cold-junction temperature out of range",
+}
+
+// FaultStatus holds faults and their description
+type FaultStatus struct {
+	Fault     Fault  `json:"fault"`
+	FaultText string `json:"fault_text"`
+}
+
+func newFaultStatus(sr byte) FaultStatus {
+	var causes []string
+	for i, cause := range faultCauses {
+		if sr&(1<<i) != 0 {
+			causes = append(causes, cause)
+		}
+	}
+	return FaultStatus{
+		Fault:     Fault(sr),
+		FaultText: strings.Join(causes, ", "),
+	}
+}
+
+// Has returns true, if all faults from f are set
+func (f Fault) Has(fault Fault) bool {
+	return f&fault == fault
+}
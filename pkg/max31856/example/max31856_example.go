@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/max31856"
+)
+
+func main() {
+	// Create new sensor with initial configuration
+	sensor, err := max31856.NewSensor(
+		max31856.WithSpidev("/dev/spidev1.0"),
+		max31856.WithType(max31856.TypeK),
+		max31856.WithFilter(max31856.Filter50Hz),
+		max31856.WithAveraging(4),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Get few readings - on demand
+	for i := 0; i < 5; i++ {
+		actual, average, err := sensor.Temperature()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		coldJunction, err := sensor.ColdJunction()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Printf("Actual: %v, average: %v, cold junction: %v\n", actual, average, coldJunction)
+		<-time.After(1 * time.Second)
+	}
+
+	// Handle sensor readings in background
+	err = sensor.Poll()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Get few readings
+	<-time.After(3 * time.Second)
+	// Disable background polling
+	sensor.Close()
+
+	// Read stored readings
+	reads := sensor.GetReadings()
+	// Print it to user
+	for _, readings := range reads {
+		fmt.Printf("id: %s, Temperature: %v. Time: %s, err: %v, fault: %v \n", readings.ID, readings.Temperature, readings.Stamp, readings.Error, readings.FaultText)
+	}
+
+	fmt.Println("All good!")
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31856
+
+import (
+	"io"
+
+	"github.com/a-clap/logging"
+)
+
+var (
+	logger = logging.GetLogger()
+)
+
+// Internal registers in Max
+const (
+	regCR0 = iota
+	regCR1
+	regMask
+	regCJHF
+	regCJLF
+	regLTHFTH
+	regLTHFTL
+	regLTLFTH
+	regLTLFTL
+	regCJTO
+	regCJTH
+	regCJTL
+	regLTCBH
+	regLTCBM
+	regLTCBL
+	regSR
+)
+
+// ReadWriteCloser is full duplex communication with Max31856
+type ReadWriteCloser interface {
+	io.Closer
+	ReadWrite(write []byte) (read []byte, err error)
+}
+
+// Ready is an interface which allows to register a callback
+// max31856 has a pin DRDY, which goes low, when new conversion is ready, this interface should rely on that pin
+type Ready interface {
+	Open(callback func()) error
+	Close()
+}
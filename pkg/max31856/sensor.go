@@ -0,0 +1,450 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31856
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/logging"
+)
+
+// Sensor is representation of each Max31856
+type Sensor struct {
+	ReadWriteCloser
+	configReg       *configReg
+	trig, fin, stop chan struct{}
+	err             chan error
+	data            chan Readings
+	cfg             SensorConfig
+	average         *avg.Avg
+	fault           FaultStatus
+	polling         atomic.Bool
+	ready           Ready
+	readings        []Readings
+	mtx             sync.Mutex
+	// ctl serializes Poll and Close, cfgMtx protects cfg, average and fault, ioMtx serializes access to registers
+	ctl    sync.Mutex
+	cfgMtx sync.Mutex
+	ioMtx  sync.Mutex
+}
+
+// SensorConfig holds configuration for Sensor
+type SensorConfig struct {
+	Name         string        `json:"name"`
+	ID           string        `json:"id"`
+	Correction   float64       `json:"correction"`
+	ASyncPoll    bool          `json:"a_sync_poll"`
+	PollInterval time.Duration `json:"poll_interval"`
+	Samples      uint          `json:"samples"`
+	// Type, Filter and Averaging are set in Max, zero values mean TypeK, Filter50Hz and single sample
+	Type      Type   `json:"type"`
+	Filter    Filter `json:"filter"`
+	Averaging uint   `json:"averaging"`
+}
+
+// Readings is a structure returned, when user uses Poll
+type Readings struct {
+	ID           string    `json:"id"`
+	Temperature  float64   `json:"temperature"`
+	Average      float64   `json:"average"`
+	ColdJunction float64   `json:"cold_junction"`
+	Stamp        time.Time `json:"stamp"`
+	Error        string    `json:"error"`
+	FaultStatus
+}
+
+// NewSensor creates Sensor with provided options
+func NewSensor(options ...Option) (*Sensor, error) {
+	s := &Sensor{
+		ReadWriteCloser: nil,
+		configReg:       newConfig(),
+		readings:        nil,
+		mtx:             sync.Mutex{},
+		cfg: SensorConfig{
+			ID:           "",
+			Correction:   0,
+			ASyncPoll:    false,
+			PollInterval: 100 * time.Millisecond,
+			Samples:      10,
+		},
+	}
+
+	for _, opt := range options {
+		if err := opt(s); err != nil {
+			return nil, fmt.Errorf("NewSensor: %w", err)
+		}
+	}
+	s.average = avg.New(s.cfg.Samples)
+
+	// verify after parsing opts
+	if err := s.verify(); err != nil {
+		return nil, fmt.Errorf("NewSensor.Verify: %w", err)
+	}
+	s.cfg.normalize()
+	*s.configReg = s.cfg.hardware()
+	if err := s.configReg.check(); err != nil {
+		return nil, fmt.Errorf("NewSensor.check: %w", err)
+	}
+
+	// Do initial configuration
+	if err := s.config(); err != nil {
+		return nil, fmt.Errorf("NewSensor.config: %w", err)
+	}
+
+	return s, nil
+}
+
+// Poll allows user to enable background temperature updates
+// Then data can be retrieved by calling GetReadings()
+func (s *Sensor) Poll() (err error) {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
+	if s.polling.Load() {
+		return fmt.Errorf("Poll {ID: %v}: %w", s.ID(), ErrAlreadyPolling)
+	}
+
+	s.fin = make(chan struct{})
+	s.stop = make(chan struct{})
+	s.err = make(chan error, 10)
+	s.data = make(chan Readings, 10)
+
+	cfg := s.GetConfig()
+	s.polling.Store(true)
+	if cfg.ASyncPoll {
+		err = s.prepareAsyncPoll()
+	} else {
+		err = s.prepareSyncPoll(cfg.PollInterval)
+	}
+
+	if err != nil {
+		s.polling.Store(false)
+		return fmt.Errorf("Poll {ID: %v}: %w", s.ID(), err)
+	}
+	go s.poll(cfg.ASyncPoll)
+
+	return
+}
+
+// GetReadings returns accumulated data by Poll, clears data on read
+func (s *Sensor) GetReadings() []Readings {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if len(s.readings) == 0 {
+		return nil
+	}
+	c := make([]Readings, len(s.readings))
+	copy(c, s.readings)
+	s.readings = nil
+	return c
+}
+
+// Configure is a way to set Config
+func (s *Sensor) Configure(config SensorConfig) error {
+	// Registers are written, so conversion can't be read in the meantime
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	if config.ASyncPoll {
+		if s.ready == nil {
+			return fmt.Errorf("Configure {ID: %v, Config: %v}: %w", s.ID(), config, ErrNoReadyInterface)
+		}
+	}
+	config.normalize()
+	if hw := config.hardware(); hw != *s.configReg {
+		if err := hw.check(); err != nil {
+			return fmt.Errorf("Configure {ID: %v}: %w", s.ID(), err)
+		}
+		if err := s.setConfig(hw); err != nil {
+			return fmt.Errorf("Configure.setConfig {ID: %v}: %w", s.ID(), err)
+		}
+		s.cfg.Type, s.cfg.Filter, s.cfg.Averaging = config.Type, config.Filter, config.Averaging
+	}
+	if s.cfg.Samples != config.Samples {
+		s.average.Resize(config.Samples)
+		s.cfg.Samples = config.Samples
+	}
+	s.cfg.Name = config.Name
+	s.cfg.ASyncPoll = config.ASyncPoll
+	s.cfg.PollInterval = config.PollInterval
+	s.cfg.Correction = config.Correction
+
+	return nil
+}
+
+// GetConfig returns current Config
+func (s *Sensor) GetConfig() SensorConfig {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.cfg
+}
+
+// Average returns average temperature
+func (s *Sensor) Average() float64 {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.average.Average()
+}
+
+// Temperature returns actual temperature of thermocouple and average
+func (s *Sensor) Temperature() (actual float64, average float64, err error) {
+	readings, err := s.measure()
+	return readings.Temperature, readings.Average, err
+}
+
+// ColdJunction returns temperature of cold junction (temperature of Max)
+func (s *Sensor) ColdJunction() (float64, error) {
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	r, err := s.read(regCJTH, 2)
+	if err != nil {
+		return 0, fmt.Errorf("ColdJunction.read {ID: %v}: %w", s.ID(), err)
+	}
+	return coldJunction(r[0], r[1]), nil
+}
+
+// Fault returns faults found by last conversion
+func (s *Sensor) Fault() FaultStatus {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.fault
+}
+
+// measure reads temperatures and fault status, corrected temperature is averaged.
+// ID, Stamp and Error of readings are left for caller
+func (s *Sensor) measure() (readings Readings, err error) {
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	// Cold junction, thermocouple and fault status are next to each other
+	r, err := s.read(regCJTH, regSR-regCJTH+1)
+	if err != nil {
+		//	can't do much about it
+		return readings, fmt.Errorf("Temperature.read {ID: %v}: %w", s.ID(), err)
+	}
+	readings.ColdJunction = coldJunction(r[0], r[1])
+	readings.FaultStatus = newFaultStatus(r[regSR-regCJTH])
+
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	s.fault = readings.FaultStatus
+	if readings.Fault != 0 {
+		return readings, fmt.Errorf("Temperature {ID: %v, Fault: %v, Cause: %v}: %w", s.ID(), readings.Fault, readings.FaultText, ErrFault)
+	}
+	readings.Temperature = thermocouple(r[2], r[3], r[4]) + s.cfg.Correction
+	s.average.Add(readings.Temperature)
+	readings.Average = s.average.Average()
+	return readings, nil
+}
+
+// Close should be always called, if user used Poll
+func (s *Sensor) Close() error {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
+	if !s.polling.Load() {
+		return nil
+	}
+	// Close stop channel, notify poll
+	close(s.stop)
+	// Unblock poll
+	for range s.data {
+	}
+	// Wait until finish
+	for range s.fin {
+	}
+
+	return nil
+}
+
+// ID returns unique ID
+func (s *Sensor) ID() string {
+	return s.cfg.ID
+}
+
+func (s *Sensor) prepareSyncPoll(pollTime time.Duration) error {
+	s.trig = make(chan struct{})
+	// Goroutine may outlive Close, so it works on channels of this Poll only
+	go func(stop chan struct{}, trig chan struct{}, errs chan error) {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(pollTime):
+				trigger(trig, errs)
+			}
+		}
+	}(s.stop, s.trig, s.err)
+
+	return nil
+}
+
+func (s *Sensor) prepareAsyncPoll() error {
+	if s.ready == nil {
+		return ErrNoReadyInterface
+	}
+	s.trig = make(chan struct{}, 1)
+	return s.ready.Open(s.callback)
+}
+
+// poll runs until Close, async tells how it was prepared by Poll
+func (s *Sensor) poll(async bool) {
+	go func(data chan Readings) {
+		for r := range data {
+			s.add(r)
+		}
+	}(s.data)
+
+	for s.polling.Load() {
+		select {
+		case <-s.stop:
+			s.polling.Store(false)
+		case <-s.trig:
+			r, err := s.measure()
+			var e string
+			if err != nil {
+				e = err.Error()
+			}
+			r.ID, r.Stamp, r.Error = s.ID(), time.Now(), e
+			if e != "" {
+				logger.Error("error on max31856.Poll", logging.Reflect("readings", r))
+			}
+
+			s.data <- r
+		case e := <-s.err:
+			s.data <- Readings{
+				Error: e.Error(),
+			}
+		}
+	}
+	// For sure there won't be more data
+	close(s.data)
+	if async {
+		s.ready.Close()
+		close(s.trig)
+	}
+
+	// Notify user that we are done
+	s.fin <- struct{}{}
+	close(s.fin)
+}
+
+// setConfig writes new configuration to Max, s.ioMtx must be locked
+func (s *Sensor) setConfig(hw configReg) error {
+	previous := *s.configReg
+	// Filter can't be changed during continuous conversion
+	if err := s.write(regCR0, []byte{s.configReg.cr0() &^ (1 << cMode)}); err != nil {
+		return err
+	}
+	*s.configReg = hw
+	if err := s.config(); err != nil {
+		*s.configReg = previous
+		return err
+	}
+	return nil
+}
+
+// normalize replaces zero values of hardware configuration with defaults
+func (c *SensorConfig) normalize() {
+	if c.Type == "" {
+		c.Type = TypeK
+	}
+	if c.Filter == 0 {
+		c.Filter = Filter50Hz
+	}
+	if c.Averaging == 0 {
+		c.Averaging = 1
+	}
+}
+
+// hardware returns configReg described by SensorConfig
+func (c SensorConfig) hardware() configReg {
+	return configReg{
+		tcType:    c.Type,
+		filter:    c.Filter,
+		averaging: c.Averaging,
+	}
+}
+
+func (s *Sensor) config() error {
+	return s.write(regCR0, []byte{s.configReg.cr0(), s.configReg.cr1()})
+}
+
+func (s *Sensor) read(addr byte, len int) ([]byte, error) {
+	// We need to create slice with 1 byte more
+	w := make([]byte, len+1)
+	w[0] = addr
+	r, err := s.ReadWrite(w)
+	if err != nil {
+		return nil, err
+	}
+	// First byte is useless
+	return r[1:], nil
+}
+
+func (s *Sensor) write(addr byte, w []byte) error {
+	buf := []byte{addr | 0x80}
+	buf = append(buf, w...)
+	_, err := s.ReadWrite(buf)
+	return err
+}
+
+func (s *Sensor) verify() error {
+	// Check if interface exists
+	if s.ReadWriteCloser == nil {
+		return ErrNoReadWriteCloser
+	}
+	// Check interface itself
+	r, err := s.read(regCR0, regSR+1)
+	if err != nil {
+		return ErrInterface
+	}
+	checkReadings := func(expected byte) bool {
+		for _, elem := range r {
+			if elem != expected {
+				return false
+			}
+		}
+		return true
+	}
+
+	if onlyZeroes := checkReadings(0); onlyZeroes {
+		return ErrReadZeroes
+	}
+
+	if onlyFF := checkReadings(0xff); onlyFF {
+		return ErrReadFF
+	}
+
+	return nil
+}
+
+func (s *Sensor) callback() {
+	trigger(s.trig, s.err)
+}
+
+func trigger(trig chan struct{}, errs chan error) {
+	// We don't want to block on channel write, as it may be isr
+	select {
+	case trig <- struct{}{}:
+	default:
+		select {
+		case errs <- ErrTooMuchTriggers:
+		default:
+		}
+	}
+}
+
+func (s *Sensor) add(r Readings) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.readings = append(s.readings, r)
+	if len(s.readings) > 100 {
+		s.readings = s.readings[1:]
+	}
+}
@@ -0,0 +1,436 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31856_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/max31856"
+	"github.com/stretchr/testify/suite"
+)
+
+type SensorSuite struct {
+	suite.Suite
+}
+
+// MaxFake emulates registers of Max31856: address is incremented after each byte,
+// bit 0x80 of address means write
+type MaxFake struct {
+	mtx    sync.Mutex
+	regs   [16]byte
+	writes [][]byte
+	err    error
+}
+
+type ReadyFake struct {
+	cb     func()
+	opened bool
+}
+
+func TestMaxSensor(t *testing.T) {
+	suite.Run(t, new(SensorSuite))
+}
+
+// newMaxFake returns Max after power on reset
+func newMaxFake() *MaxFake {
+	m := &MaxFake{}
+	m.regs[0x02] = 0xFF
+	m.regs[0x03] = 0x7F
+	m.regs[0x04] = 0xC0
+	m.regs[0x05] = 0x7F
+	m.regs[0x06] = 0xFF
+	m.regs[0x07] = 0x80
+	m.regs[0x01] = 0x03
+	return m
+}
+
+func (m *MaxFake) Close() error {
+	return nil
+}
+
+func (m *MaxFake) ReadWrite(write []byte) ([]byte, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	read := make([]byte, len(write))
+	addr := write[0] &^ 0x80
+	if write[0]&0x80 != 0 {
+		m.writes = append(m.writes, append([]byte(nil), write...))
+	}
+	for i := 1; i < len(write); i++ {
+		reg := (int(addr) + i - 1) % len(m.regs)
+		if write[0]&0x80 != 0 {
+			m.regs[reg] = write[i]
+		} else {
+			read[i] = m.regs[reg]
+		}
+	}
+	return read, nil
+}
+
+// setTemperature sets thermocouple and cold-junction registers
+func (m *MaxFake) setTemperature(tc, cj float64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	raw := uint32(int32(tc/0.0078125)) << 5
+	m.regs[0x0C], m.regs[0x0D], m.regs[0x0E] = byte(raw>>16), byte(raw>>8), byte(raw)
+	rawCJ := uint16(int16(cj/0.015625)) << 2
+	m.regs[0x0A], m.regs[0x0B] = byte(rawCJ>>8), byte(rawCJ)
+}
+
+func (m *MaxFake) setFault(sr byte) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.regs[0x0F] = sr
+}
+
+func (m *MaxFake) reg(addr int) byte {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.regs[addr]
+}
+
+func (r *ReadyFake) Open(callback func()) error {
+	r.cb = callback
+	r.opened = true
+	return nil
+}
+
+func (r *ReadyFake) Close() {
+	r.opened = false
+}
+
+func (s *SensorSuite) TestNew() {
+	r := s.Require()
+	args := []struct {
+		name string
+		opts []max31856.Option
+		cr0  byte
+		cr1  byte
+		cfg  max31856.SensorConfig
+	}{
+		{
+			name: "defaults",
+			opts: nil,
+			cr0:  0x91,
+			cr1:  0x03,
+			cfg: max31856.SensorConfig{
+				PollInterval: 100 * time.Millisecond,
+				Samples:      10,
+				Type:         max31856.TypeK,
+				Filter:       max31856.Filter50Hz,
+				Averaging:    1,
+			},
+		},
+		{
+			name: "type J, 60Hz, averaging 4",
+			opts: []max31856.Option{
+				max31856.WithID("tc"),
+				max31856.WithName("name"),
+				max31856.WithType(max31856.TypeJ),
+				max31856.WithFilter(max31856.Filter60Hz),
+				max31856.WithAveraging(4),
+			},
+			cr0: 0x90,
+			cr1: 0x22,
+			cfg: max31856.SensorConfig{
+				ID:           "tc",
+				Name:         "name",
+				PollInterval: 100 * time.Millisecond,
+				Samples:      10,
+				Type:         max31856.TypeJ,
+				Filter:       max31856.Filter60Hz,
+				Averaging:    4,
+			},
+		},
+		{
+			name: "type T, averaging 16",
+			opts: []max31856.Option{
+				max31856.WithType(max31856.TypeT),
+				max31856.WithAveraging(16),
+			},
+			cr0: 0x91,
+			cr1: 0x47,
+			cfg: max31856.SensorConfig{
+				PollInterval: 100 * time.Millisecond,
+				Samples:      10,
+				Type:         max31856.TypeT,
+				Filter:       max31856.Filter50Hz,
+				Averaging:    16,
+			},
+		},
+	}
+	for _, arg := range args {
+		fake := newMaxFake()
+		max, err := max31856.NewSensor(append(arg.opts, max31856.WithReadWriteCloser(fake))...)
+		r.Nil(err, arg.name)
+		r.NotNil(max, arg.name)
+		r.Equal(arg.cr0, fake.reg(0x00), arg.name)
+		r.Equal(arg.cr1, fake.reg(0x01), arg.name)
+		r.Equal(arg.cfg, max.GetConfig(), arg.name)
+	}
+}
+
+func (s *SensorSuite) TestNew_Errors() {
+	r := s.Require()
+	args := []struct {
+		name string
+		opts []max31856.Option
+		regs func(m *MaxFake)
+		err  error
+	}{
+		{
+			name: "lack of interface",
+			opts: nil,
+			err:  max31856.ErrNoReadWriteCloser,
+		},
+		{
+			name: "interface error",
+			regs: func(m *MaxFake) { m.err = errors.New("broken") },
+			err:  max31856.ErrInterface,
+		},
+		{
+			name: "only zeroes",
+			regs: func(m *MaxFake) { m.regs = [16]byte{} },
+			err:  max31856.ErrReadZeroes,
+		},
+		{
+			name: "only 0xFF",
+			regs: func(m *MaxFake) {
+				for i := range m.regs {
+					m.regs[i] = 0xFF
+				}
+			},
+			err: max31856.ErrReadFF,
+		},
+		{
+			name: "unknown type",
+			opts: []max31856.Option{max31856.WithType("X")},
+			err:  max31856.ErrUnknownType,
+		},
+		{
+			name: "unknown filter",
+			opts: []max31856.Option{max31856.WithFilter(55)},
+			err:  max31856.ErrUnknownFilter,
+		},
+		{
+			name: "wrong averaging",
+			opts: []max31856.Option{max31856.WithAveraging(3)},
+			err:  max31856.ErrAveraging,
+		},
+	}
+	for _, arg := range args {
+		opts := arg.opts
+		if arg.err != max31856.ErrNoReadWriteCloser {
+			fake := newMaxFake()
+			if arg.regs != nil {
+				arg.regs(fake)
+			}
+			opts = append(opts, max31856.WithReadWriteCloser(fake))
+		}
+		max, err := max31856.NewSensor(opts...)
+		r.Nil(max, arg.name)
+		r.ErrorIs(err, arg.err, arg.name)
+	}
+}
+
+func (s *SensorSuite) TestTemperature() {
+	r := s.Require()
+	args := []struct {
+		name string
+		tc   float64
+		cj   float64
+	}{
+		{name: "zero", tc: 0, cj: 0},
+		{name: "room", tc: 25.5, cj: 23.25},
+		{name: "high", tc: 1372.0, cj: 125.0},
+		{name: "negative", tc: -200.25, cj: -55.0},
+		{name: "resolution", tc: 0.0078125, cj: -0.015625},
+	}
+	fake := newMaxFake()
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake))
+	r.Nil(err)
+	for _, arg := range args {
+		fake.setTemperature(arg.tc, arg.cj)
+
+		tmp, _, err := max.Temperature()
+		r.Nil(err, arg.name)
+		r.InDelta(arg.tc, tmp, 0.0001, arg.name)
+
+		cj, err := max.ColdJunction()
+		r.Nil(err, arg.name)
+		r.InDelta(arg.cj, cj, 0.0001, arg.name)
+		r.Equal(max31856.FaultStatus{}, max.Fault(), arg.name)
+	}
+}
+
+func (s *SensorSuite) TestTemperature_Average() {
+	r := s.Require()
+	fake := newMaxFake()
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake))
+	r.Nil(err)
+	r.Nil(max.Configure(max31856.SensorConfig{Samples: 2, Correction: 1.0}))
+
+	fake.setTemperature(10, 20)
+	tmp, average, err := max.Temperature()
+	r.Nil(err)
+	r.InDelta(11.0, tmp, 0.0001)
+	r.InDelta(11.0, average, 0.0001)
+
+	fake.setTemperature(20, 20)
+	tmp, average, err = max.Temperature()
+	r.Nil(err)
+	r.InDelta(21.0, tmp, 0.0001)
+	r.InDelta(16.0, average, 0.0001)
+	r.InDelta(16.0, max.Average(), 0.0001)
+}
+
+func (s *SensorSuite) TestFault() {
+	r := s.Require()
+	fake := newMaxFake()
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake))
+	r.Nil(err)
+
+	fake.setTemperature(21.0, 22.0)
+	fake.setFault(0x01)
+	_, _, err = max.Temperature()
+	r.ErrorIs(err, max31856.ErrFault)
+	r.ErrorContains(err, "Open thermocouple")
+
+	fault := max.Fault()
+	r.True(fault.Fault.Has(max31856.FaultOpen))
+	r.False(fault.Fault.Has(max31856.FaultVoltage))
+	r.Equal("Open thermocouple", fault.FaultText)
+
+	fake.setFault(0x42)
+	_, _, err = max.Temperature()
+	r.ErrorIs(err, max31856.ErrFault)
+	fault = max.Fault()
+	r.True(fault.Fault.Has(max31856.FaultVoltage | max31856.FaultTCRange))
+	r.Equal("Overvoltage or undervoltage fault, Thermocouple temperature out of range", fault.FaultText)
+
+	// Comparator mode - fault is gone with its reason
+	fake.setFault(0x00)
+	tmp, _, err := max.Temperature()
+	r.Nil(err)
+	r.InDelta(21.0, tmp, 0.0001)
+	r.Equal(max31856.FaultStatus{}, max.Fault())
+}
+
+func (s *SensorSuite) TestConfigure() {
+	r := s.Require()
+	fake := newMaxFake()
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake), max31856.WithID("tc"))
+	r.Nil(err)
+
+	cfg := max.GetConfig()
+	cfg.Name = "new name"
+	cfg.Type = max31856.TypeJ
+	cfg.Filter = max31856.Filter60Hz
+	cfg.Averaging = 4
+	r.Nil(max.Configure(cfg))
+	r.Equal(byte(0x90), fake.reg(0x00))
+	r.Equal(byte(0x22), fake.reg(0x01))
+	r.Equal(cfg, max.GetConfig())
+
+	// Conversion stopped, before filter is changed
+	last := fake.writes[len(fake.writes)-2:]
+	r.Equal([]byte{0x80, 0x11}, last[0])
+	r.Equal([]byte{0x80, 0x90, 0x22}, last[1])
+
+	// ID can't be changed, zero values are defaults
+	other := cfg
+	other.ID, other.Type, other.Filter, other.Averaging = "other", "", 0, 0
+	r.Nil(max.Configure(other))
+	cfg = max.GetConfig()
+	r.Equal("tc", cfg.ID)
+	r.Equal(max31856.TypeK, cfg.Type)
+	r.Equal(max31856.Filter50Hz, cfg.Filter)
+	r.Equal(uint(1), cfg.Averaging)
+	r.Equal(byte(0x91), fake.reg(0x00))
+	r.Equal(byte(0x03), fake.reg(0x01))
+
+	// Nothing written, if hardware config is the same
+	writes := len(fake.writes)
+	r.Nil(max.Configure(cfg))
+	r.Len(fake.writes, writes)
+
+	// Wrong configuration doesn't change anything
+	wrong := cfg
+	wrong.Averaging = 5
+	r.ErrorIs(max.Configure(wrong), max31856.ErrAveraging)
+	r.Equal(cfg, max.GetConfig())
+	r.Len(fake.writes, writes)
+
+	wrong = cfg
+	wrong.ASyncPoll = true
+	r.ErrorIs(max.Configure(wrong), max31856.ErrNoReadyInterface)
+	r.Equal(cfg, max.GetConfig())
+}
+
+func (s *SensorSuite) TestPoll() {
+	r := s.Require()
+	fake := newMaxFake()
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake), max31856.WithID("tc"))
+	r.Nil(err)
+	cfg := max.GetConfig()
+	cfg.PollInterval = 5 * time.Millisecond
+	r.Nil(max.Configure(cfg))
+
+	fake.setTemperature(100.5, 25.0)
+	r.Nil(max.Poll())
+	r.ErrorIs(max.Poll(), max31856.ErrAlreadyPolling)
+	<-time.After(50 * time.Millisecond)
+	r.Nil(max.Close())
+
+	readings := max.GetReadings()
+	r.NotEmpty(readings)
+	for _, reading := range readings {
+		r.Equal("tc", reading.ID)
+		r.Empty(reading.Error)
+		r.InDelta(100.5, reading.Temperature, 0.0001)
+		r.InDelta(100.5, reading.Average, 0.0001)
+		r.InDelta(25.0, reading.ColdJunction, 0.0001)
+		r.False(reading.Stamp.IsZero())
+	}
+	r.Nil(max.GetReadings())
+}
+
+func (s *SensorSuite) TestPoll_Async() {
+	r := s.Require()
+	fake := newMaxFake()
+	ready := &ReadyFake{}
+	max, err := max31856.NewSensor(max31856.WithReadWriteCloser(fake), max31856.WithReady(ready), max31856.WithID("tc"))
+	r.Nil(err)
+	cfg := max.GetConfig()
+	cfg.ASyncPoll = true
+	r.Nil(max.Configure(cfg))
+
+	fake.setTemperature(-10.0, 20.0)
+	r.Nil(max.Poll())
+	r.True(ready.opened)
+
+	ready.cb()
+	<-time.After(10 * time.Millisecond)
+	fake.setFault(0x01)
+	ready.cb()
+	<-time.After(10 * time.Millisecond)
+	r.Nil(max.Close())
+	r.False(ready.opened)
+
+	readings := max.GetReadings()
+	r.Len(readings, 2)
+	r.InDelta(-10.0, readings[0].Temperature, 0.0001)
+	r.Empty(readings[0].Error)
+	r.Equal(max31856.FaultOpen, readings[1].Fault)
+	r.Equal("Open thermocouple", readings[1].FaultText)
+	r.NotEmpty(readings[1].Error)
+}
@@ -5,6 +5,11 @@
 
 package max31865
 
+import (
+	"math"
+	"time"
+)
+
 type Wiring int
 
 // Wiring options
@@ -14,9 +19,39 @@ const (
 	FourWire
 )
 
-// Registers in Max31865
+// Filter is frequency of mains, which is rejected by ADC of Max
+type Filter int
+
+// Filter options
+const (
+	Filter50Hz Filter = 50
+	Filter60Hz Filter = 60
+)
+
+// Mode is conversion mode of Max
+type Mode string
+
+// Conversion modes
+const (
+	// Continuous keeps VBias on and converts all the time
+	Continuous Mode = "continuous"
+	// OneShot turns VBias on only for single conversion, which limits self-heating of RTD
+	OneShot Mode = "one_shot"
+)
+
 const (
-	filter60Hz uint8 = iota
+	// biasSettle is time after turning VBias on, before conversion starts
+	biasSettle = 10 * time.Millisecond
+	// OneShotMinInterval is the shortest PollInterval in OneShot mode, conversion takes up to 63 ms after VBias settles
+	OneShotMinInterval = 100 * time.Millisecond
+	// faultDetectTime is time of automatic fault detection cycle, faultDetectRetries limits waiting for its end
+	faultDetectTime    = time.Millisecond
+	faultDetectRetries = 10
+)
+
+// Bits of configuration register
+const (
+	filter50Hz uint8 = iota
 	clearFault
 	faultDetect1
 	faultDetect2
@@ -31,6 +66,10 @@ type configReg struct {
 	wiring     Wiring  // chosen by user
 	refRes     float64 // reference resistor (usually 400 or 430)
 	nominalRes float64 // nominal resistor (100 for PT100)
+	filter     Filter
+	mode       Mode
+	conversion Conversion // nil means IEC60751 with nominalRes
+	leadRes    float64    // resistance of leads, compensated only in TwoWire
 }
 
 func newConfig() *configReg {
@@ -38,19 +77,85 @@ func newConfig() *configReg {
 		wiring:     ThreeWire,
 		refRes:     430.0,
 		nominalRes: 100.0,
+		filter:     Filter50Hz,
+		mode:       Continuous,
 	}
 }
 
 // reg returns current value of register - based on config
+// In OneShot mode VBias is off, it is turned on by bias before each conversion
 func (c *configReg) reg() uint8 {
-	const value = uint8((1 << filter60Hz) | (1 << continuous) | (1 << vBias))
-	const wireMsk = 1 << wire3
+	value := uint8(0)
+	if c.mode != OneShot {
+		value |= (1 << continuous) | (1 << vBias)
+	}
+	if c.filter != Filter60Hz {
+		value |= 1 << filter50Hz
+	}
 	if c.wiring == ThreeWire {
-		return value | wireMsk
+		value |= 1 << wire3
 	}
 	return value
 }
 
+// bias returns register with VBias on, used to prepare OneShot conversion
+func (c *configReg) bias() uint8 {
+	return c.reg() | (1 << vBias)
+}
+
+// oneShot returns command which starts single conversion, VBias must be settled
+func (c *configReg) oneShot() uint8 {
+	return c.bias() | (1 << oneShot)
+}
+
+// conversionTime returns time of single conversion, which depends on filter
+func (c *configReg) conversionTime() time.Duration {
+	if c.filter == Filter60Hz {
+		return 53 * time.Millisecond
+	}
+	return 63 * time.Millisecond
+}
+
+// thresholds returns content of fault threshold registers (from HFaultMsb to LFaultLsb) for temperatures.
+// Thresholds are disabled, if low is not lower than high
+func (c *configReg) thresholds(low, high float64) []byte {
+	h, l := uint16(0xFFFF), uint16(0x0000)
+	if low < high {
+		h, l = c.code(high), c.code(low)
+	}
+	return []byte{byte(h >> 8), byte(h), byte(l >> 8), byte(l)}
+}
+
+// code converts temperature to register value of rtd, based on Conversion
+func (c *configReg) code(t float64) uint16 {
+	r := c.rtd().Resistance(t) + c.lead()
+	code := math.Round(r / c.refRes * 32768)
+	code = math.Max(0, math.Min(code, 0x7FFF))
+	// Register keeps rtd in 15 upper bits
+	return uint16(code) << 1
+}
+
+// resistance returns resistance of RTD for value of rtd (without fault bit), lead resistance is compensated
+func (c *configReg) resistance(rtd uint16) float64 {
+	return float64(rtd)/32768*c.refRes - c.lead()
+}
+
+// lead returns resistance of leads in series with RTD, ThreeWire and FourWire compensate it in hardware
+func (c *configReg) lead() float64 {
+	if c.wiring != TwoWire {
+		return 0
+	}
+	return c.leadRes
+}
+
+// rtd returns Conversion chosen by user
+func (c *configReg) rtd() Conversion {
+	if c.conversion == nil {
+		return IEC60751(c.nominalRes)
+	}
+	return c.conversion
+}
+
 // clearFaults returns command which execute internal Max command - faults reset
 func (c *configReg) clearFaults() uint8 {
 	return c.reg() | (1 << clearFault)
@@ -58,7 +163,7 @@ func (c *configReg) clearFaults() uint8 {
 
 // faultDetect returns command which executes internal Max command - fault detect
 func (c *configReg) faultDetect() uint8 {
-	return 0b10000100 | (c.reg() & ((1 << filter60Hz) | (1 << wire3)))
+	return 0b10000100 | (c.reg() & ((1 << filter50Hz) | (1 << wire3)))
 }
 
 // faultDetectFinished returns whether faultDetect is done
@@ -7,6 +7,7 @@ package max31865
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -15,6 +16,8 @@ func TestConfig_reg(t *testing.T) {
 	tests := []struct {
 		name        string
 		wiring      Wiring
+		filter      Filter
+		mode        Mode
 		reg         uint8
 		clearFaults uint8
 		faultDetect uint8
@@ -40,11 +43,33 @@ func TestConfig_reg(t *testing.T) {
 			clearFaults: 0b11010001 | (1 << 1),
 			faultDetect: 0b10010101,
 		},
+		{
+			name:        "three wire, 60 Hz",
+			wiring:      ThreeWire,
+			filter:      Filter60Hz,
+			reg:         0b11010000,
+			clearFaults: 0b11010000 | (1 << 1),
+			faultDetect: 0b10010100,
+		},
+		{
+			name:        "three wire, one shot",
+			wiring:      ThreeWire,
+			mode:        OneShot,
+			reg:         0b00010001,
+			clearFaults: 0b00010001 | (1 << 1),
+			faultDetect: 0b10010101,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newConfig()
 			c.wiring = tt.wiring
+			if tt.filter != 0 {
+				c.filter = tt.filter
+			}
+			if tt.mode != "" {
+				c.mode = tt.mode
+			}
 
 			if got := c.reg(); got != tt.reg {
 				t.Errorf("reg() = %v, reg %v", got, tt.reg)
@@ -61,6 +86,28 @@ func TestConfig_reg(t *testing.T) {
 	}
 }
 
+func TestConfig_oneShot(t *testing.T) {
+	c := newConfig()
+	c.mode = OneShot
+	require.Equal(t, uint8(0b10010001), c.bias())
+	require.Equal(t, uint8(0b10110001), c.oneShot())
+	require.Equal(t, 63*time.Millisecond, c.conversionTime())
+	c.filter = Filter60Hz
+	require.Equal(t, 53*time.Millisecond, c.conversionTime())
+}
+
+func TestConfig_thresholds(t *testing.T) {
+	c := newConfig()
+	c.refRes = 400.0
+	// Values of rtd register from datasheet
+	require.Equal(t, []byte{0x51, 0x54, 0x40, 0x00}, c.thresholds(0, 70))
+	require.Equal(t, []byte{0x40, 0x00, 0x33, 0x66}, c.thresholds(-50, 0))
+	// Disabled
+	require.Equal(t, []byte{0xFF, 0xFF, 0x00, 0x00}, c.thresholds(0, 0))
+	// Out of range is limited
+	require.Equal(t, []byte{0xFF, 0xFE, 0x00, 0x00}, c.thresholds(-300, 2000))
+}
+
 func TestConfig_faultDetectFinished(t *testing.T) {
 
 	wireModes := []Wiring{TwoWire, ThreeWire, FourWire}
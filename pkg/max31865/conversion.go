@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31865
+
+import (
+	"fmt"
+	"math"
+)
+
+// Conversion converts resistance of RTD in ohms to temperature in Celsius and back
+type Conversion interface {
+	Temperature(resistance float64) float64
+	Resistance(temperature float64) float64
+}
+
+// RTD is a name of built-in Conversion
+type RTD string
+
+// Built-in RTDs
+const (
+	// PT100 and PT1000 are platinum RTDs with IEC 60751 coefficients
+	PT100  RTD = "pt100"
+	PT1000 RTD = "pt1000"
+	// Ni100 and Ni120 are nickel RTDs with DIN 43760 coefficients (alpha 0.00618)
+	Ni100 RTD = "ni100"
+	Ni120 RTD = "ni120"
+)
+
+// CVD is Callendar-Van Dusen equation of platinum RTD:
+// R(t) = R0 * (1 + A*t + B*t^2 + C*(t - 100)*t^3), where C is used only below 0 °C
+type CVD struct {
+	R0 float64 `json:"r0"`
+	A  float64 `json:"a"`
+	B  float64 `json:"b"`
+	C  float64 `json:"c"`
+}
+
+// Nickel is equation of nickel RTD:
+// R(t) = R0 * (1 + A*t + B*t^2 + D*t^4 + F*t^6)
+type Nickel struct {
+	R0 float64 `json:"r0"`
+	A  float64 `json:"a"`
+	B  float64 `json:"b"`
+	D  float64 `json:"d"`
+	F  float64 `json:"f"`
+}
+
+// Newton's method stops after newtonSteps or when change is lower than newtonEpsilon
+const (
+	newtonSteps   = 50
+	newtonEpsilon = 1e-9
+)
+
+// Conversion returns Conversion of built-in RTD
+func (r RTD) Conversion() (Conversion, error) {
+	switch r {
+	case PT100:
+		return IEC60751(100), nil
+	case PT1000:
+		return IEC60751(1000), nil
+	case Ni100:
+		return DIN43760(100), nil
+	case Ni120:
+		return DIN43760(120), nil
+	}
+	return nil, fmt.Errorf("{RTD: %v}: %w", r, ErrUnknownRTD)
+}
+
+// IEC60751 returns CVD of standard platinum RTD with nominal resistance r0
+func IEC60751(r0 float64) CVD {
+	return CVD{R0: r0, A: 3.9083e-3, B: -5.775e-7, C: -4.183e-12}
+}
+
+// DIN43760 returns standard nickel RTD with nominal resistance r0
+func DIN43760(r0 float64) Nickel {
+	return Nickel{R0: r0, A: 5.485e-3, B: 6.65e-6, D: 2.805e-11, F: -2e-17}
+}
+
+// Resistance returns resistance of RTD at temperature t
+func (c CVD) Resistance(t float64) float64 {
+	r := 1 + c.A*t + c.B*t*t
+	if t < 0 {
+		r += c.C * (t - 100) * t * t * t
+	}
+	return c.R0 * r
+}
+
+// Temperature returns temperature of RTD with resistance r.
+// Above 0 °C equation is quadratic, below it is solved numerically
+func (c CVD) Temperature(r float64) float64 {
+	t := r/c.R0 - 1
+	if c.B != 0 {
+		t = (-c.A + math.Sqrt(c.A*c.A-4*c.B*(1-r/c.R0))) / (2 * c.B)
+	} else if c.A != 0 {
+		t /= c.A
+	}
+	if t >= 0 || c.C == 0 {
+		return t
+	}
+	return newton(c.Resistance, func(t float64) float64 {
+		return c.R0 * (c.A + 2*c.B*t + c.C*(4*t-300)*t*t)
+	}, r, t)
+}
+
+// Resistance returns resistance of RTD at temperature t
+func (n Nickel) Resistance(t float64) float64 {
+	t2 := t * t
+	return n.R0 * (1 + n.A*t + n.B*t2 + n.D*t2*t2 + n.F*t2*t2*t2)
+}
+
+// Temperature returns temperature of RTD with resistance r, it is solved numerically
+func (n Nickel) Temperature(r float64) float64 {
+	t := (r/n.R0 - 1) / n.A
+	return newton(n.Resistance, func(t float64) float64 {
+		t2 := t * t
+		return n.R0 * (n.A + 2*n.B*t + 4*n.D*t2*t + 6*n.F*t2*t2*t)
+	}, r, t)
+}
+
+// newton finds t, for which resistance(t) equals r, starting from t
+func newton(resistance, derivative func(t float64) float64, r, t float64) float64 {
+	for i := 0; i < newtonSteps; i++ {
+		d := derivative(t)
+		if d == 0 {
+			break
+		}
+		step := (resistance(t) - r) / d
+		t -= step
+		if math.Abs(step) < newtonEpsilon {
+			break
+		}
+	}
+	return t
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package max31865
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversion(t *testing.T) {
+	// Resistances from IEC 60751 and DIN 43760 tables
+	tests := []struct {
+		name       string
+		rtd        RTD
+		t          float64
+		resistance float64
+	}{
+		{name: "pt100, -200", rtd: PT100, t: -200, resistance: 18.52},
+		{name: "pt100, -100", rtd: PT100, t: -100, resistance: 60.26},
+		{name: "pt100, 0", rtd: PT100, t: 0, resistance: 100},
+		{name: "pt100, 100", rtd: PT100, t: 100, resistance: 138.51},
+		{name: "pt100, 850", rtd: PT100, t: 850, resistance: 390.48},
+		{name: "pt1000, -50", rtd: PT1000, t: -50, resistance: 803.06},
+		{name: "pt1000, 100", rtd: PT1000, t: 100, resistance: 1385.06},
+		{name: "ni100, -60", rtd: Ni100, t: -60, resistance: 69.52},
+		{name: "ni100, 100", rtd: Ni100, t: 100, resistance: 161.78},
+		{name: "ni120, 100", rtd: Ni120, t: 100, resistance: 194.14},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := tt.rtd.Conversion()
+			require.Nil(t, err)
+			require.InDelta(t, tt.resistance, c.Resistance(tt.t), 0.01)
+			require.InDelta(t, tt.t, c.Temperature(tt.resistance), 0.05)
+			// Conversion back and forth is exact
+			require.InDelta(t, tt.t, c.Temperature(c.Resistance(tt.t)), 1e-6)
+		})
+	}
+
+	_, err := RTD("pt500").Conversion()
+	require.ErrorIs(t, err, ErrUnknownRTD)
+}
+
+func TestConversion_CVD(t *testing.T) {
+	// Without C, equation is quadratic in whole range
+	c := CVD{R0: 100, A: 3.9083e-3, B: -5.775e-7}
+	require.InDelta(t, -100.0, c.Temperature(c.Resistance(-100)), 1e-9)
+
+	// Linear RTD
+	c = CVD{R0: 100, A: 3.85e-3}
+	require.InDelta(t, 138.5, c.Resistance(100), 1e-9)
+	require.InDelta(t, 100.0, c.Temperature(138.5), 1e-9)
+}
+
+func TestConfig_resistance(t *testing.T) {
+	c := newConfig()
+	c.refRes = 400
+	require.InDelta(t, 100.0, c.resistance(0x2000), 1e-9)
+	require.Equal(t, uint16(0x4000), c.code(0))
+
+	// ThreeWire compensates leads in hardware
+	c.leadRes = 1.5
+	require.InDelta(t, 100.0, c.resistance(0x2000), 1e-9)
+	require.Equal(t, uint16(0x4000), c.code(0))
+
+	// In TwoWire leads are in series with RTD
+	c.wiring = TwoWire
+	require.InDelta(t, 98.5, c.resistance(0x2000), 1e-9)
+	require.InDelta(t, 0.0, c.rtd().Temperature(c.resistance(c.code(0)>>1)), 0.01)
+
+	c.conversion = IEC60751(1000)
+	c.refRes, c.leadRes = 4000, 0
+	require.InDelta(t, 1000.0, c.resistance(0x2000), 1e-9)
+}
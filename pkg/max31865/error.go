@@ -5,7 +5,10 @@
 
 package max31865
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	ErrInterface         = errors.New("error on interface usage")
@@ -16,8 +19,44 @@ var (
 	ErrNoReadyInterface  = errors.New("lack of Ready interface")
 	ErrNoReadWriteCloser = errors.New("lack of ReadWriterCloser interface")
 	ErrTooMuchTriggers   = errors.New("poll received too much triggers")
+	ErrUnknownFilter     = errors.New("unknown filter, expected 50 or 60 Hz")
+	ErrUnknownMode       = errors.New("unknown conversion mode")
+	ErrOneShotAsync      = errors.New("one-shot mode can't be polled by Ready interface")
+	ErrOneShotInterval   = errors.New("poll interval is too short for one-shot mode")
+	ErrFaultThresholds   = errors.New("low fault threshold is higher than high threshold")
+	ErrFaultDetect       = errors.New("fault detection cycle didn't finish")
+	ErrFaultDetected     = errors.New("fault detected")
+	ErrUnknownRTD        = errors.New("unknown rtd")
+)
+
+// Fault is a bitmask of fault status register
+type Fault uint8
+
+// Possible faults, bits are the same as in fault status register
+const (
+	// FaultVoltage is overvoltage or undervoltage on inputs
+	FaultVoltage Fault = 1 << (iota + 2)
+	// FaultRTDInLow is RTDIN- below 0.85 x VBias, usually RTDIN- shorted low or Force- open
+	FaultRTDInLow
+	// FaultRefInLow is REFIN- below 0.85 x VBias, usually Force- open
+	FaultRefInLow
+	// FaultRefInHigh is REFIN- above 0.85 x VBias, usually open RTD element
+	FaultRefInHigh
+	// FaultRTDLow is RTD below low threshold, usually shorted RTD element
+	FaultRTDLow
+	// FaultRTDHigh is RTD above high threshold, usually open RTD element
+	FaultRTDHigh
 )
 
+// faultMask clears unused bits of fault status register
+const faultMask = Fault(0b11111100)
+
+// FaultStatus holds faults and their possible causes, which depend on wiring
+type FaultStatus struct {
+	Fault     Fault  `json:"fault"`
+	FaultText string `json:"fault_text"`
+}
+
 var (
 	twoWireErrors = [...]string{
 		"Overvoltage or undervoltage fault",                  // D2
@@ -48,6 +87,19 @@ var (
 	}
 )
 
+func newFaultStatus(errorReg byte, w Wiring) FaultStatus {
+	fault := Fault(errorReg) & faultMask
+	return FaultStatus{
+		Fault:     fault,
+		FaultText: strings.Join(errorCauses(byte(fault), w), ", "),
+	}
+}
+
+// Has returns true, if all faults from f are set
+func (f Fault) Has(fault Fault) bool {
+	return f&fault == fault
+}
+
 func errorCauses(errorReg byte, w Wiring) []string {
 	const offset = 2
 	bitPos := offset
@@ -6,6 +6,9 @@
 package max31865
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/a-clap/embedded/pkg/gpio"
 )
 
@@ -59,6 +62,68 @@ func WithNominalRes(nominal float64) Option {
 	}
 }
 
+// WithRTD sets built-in Conversion and nominal resistance of RTD
+func WithRTD(rtd RTD) Option {
+	return func(s *Sensor) error {
+		conversion, err := rtd.Conversion()
+		if err != nil {
+			return fmt.Errorf("WithRTD: %w", err)
+		}
+		s.configReg.conversion = conversion
+		s.configReg.nominalRes = conversion.Resistance(0)
+		return nil
+	}
+}
+
+// WithConversion sets Conversion of resistance to temperature, e.g. CVD with custom coefficients
+func WithConversion(conversion Conversion) Option {
+	return func(s *Sensor) error {
+		s.configReg.conversion = conversion
+		return nil
+	}
+}
+
+// WithLeadResistance sets resistance of leads in ohms, which is subtracted from measured resistance.
+// It is used only in TwoWire, where leads are in series with RTD
+func WithLeadResistance(res float64) Option {
+	return func(s *Sensor) error {
+		s.configReg.leadRes = res
+		return nil
+	}
+}
+
+// WithFilter sets frequency of mains, which is rejected by Max
+func WithFilter(filter Filter) Option {
+	return func(s *Sensor) error {
+		s.configReg.filter, s.cfg.Filter = filter, filter
+		return nil
+	}
+}
+
+// WithMode sets conversion mode, in OneShot mode PollInterval is at least OneShotMinInterval
+func WithMode(mode Mode) Option {
+	return func(s *Sensor) error {
+		s.configReg.mode, s.cfg.Mode = mode, mode
+		return nil
+	}
+}
+
+// WithFaultThresholds sets temperatures, outside of which Max reports fault
+func WithFaultThresholds(low, high float64) Option {
+	return func(s *Sensor) error {
+		s.cfg.FaultLow, s.cfg.FaultHigh = low, high
+		return nil
+	}
+}
+
+// WithFaultDetectInterval sets period of automatic fault detection cycle, 0 disables it
+func WithFaultDetectInterval(interval time.Duration) Option {
+	return func(s *Sensor) error {
+		s.cfg.FaultDetectInterval = interval
+		return nil
+	}
+}
+
 // WithSpidev is a standard way of communication with Max - via spidev
 func WithSpidev(devfile string) Option {
 	return func(s *Sensor) error {
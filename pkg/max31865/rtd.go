@@ -5,8 +5,6 @@
 
 package max31865
 
-import "math"
-
 type rtd struct {
 	r   uint16
 	err error
@@ -29,43 +27,3 @@ func (r *rtd) update(msb byte, lsb byte) error {
 func (r *rtd) rtd() uint16 {
 	return r.r
 }
-
-func (r *rtd) toTemperature(refRes float64, rNominal float64) float64 {
-	const (
-		RtdA float64 = 3.9083e-3
-		RtdB float64 = -5.775e-7
-	)
-	Rt := float64(r.r)
-	Rt /= 32768
-	Rt *= refRes
-
-	Z1 := -RtdA
-	Z2 := RtdA*RtdA - (4 * RtdB)
-	Z3 := (4 * RtdB) / rNominal
-	Z4 := 2 * RtdB
-
-	temp := Z2 + (Z3 * Rt)
-	temp = (math.Sqrt(temp) + Z1) / Z4
-
-	if temp >= 0 {
-		return temp
-	}
-
-	Rt /= rNominal
-	Rt *= 100
-
-	rpoly := Rt
-
-	temp = -242.02
-	temp += 2.2228 * rpoly
-	rpoly *= Rt
-	temp += 2.5859e-3 * rpoly
-	rpoly *= rNominal
-	temp -= 4.8260e-6 * rpoly
-	rpoly *= Rt
-	temp -= 2.8183e-8 * rpoly
-	rpoly *= Rt
-	temp += 1.5243e-10 * rpoly
-
-	return temp
-}
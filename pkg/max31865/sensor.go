@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
 	"github.com/a-clap/logging"
 )
 
@@ -25,10 +26,19 @@ type Sensor struct {
 	data            chan Readings
 	cfg             SensorConfig
 	average         *avg.Avg
+	filter          *avg.Chain
+	fault           FaultStatus
+	detected        time.Time
 	polling         atomic.Bool
 	ready           Ready
 	readings        []Readings
-	mtx             sync.Mutex
+	// latest is last polled reading, mtx protects readings and latest
+	latest Readings
+	mtx    sync.Mutex
+	// ctl serializes Poll and Close, cfgMtx protects cfg, average, filter and fault, ioMtx serializes conversions and detected
+	ctl    sync.Mutex
+	cfgMtx sync.Mutex
+	ioMtx  sync.Mutex
 }
 
 // SensorConfig holds configuration for Sensor
@@ -39,15 +49,32 @@ type SensorConfig struct {
 	ASyncPoll    bool          `json:"a_sync_poll"`
 	PollInterval time.Duration `json:"poll_interval"`
 	Samples      uint          `json:"samples"`
+	// Calibration is applied before Correction, Stamp is set by Configure, unless other Stamp is given
+	Calibration calibration.Calibration `json:"calibration"`
+	// Filters are applied in order to corrected temperature, Average is computed from filtered value
+	Filters []avg.FilterConfig `json:"filters"`
+	// Filter and Mode are set in Max, zero values mean Filter50Hz and Continuous
+	Filter Filter `json:"filter"`
+	Mode   Mode   `json:"mode"`
+	// FaultLow and FaultHigh are hardware fault thresholds, they are disabled if FaultLow equals FaultHigh
+	FaultLow  float64 `json:"fault_low"`
+	FaultHigh float64 `json:"fault_high"`
+	// FaultDetectInterval is period of automatic fault detection cycle, which runs before conversion, 0 disables it
+	FaultDetectInterval time.Duration `json:"fault_detect_interval"`
 }
 
 // Readings is a structure returned, when user uses Poll
 type Readings struct {
 	ID          string    `json:"id"`
 	Temperature float64   `json:"temperature"`
+	Filtered    float64   `json:"filtered"`
 	Average     float64   `json:"average"`
 	Stamp       time.Time `json:"stamp"`
 	Error       string    `json:"error"`
+	// Code is value of rtd register (without fault bit), Resistance is resistance of RTD in ohms
+	Code       uint16  `json:"code"`
+	Resistance float64 `json:"resistance"`
+	FaultStatus
 }
 
 // NewSensor creates Sensor with provided options
@@ -67,6 +94,7 @@ func NewSensor(options ...Option) (*Sensor, error) {
 		},
 	}
 	s.average = avg.New(s.cfg.Samples)
+	s.filter, _ = avg.NewChain(nil)
 
 	for _, opt := range options {
 		if err := opt(s); err != nil {
@@ -78,11 +106,23 @@ func NewSensor(options ...Option) (*Sensor, error) {
 	if err := s.verify(); err != nil {
 		return nil, fmt.Errorf("NewSensor.Verify: %w", err)
 	}
+	if s.cfg.Mode == OneShot && s.cfg.PollInterval < OneShotMinInterval {
+		s.cfg.PollInterval = OneShotMinInterval
+	}
+	if err := s.cfg.check(); err != nil {
+		return nil, fmt.Errorf("NewSensor.check: %w", err)
+	}
+	s.configReg.filter, s.configReg.mode = s.cfg.conversion()
 
 	// Do initial regConfig
 	if err := s.config(); err != nil {
 		return nil, fmt.Errorf("NewSensor.config: %w", err)
 	}
+	if s.cfg.FaultLow != s.cfg.FaultHigh {
+		if err := s.setThresholds(s.cfg.FaultLow, s.cfg.FaultHigh); err != nil {
+			return nil, fmt.Errorf("NewSensor.setThresholds: %w", err)
+		}
+	}
 
 	return s, nil
 }
@@ -90,6 +130,8 @@ func NewSensor(options ...Option) (*Sensor, error) {
 // Poll allows user to enable background temperature updates
 // Then data can be retrieved by calling GetReadings()
 func (s *Sensor) Poll() (err error) {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
 	if s.polling.Load() {
 		return fmt.Errorf("Poll {ID: %v}: %w", s.ID(), ErrAlreadyPolling)
 	}
@@ -99,18 +141,19 @@ func (s *Sensor) Poll() (err error) {
 	s.err = make(chan error, 10)
 	s.data = make(chan Readings, 10)
 
+	cfg := s.GetConfig()
 	s.polling.Store(true)
-	if s.cfg.ASyncPoll {
+	if cfg.ASyncPoll {
 		err = s.prepareAsyncPoll()
 	} else {
-		err = s.prepareSyncPoll(s.cfg.PollInterval)
+		err = s.prepareSyncPoll(cfg.PollInterval)
 	}
 
 	if err != nil {
 		s.polling.Store(false)
 		return fmt.Errorf("Poll {ID: %v}: %w", s.ID(), err)
 	}
-	go s.poll()
+	go s.poll(cfg.ASyncPoll)
 
 	return
 }
@@ -128,13 +171,59 @@ func (s *Sensor) GetReadings() []Readings {
 	return c
 }
 
+// Latest returns last polled reading without starting new conversion, it isn't cleared by GetReadings.
+// Stamp is zero before first reading.
+func (s *Sensor) Latest() Readings {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.latest
+}
+
 // Configure is a way to set Config
 func (s *Sensor) Configure(config SensorConfig) error {
+	// Registers are written, so conversion can't run in the meantime
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	if config.ASyncPoll {
 		if s.ready == nil {
 			return fmt.Errorf("Configure {ID: %v, Config: %v}: %w", s.ID(), config, ErrNoReadyInterface)
 		}
 	}
+	if err := config.check(); err != nil {
+		return fmt.Errorf("Configure {ID: %v}: %w", s.ID(), err)
+	}
+	if filter, mode := config.conversion(); filter != s.configReg.filter || mode != s.configReg.mode {
+		if err := s.setConversion(filter, mode); err != nil {
+			return fmt.Errorf("Configure.setConversion {ID: %v, Filter: %v, Mode: %v}: %w", s.ID(), filter, mode, err)
+		}
+	}
+	s.cfg.Filter, s.cfg.Mode = config.Filter, config.Mode
+	if config.FaultLow != s.cfg.FaultLow || config.FaultHigh != s.cfg.FaultHigh {
+		if err := s.setThresholds(config.FaultLow, config.FaultHigh); err != nil {
+			return fmt.Errorf("Configure.setThresholds {ID: %v, FaultLow: %v, FaultHigh: %v}: %w", s.ID(), config.FaultLow, config.FaultHigh, err)
+		}
+		s.cfg.FaultLow, s.cfg.FaultHigh = config.FaultLow, config.FaultHigh
+	}
+	if !s.cfg.Calibration.Equal(config.Calibration) {
+		if err := config.Calibration.Validate(); err != nil {
+			return fmt.Errorf("Configure {ID: %v}: %w", s.ID(), err)
+		}
+		stamp := s.cfg.Calibration.Stamp
+		s.cfg.Calibration = config.Calibration
+		// Other Stamp is kept, so previous calibration can be restored
+		if config.Calibration.Stamp.IsZero() || config.Calibration.Stamp.Equal(stamp) {
+			s.cfg.Calibration.Stamp = time.Now()
+		}
+	}
+	if !s.filter.Matches(config.Filters) {
+		filter, err := avg.NewChain(config.Filters)
+		if err != nil {
+			return fmt.Errorf("Configure {ID: %v}: %w", s.ID(), err)
+		}
+		s.filter, s.cfg.Filters = filter, filter.Configs()
+	}
 	if s.cfg.Samples != config.Samples {
 		s.average.Resize(config.Samples)
 		s.cfg.Samples = config.Samples
@@ -143,22 +232,79 @@ func (s *Sensor) Configure(config SensorConfig) error {
 	s.cfg.ASyncPoll = config.ASyncPoll
 	s.cfg.PollInterval = config.PollInterval
 	s.cfg.Correction = config.Correction
+	s.cfg.FaultDetectInterval = config.FaultDetectInterval
 
 	return nil
 }
 
 // GetConfig returns current Config
 func (s *Sensor) GetConfig() SensorConfig {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	return s.cfg
 }
 
 // Average returns average temperature
 func (s *Sensor) Average() float64 {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
 	return s.average.Average()
 }
 
 // Temperature returns actual temperature and average
 func (s *Sensor) Temperature() (actual float64, average float64, err error) {
+	readings, err := s.measure()
+	return readings.Temperature, readings.Average, err
+}
+
+// DetectFaults runs fault detection cycle and returns detected faults
+func (s *Sensor) DetectFaults() (FaultStatus, error) {
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	fault, err := s.detectFaults()
+	if err != nil {
+		return FaultStatus{}, fmt.Errorf("DetectFaults {ID: %v}: %w", s.ID(), err)
+	}
+	s.setFault(fault)
+	return fault, nil
+}
+
+// Fault returns faults found by last fault detection cycle or conversion
+func (s *Sensor) Fault() FaultStatus {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	return s.fault
+}
+
+// measure reads temperature, corrected value is passed through Filters and filtered value is averaged.
+// If FaultDetectInterval elapsed, fault detection cycle runs first and conversion is skipped on fault.
+// ID, Stamp and Error of readings are left for caller
+func (s *Sensor) measure() (readings Readings, err error) {
+	s.ioMtx.Lock()
+	defer s.ioMtx.Unlock()
+	if interval := s.GetConfig().FaultDetectInterval; interval > 0 && time.Since(s.detected) >= interval {
+		fault, err := s.detectFaults()
+		if err != nil {
+			return readings, fmt.Errorf("Temperature.detectFaults {ID: %v}: %w", s.ID(), err)
+		}
+		s.setFault(fault)
+		if fault.Fault != 0 {
+			readings.FaultStatus = fault
+			return readings, fmt.Errorf("Temperature {ID: %v, Fault: %v, Cause: %v}: %w", s.ID(), fault.Fault, fault.FaultText, ErrFaultDetected)
+		}
+	}
+	if s.configReg.mode == OneShot {
+		if err = s.convert(); err != nil {
+			err = fmt.Errorf("Temperature.convert {ID: %v}: %w", s.ID(), err)
+			return
+		}
+		// VBias is turned off after reading
+		defer func() {
+			if err := s.config(); err != nil {
+				logger.Error("max31865 VBias off", logging.String("ID", s.ID()), logging.String("error", err.Error()))
+			}
+		}()
+	}
 	r, err := s.read(regConf, regFault+1)
 	if err != nil {
 		//	can't do much about it
@@ -169,17 +315,29 @@ func (s *Sensor) Temperature() (actual float64, average float64, err error) {
 	if err != nil {
 		// Not handling error here, should have happened on previous call
 		_ = s.clearFaults()
+		readings.FaultStatus = newFaultStatus(r[regFault], s.configReg.wiring)
+		s.setFault(readings.FaultStatus)
 		// make error more specific
 		err = fmt.Errorf("Temperature.rtd {ID: %v, Reg: %v, Cause: %v}: %w", s.ID(), r[regFault], errorCauses(r[regFault], s.configReg.wiring), err)
 		return
 	}
-	tmp := s.r.toTemperature(s.configReg.refRes, s.configReg.nominalRes)
-	s.average.Add(tmp + s.cfg.Correction)
-	return tmp, s.average.Average(), nil
+	readings.Code = s.r.rtd()
+	readings.Resistance = s.configReg.resistance(readings.Code)
+	tmp := s.configReg.rtd().Temperature(readings.Resistance)
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	readings.Temperature = s.cfg.Calibration.Apply(tmp)
+	readings.Filtered = s.filter.Add(readings.Temperature + s.cfg.Correction)
+	s.average.Add(readings.Filtered)
+	readings.Average = s.average.Average()
+	s.fault = FaultStatus{}
+	return readings, nil
 }
 
 // Close should be always called, if user used Poll
 func (s *Sensor) Close() error {
+	s.ctl.Lock()
+	defer s.ctl.Unlock()
 	if !s.polling.Load() {
 		return nil
 	}
@@ -202,13 +360,17 @@ func (s *Sensor) ID() string {
 
 func (s *Sensor) prepareSyncPoll(pollTime time.Duration) error {
 	s.trig = make(chan struct{})
-	go func(s *Sensor, pollTime time.Duration) {
-		for s.polling.Load() {
-			<-time.After(pollTime)
-			s.callback()
+	// Goroutine may outlive Close, so it works on channels of this Poll only
+	go func(stop chan struct{}, trig chan struct{}, errs chan error) {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(pollTime):
+				trigger(trig, errs)
+			}
 		}
-		close(s.trig)
-	}(s, pollTime)
+	}(s.stop, s.trig, s.err)
 
 	return nil
 }
@@ -221,30 +383,25 @@ func (s *Sensor) prepareAsyncPoll() error {
 	return s.ready.Open(s.callback)
 }
 
-func (s *Sensor) poll() {
-	go func() {
-		for data := range s.data {
-			s.add(data)
+// poll runs until Close, async tells how it was prepared by Poll
+func (s *Sensor) poll(async bool) {
+	go func(data chan Readings) {
+		for r := range data {
+			s.add(r)
 		}
-	}()
+	}(s.data)
 
 	for s.polling.Load() {
 		select {
 		case <-s.stop:
 			s.polling.Store(false)
 		case <-s.trig:
-			tmp, average, err := s.Temperature()
+			r, err := s.measure()
 			var e string
 			if err != nil {
 				e = err.Error()
 			}
-			r := Readings{
-				ID:          s.ID(),
-				Temperature: tmp,
-				Average:     average,
-				Stamp:       time.Now(),
-				Error:       e,
-			}
+			r.ID, r.Stamp, r.Error = s.ID(), time.Now(), e
 			if e != "" {
 				logger.Error("error on max31865.Poll", logging.Reflect("readings", r))
 			}
@@ -258,7 +415,7 @@ func (s *Sensor) poll() {
 	}
 	// For sure there won't be more data
 	close(s.data)
-	if s.cfg.ASyncPoll {
+	if async {
 		s.ready.Close()
 		close(s.trig)
 	}
@@ -268,6 +425,113 @@ func (s *Sensor) poll() {
 	close(s.fin)
 }
 
+// convert runs single conversion in OneShot mode, s.ioMtx must be locked
+func (s *Sensor) convert() error {
+	if err := s.write(regConf, []byte{s.configReg.bias()}); err != nil {
+		return err
+	}
+	time.Sleep(biasSettle)
+	if err := s.write(regConf, []byte{s.configReg.oneShot()}); err != nil {
+		return err
+	}
+	time.Sleep(s.configReg.conversionTime())
+	return nil
+}
+
+// detectFaults runs automatic fault detection cycle, s.ioMtx must be locked
+func (s *Sensor) detectFaults() (FaultStatus, error) {
+	if err := s.write(regConf, []byte{s.configReg.faultDetect()}); err != nil {
+		return FaultStatus{}, err
+	}
+	finished := false
+	for i := 0; i < faultDetectRetries && !finished; i++ {
+		time.Sleep(faultDetectTime)
+		r, err := s.read(regConf, 1)
+		if err != nil {
+			return FaultStatus{}, err
+		}
+		finished = s.configReg.faultDetectFinished(r[0])
+	}
+	if !finished {
+		// Not handling error here, cycle failed anyway
+		_ = s.config()
+		return FaultStatus{}, ErrFaultDetect
+	}
+	r, err := s.read(regFault, 1)
+	if err != nil {
+		return FaultStatus{}, err
+	}
+	s.detected = time.Now()
+	// Faults are latched, clearing them also restores configuration
+	if err := s.clearFaults(); err != nil {
+		return FaultStatus{}, err
+	}
+	return newFaultStatus(r[0], s.configReg.wiring), nil
+}
+
+// setFault stores last known faults
+func (s *Sensor) setFault(fault FaultStatus) {
+	s.cfgMtx.Lock()
+	defer s.cfgMtx.Unlock()
+	s.fault = fault
+}
+
+// setConversion writes filter and mode to Max, s.ioMtx must be locked
+func (s *Sensor) setConversion(filter Filter, mode Mode) error {
+	previous := *s.configReg
+	// Filter can't be changed during continuous conversion
+	if err := s.write(regConf, []byte{s.configReg.reg() &^ (1 << continuous)}); err != nil {
+		return err
+	}
+	s.configReg.filter, s.configReg.mode = filter, mode
+	if err := s.config(); err != nil {
+		*s.configReg = previous
+		return err
+	}
+	return nil
+}
+
+// setThresholds writes fault thresholds to Max, s.ioMtx must be locked
+func (s *Sensor) setThresholds(low, high float64) error {
+	return s.write(regHFaultMsb, s.configReg.thresholds(low, high))
+}
+
+// conversion returns Filter and Mode, zero values are replaced with defaults
+func (c SensorConfig) conversion() (Filter, Mode) {
+	filter, mode := c.Filter, c.Mode
+	if filter == 0 {
+		filter = Filter50Hz
+	}
+	if mode == "" {
+		mode = Continuous
+	}
+	return filter, mode
+}
+
+// check verifies hardware part of config
+func (c SensorConfig) check() error {
+	filter, mode := c.conversion()
+	if filter != Filter50Hz && filter != Filter60Hz {
+		return fmt.Errorf("{Filter: %v}: %w", c.Filter, ErrUnknownFilter)
+	}
+	switch mode {
+	case Continuous:
+	case OneShot:
+		if c.ASyncPoll {
+			return ErrOneShotAsync
+		}
+		if c.PollInterval < OneShotMinInterval {
+			return fmt.Errorf("{PollInterval: %v}: %w", c.PollInterval, ErrOneShotInterval)
+		}
+	default:
+		return fmt.Errorf("{Mode: %v}: %w", c.Mode, ErrUnknownMode)
+	}
+	if c.FaultLow > c.FaultHigh {
+		return fmt.Errorf("{FaultLow: %v, FaultHigh: %v}: %w", c.FaultLow, c.FaultHigh, ErrFaultThresholds)
+	}
+	return nil
+}
+
 func (s *Sensor) clearFaults() error {
 	return s.write(regConf, []byte{s.configReg.clearFaults()})
 }
@@ -330,18 +594,25 @@ func (s *Sensor) verify() error {
 }
 
 func (s *Sensor) callback() {
+	trigger(s.trig, s.err)
+}
+
+func trigger(trig chan struct{}, errs chan error) {
 	// We don't want to block on channel write, as it may be isr
 	select {
-	case s.trig <- struct{}{}:
-		return
+	case trig <- struct{}{}:
 	default:
-		s.err <- ErrTooMuchTriggers
+		select {
+		case errs <- ErrTooMuchTriggers:
+		default:
+		}
 	}
 }
 
 func (s *Sensor) add(r Readings) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
+	s.latest = r
 	s.readings = append(s.readings, r)
 	if len(s.readings) > 100 {
 		s.readings = s.readings[1:]
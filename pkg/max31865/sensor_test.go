@@ -8,9 +8,12 @@ package max31865_test
 import (
 	"errors"
 	"io"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/a-clap/embedded/pkg/avg"
+	"github.com/a-clap/embedded/pkg/calibration"
 	"github.com/a-clap/embedded/pkg/max31865"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -133,6 +136,35 @@ func (s *SensorSuite) TestTemperature() {
 	}
 }
 
+func (s *SensorSuite) TestConversion() {
+	args := []struct {
+		name    string
+		options []max31865.Option
+		config  byte
+		tmp     float64
+	}{
+		{name: "pt1000", options: []max31865.Option{max31865.WithRTD(max31865.PT1000), max31865.WithRefRes(4000.0)}, config: 0xd1, tmp: 70.0},
+		{name: "lead resistance", options: []max31865.Option{max31865.WithRefRes(400.0), max31865.WithWiring(max31865.TwoWire), max31865.WithLeadResistance(27.075)}, config: 0xc1, tmp: 0.0},
+		{name: "lead resistance in three wire", options: []max31865.Option{max31865.WithRefRes(400.0), max31865.WithLeadResistance(27.075)}, config: 0xd1, tmp: 70.0},
+		{name: "custom", options: []max31865.Option{max31865.WithRefRes(400.0), max31865.WithConversion(max31865.CVD{R0: 100, A: 3.85e-3})}, config: 0xd1, tmp: 70.32},
+	}
+	for _, arg := range args {
+		sensorMock = new(SensorTransferMock)
+		sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+		sensorMock.On("ReadWrite", []byte{0x80, arg.config}).Return([]byte{0x00, 0x00}, nil)
+		max, err := max31865.NewSensor(append(arg.options, max31865.WithReadWriteCloser(sensorMock))...)
+		s.Require().Nil(err, arg.name)
+
+		sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+		tmp, _, err := max.Temperature()
+		s.Nil(err, arg.name)
+		s.InDelta(arg.tmp, tmp, 0.01, arg.name)
+	}
+
+	_, err := max31865.NewSensor(max31865.WithRTD("pt500"))
+	s.ErrorIs(err, max31865.ErrUnknownRTD)
+}
+
 func (s *SensorSuite) TestTemperatureError() {
 	// Initial configReg call, always constant
 	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
@@ -151,6 +183,78 @@ func (s *SensorSuite) TestTemperatureError() {
 	s.InDelta(0.0, tmp, 1)
 }
 
+func (s *SensorSuite) TestFaults() {
+	r := s.Require()
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil).Once()
+	max, err := max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithRefRes(400.0))
+	r.Nil(err)
+
+	// On-demand cycle, which finds open RTD
+	sensorMock.On("ReadWrite", []byte{0x80, 0x95}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x00, 0x00}).Return([]byte{0x00, 0x95}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x00, 0x00}).Return([]byte{0x00, 0xd1}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x07, 0x00}).Return([]byte{0x00, 0x27}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd3}).Return([]byte{0x00, 0x00}, nil).Once()
+	fault, err := max.DetectFaults()
+	r.Nil(err)
+	r.Equal(max31865.FaultRefInHigh|max31865.FaultVoltage, fault.Fault)
+	r.True(fault.Fault.Has(max31865.FaultRefInHigh))
+	r.False(fault.Fault.Has(max31865.FaultRTDHigh))
+	r.Contains(fault.FaultText, "Overvoltage or undervoltage fault, Open RTD element")
+	r.Equal(fault, max.Fault())
+	sensorMock.AssertExpectations(s.T())
+
+	// Cycle, which never finishes
+	sensorMock.On("ReadWrite", []byte{0x80, 0x95}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x00, 0x00}).Return([]byte{0x00, 0x95}, nil).Times(10)
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil).Once()
+	_, err = max.DetectFaults()
+	r.ErrorIs(err, max31865.ErrFaultDetect)
+	sensorMock.AssertExpectations(s.T())
+
+	// On-demand cycle has just run, so periodic one waits, conversion clears faults
+	cfg := max.GetConfig()
+	cfg.FaultDetectInterval = time.Hour
+	r.Nil(max.Configure(cfg))
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+	_, _, err = max.Temperature()
+	r.Nil(err)
+	r.Equal(max31865.FaultStatus{}, max.Fault())
+	sensorMock.AssertExpectations(s.T())
+
+	// Periodic cycle runs before conversion
+	cfg.FaultDetectInterval = time.Nanosecond
+	r.Nil(max.Configure(cfg))
+	sensorMock.On("ReadWrite", []byte{0x80, 0x95}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x00, 0x00}).Return([]byte{0x00, 0xd1}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x07, 0x00}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd3}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+	_, _, err = max.Temperature()
+	r.Nil(err)
+	sensorMock.AssertExpectations(s.T())
+
+	// Fault found by periodic cycle skips conversion
+	sensorMock.On("ReadWrite", []byte{0x80, 0x95}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x00, 0x00}).Return([]byte{0x00, 0xd1}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x07, 0x00}).Return([]byte{0x00, 0x04}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd3}).Return([]byte{0x00, 0x00}, nil).Once()
+	_, _, err = max.Temperature()
+	r.ErrorIs(err, max31865.ErrFaultDetected)
+	r.Equal(max31865.FaultVoltage, max.Fault().Fault)
+	sensorMock.AssertExpectations(s.T())
+
+	// Fault bit of rtd
+	cfg.FaultDetectInterval = 0
+	r.Nil(max.Configure(cfg))
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0xFF, 0xFF, 0x51, 0x54, 0x0, 0x0, 0x80}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd3}).Return([]byte{0x00, 0x00}, nil).Once()
+	_, _, err = max.Temperature()
+	r.ErrorIs(err, max31865.ErrRtd)
+	r.Equal(max31865.FaultRTDHigh, max.Fault().Fault)
+}
+
 func (s *SensorSuite) TestConfigure() {
 	args := []struct {
 		name        string
@@ -216,6 +320,134 @@ func (s *SensorSuite) TestConfigure() {
 	}
 }
 
+func (s *SensorSuite) TestConfigure_Hardware() {
+	r := s.Require()
+	sensorMock = new(SensorTransferMock)
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	// 60 Hz and thresholds from 0 to 70 degrees
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd0}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x83, 0x51, 0x54, 0x40, 0x00}).Return(make([]byte, 5), nil).Once()
+	max, err := max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithRefRes(400.0),
+		max31865.WithFilter(max31865.Filter60Hz), max31865.WithFaultThresholds(0, 70))
+	r.Nil(err)
+	cfg := max.GetConfig()
+	r.Equal(max31865.Filter60Hz, cfg.Filter)
+	r.InDelta(70.0, cfg.FaultHigh, 1e-9)
+
+	args := []struct {
+		name string
+		cfg  func(cfg *max31865.SensorConfig)
+		err  error
+	}{
+		{name: "unknown filter", cfg: func(cfg *max31865.SensorConfig) { cfg.Filter = 55 }, err: max31865.ErrUnknownFilter},
+		{name: "unknown mode", cfg: func(cfg *max31865.SensorConfig) { cfg.Mode = "burst" }, err: max31865.ErrUnknownMode},
+		{name: "one shot too often", cfg: func(cfg *max31865.SensorConfig) { cfg.Mode = max31865.OneShot }, err: max31865.ErrOneShotInterval},
+		{name: "thresholds", cfg: func(cfg *max31865.SensorConfig) { cfg.FaultLow = 80 }, err: max31865.ErrFaultThresholds},
+	}
+	for _, arg := range args {
+		cfg := max.GetConfig()
+		arg.cfg(&cfg)
+		r.ErrorIs(max.Configure(cfg), arg.err, arg.name)
+	}
+
+	// Continuous conversion is stopped before filter is changed
+	sensorMock.On("ReadWrite", []byte{0x80, 0x90}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil).Once()
+	cfg.Filter = max31865.Filter50Hz
+	r.Nil(max.Configure(cfg))
+
+	// One-shot turns VBias off, thresholds are disabled
+	sensorMock.On("ReadWrite", []byte{0x80, 0x91}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0x11}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x83, 0xff, 0xff, 0x00, 0x00}).Return(make([]byte, 5), nil).Once()
+	cfg.Mode, cfg.PollInterval = max31865.OneShot, max31865.OneShotMinInterval
+	cfg.FaultLow, cfg.FaultHigh = 0, 0
+	r.Nil(max.Configure(cfg))
+	r.Equal(cfg.Mode, max.GetConfig().Mode)
+	sensorMock.AssertExpectations(s.T())
+
+	// VBias is on only during conversion
+	sensorMock.On("ReadWrite", []byte{0x80, 0x91}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0xb1}).Return([]byte{0x00, 0x00}, nil).Once()
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0x91, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+	sensorMock.On("ReadWrite", []byte{0x80, 0x11}).Return([]byte{0x00, 0x00}, nil).Once()
+	tmp, _, err := max.Temperature()
+	r.Nil(err)
+	r.InDelta(70.0, tmp, 0.1)
+	sensorMock.AssertExpectations(s.T())
+
+	// One-shot can't be polled by DRDY
+	cfg.ASyncPoll = true
+	r.ErrorIs(max.Configure(cfg), max31865.ErrNoReadyInterface)
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	_, err = max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithMode("burst"))
+	r.ErrorIs(err, max31865.ErrUnknownMode)
+}
+
+func (s *SensorSuite) TestCalibration() {
+	r := s.Require()
+	sensorMock = new(SensorTransferMock)
+	// Initial configReg call, always constant
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	// Configuration call
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil)
+	max, err := max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithRefRes(400.0))
+	r.Nil(err)
+
+	cfg := max.GetConfig()
+	cfg.Calibration = calibration.Calibration{Model: calibration.Piecewise, Points: []calibration.Point{{Raw: 70, Reference: 69}, {Raw: 60, Reference: 61}}}
+	r.ErrorIs(max.Configure(cfg), calibration.ErrPointsOrder)
+	r.True(max.GetConfig().Calibration.Stamp.IsZero())
+
+	before := time.Now()
+	cfg.Calibration = calibration.Calibration{Model: calibration.TwoPoint, Points: []calibration.Point{{Raw: 0, Reference: 1}, {Raw: 70, Reference: 69}}}
+	r.Nil(max.Configure(cfg))
+	stamp := max.GetConfig().Calibration.Stamp
+	r.False(stamp.Before(before))
+
+	// Same calibration keeps stamp
+	r.Nil(max.Configure(max.GetConfig()))
+	r.Equal(stamp, max.GetConfig().Calibration.Stamp)
+
+	// 70 degrees, calibration is applied before averaging
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+	tmp, average, err := max.Temperature()
+	r.Nil(err)
+	r.InDelta(69.0, tmp, 0.1)
+	r.InDelta(69.0, average, 0.1)
+}
+
+func (s *SensorSuite) TestFilters() {
+	r := s.Require()
+	sensorMock = new(SensorTransferMock)
+	// Initial configReg call, always constant
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	// Configuration call
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil)
+	max, err := max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithRefRes(400.0))
+	r.Nil(err)
+
+	cfg := max.GetConfig()
+	cfg.Filters = []avg.FilterConfig{{Type: avg.Kalman}}
+	r.ErrorIs(max.Configure(cfg), avg.ErrNoise)
+	r.Nil(max.GetConfig().Filters)
+
+	cfg.Filters = []avg.FilterConfig{{Type: avg.Median, Window: 3}}
+	r.Nil(max.Configure(cfg))
+	r.Equal(cfg.Filters, max.GetConfig().Filters)
+
+	// 0 degrees twice, then 70 degrees: median of first three readings is 0
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x40, 0x00, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Twice()
+	sensorMock.On("ReadWrite", maxInitCall).Return([]byte{0x0, 0xd1, 0x51, 0x54, 0xFF, 0xFF, 0x0, 0x0, 0x0}, nil).Once()
+	var tmp, average float64
+	for i := 0; i < 3; i++ {
+		tmp, average, err = max.Temperature()
+		r.Nil(err)
+	}
+	r.InDelta(70.0, tmp, 0.1)
+	r.InDelta(0.0, average, 0.1)
+}
+
 func (s *SensorSuite) TestPollTime() {
 	r := s.Require()
 	// Initial configReg call, always constant
@@ -233,9 +465,9 @@ func (s *SensorSuite) TestPollTime() {
 	r.Nil(max.Configure(cfg))
 
 	expectedTmp := []float32{
-		-200.1,
-		-175.3,
-		-50.7,
+		-200.0,
+		-175.0,
+		-50.0,
 		0.0,
 		70.0,
 	}
@@ -250,14 +482,24 @@ func (s *SensorSuite) TestPollTime() {
 	for _, buf := range buffers {
 		sensorMock.On("ReadWrite", maxInitCall).Return(buf, nil).Once()
 	}
+	// Poll may do more conversions before Close
+	sensorMock.On("ReadWrite", maxInitCall).Return(buffers[len(buffers)-1], nil)
 	sensorMock.On("Close").Return(nil)
 	err := max.Poll()
 	s.Nil(err)
-	<-time.After(cfg.PollInterval*time.Duration(len(buffers)) + time.Millisecond)
 
+	var readings []max31865.Readings
+	timeout := time.After(time.Second)
+	for len(readings) < len(expectedTmp) {
+		select {
+		case <-timeout:
+			r.Fail("not enough readings")
+		case <-time.After(time.Millisecond):
+			readings = append(readings, max.GetReadings()...)
+		}
+	}
 	s.Nil(max.Close())
-	readings := max.GetReadings()
-	r.Len(readings, len(expectedTmp))
+	readings = readings[:len(expectedTmp)]
 
 	for i := range readings {
 		r.Equal("", readings[i].Error)
@@ -274,10 +516,14 @@ func (s *SensorSuite) TestPollTime() {
 			continue
 		}
 
+		// Readings never come faster than PollInterval, scheduler (e.g. under -race) may delay them
 		diff := readings[i].Stamp.Sub(readings[i-1].Stamp)
-		r.InDelta(cfg.PollInterval, diff, float64(1*time.Millisecond))
+		r.GreaterOrEqual(diff, cfg.PollInterval-time.Millisecond)
+		r.Less(diff, 3*cfg.PollInterval)
 	}
-
+	// Raw values are reported too
+	r.Equal(uint16(0x5154>>1), readings[4].Code)
+	r.InDelta(127.075, readings[4].Resistance, 0.001)
 }
 
 func (s *SensorSuite) TestPollTwice() {
@@ -347,6 +593,59 @@ func (s *SensorSuite) TestPollTriggerReturnsCorrectErrors() {
 	r.Nil(max.Close())
 }
 
+func (s *SensorSuite) TestConcurrent() {
+	r := s.Require()
+	// Initial configReg call, always constant
+	sensorMock.On("ReadWrite", maxInitCall).Return(maxPORState, nil).Once()
+	// Configuration call
+	sensorMock.On("ReadWrite", []byte{0x80, 0xd1}).Return([]byte{0x00, 0x00}, nil)
+	max, err := max31865.NewSensor(max31865.WithReadWriteCloser(sensorMock), max31865.WithRefRes(400.0), max31865.WithID("max"))
+	r.Nil(err)
+
+	// 0 degrees
+	tmp := []byte{0x0, 0xd1, 0x40, 0x00, 0xFF, 0xFF, 0x0, 0x0, 0x0}
+	sensorMock.On("ReadWrite", maxInitCall).Return(tmp, nil)
+	sensorMock.On("Close").Return(nil)
+
+	// Configure changes PollInterval and Correction while poll loop is reading them
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 30; j++ {
+				switch (i + j) % 4 {
+				case 0:
+					_ = max.Poll()
+				case 1:
+					r.Nil(max.Close())
+				case 2:
+					r.Nil(max.Configure(max31865.SensorConfig{
+						ID:           "max",
+						Correction:   float64(j),
+						PollInterval: time.Duration(j%3+1) * time.Millisecond,
+						Samples:      uint(j%5 + 1),
+					}))
+				case 3:
+					_, _, _ = max.Temperature()
+				}
+				_ = max.GetConfig()
+				_ = max.Average()
+				_ = max.GetReadings()
+			}
+		}(i)
+	}
+	wg.Wait()
+	r.Nil(max.Close())
+
+	cfg := max31865.SensorConfig{Name: "final", ID: "max", Correction: 1.5, PollInterval: time.Millisecond, Samples: 1}
+	r.Nil(max.Configure(cfg))
+	r.Equal(cfg, max.GetConfig())
+	_, average, err := max.Temperature()
+	r.Nil(err)
+	r.InDelta(1.5, average, 1e-9)
+}
+
 func (s *SensorSuite) TestNew_Errors() {
 	t := s.Require()
 	{
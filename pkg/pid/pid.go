@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package pid
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidLimits = errors.New("output min must be lower than output max")
+)
+
+// Config holds PID gains, setpoint and output limits
+type Config struct {
+	Kp         float64 `json:"kp"`
+	Ki         float64 `json:"ki"`
+	Kd         float64 `json:"kd"`
+	SetPoint   float64 `json:"set_point"`
+	OutputMin  float64 `json:"output_min"`
+	OutputMax  float64 `json:"output_max"`
+	AntiWindup bool    `json:"anti_windup"`
+}
+
+// Terms are values calculated on last Update
+type Terms struct {
+	Error  float64 `json:"error"`
+	P      float64 `json:"p"`
+	I      float64 `json:"i"`
+	D      float64 `json:"d"`
+	Output float64 `json:"output"`
+}
+
+// PID is a simple PID controller:
+// - integral is kept as I term, so changing Ki doesn't cause output bump,
+// - derivative is calculated on measurement, so changing SetPoint doesn't cause derivative kick,
+// - with AntiWindup, I term is clamped to output limits and integration stops, when output is saturated.
+type PID struct {
+	cfg             Config
+	iTerm           float64
+	prevMeasurement float64
+	initialized     bool
+	terms           Terms
+}
+
+// New creates PID with provided Config
+func New(cfg Config) (*PID, error) {
+	p := &PID{}
+	if err := p.Configure(cfg); err != nil {
+		return nil, fmt.Errorf("New: %w", err)
+	}
+	return p, nil
+}
+
+// Configure changes PID Config, internal state is kept
+func (p *PID) Configure(cfg Config) error {
+	if cfg.OutputMin >= cfg.OutputMax {
+		return fmt.Errorf("Configure {OutputMin: %v, OutputMax: %v}: %w", cfg.OutputMin, cfg.OutputMax, ErrInvalidLimits)
+	}
+	p.cfg = cfg
+	if p.cfg.AntiWindup {
+		p.iTerm = clamp(p.iTerm, p.cfg.OutputMin, p.cfg.OutputMax)
+	}
+	return nil
+}
+
+// Config returns current Config
+func (p *PID) Config() Config {
+	return p.cfg
+}
+
+// Terms returns values calculated on last Update
+func (p *PID) Terms() Terms {
+	return p.terms
+}
+
+// Reset clears internal state
+func (p *PID) Reset() {
+	p.iTerm = 0
+	p.prevMeasurement = 0
+	p.initialized = false
+	p.terms = Terms{}
+}
+
+// Update calculates new output based on measurement and time elapsed since last Update
+func (p *PID) Update(measurement float64, dt time.Duration) float64 {
+	e := p.cfg.SetPoint - measurement
+	pTerm := p.cfg.Kp * e
+
+	iTerm := p.iTerm
+	dTerm := 0.0
+	if seconds := dt.Seconds(); seconds > 0 {
+		iTerm += p.cfg.Ki * e * seconds
+		if p.initialized {
+			dTerm = -p.cfg.Kd * (measurement - p.prevMeasurement) / seconds
+		}
+	}
+
+	if p.cfg.AntiWindup {
+		iTerm = clamp(iTerm, p.cfg.OutputMin, p.cfg.OutputMax)
+		// Don't integrate further, if output is already saturated in the same direction
+		out := pTerm + iTerm + dTerm
+		if (out > p.cfg.OutputMax && e > 0) || (out < p.cfg.OutputMin && e < 0) {
+			iTerm = p.iTerm
+		}
+	}
+
+	p.iTerm = iTerm
+	p.prevMeasurement = measurement
+	p.initialized = true
+
+	p.terms = Terms{
+		Error:  e,
+		P:      pTerm,
+		I:      iTerm,
+		D:      dTerm,
+		Output: clamp(pTerm+iTerm+dTerm, p.cfg.OutputMin, p.cfg.OutputMax),
+	}
+
+	return p.terms.Output
+}
+
+func clamp(value, min, max float64) float64 {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
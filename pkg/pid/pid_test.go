@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2023 a-clap. All rights reserved.
+ * Use of this source code is governed by a MIT-style license that can be found in the LICENSE file.
+ */
+
+package pid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/a-clap/embedded/pkg/pid"
+	"github.com/stretchr/testify/suite"
+)
+
+type PIDTestSuite struct {
+	suite.Suite
+}
+
+func TestPIDTestSuite(t *testing.T) {
+	suite.Run(t, new(PIDTestSuite))
+}
+
+func (t *PIDTestSuite) TestNew_InvalidLimits() {
+	r := t.Require()
+	p, err := pid.New(pid.Config{OutputMin: 100, OutputMax: 0})
+	r.Nil(p)
+	r.ErrorIs(err, pid.ErrInvalidLimits)
+
+	p, err = pid.New(pid.Config{OutputMin: 0, OutputMax: 0})
+	r.Nil(p)
+	r.ErrorIs(err, pid.ErrInvalidLimits)
+}
+
+func (t *PIDTestSuite) TestUpdate_Terms() {
+	r := t.Require()
+	p, err := pid.New(pid.Config{
+		Kp:        2,
+		Ki:        0.5,
+		Kd:        10,
+		SetPoint:  50,
+		OutputMin: -1000,
+		OutputMax: 1000,
+	})
+	r.Nil(err)
+
+	// First update - no derivative yet
+	out := p.Update(40, time.Second)
+	terms := p.Terms()
+	r.InDelta(10, terms.Error, 1e-9)
+	r.InDelta(20, terms.P, 1e-9)
+	r.InDelta(5, terms.I, 1e-9)
+	r.InDelta(0, terms.D, 1e-9)
+	r.InDelta(25, out, 1e-9)
+	r.InDelta(out, terms.Output, 1e-9)
+
+	// Second update - measurement rises by 2 in 1 second
+	out = p.Update(42, time.Second)
+	terms = p.Terms()
+	r.InDelta(8, terms.Error, 1e-9)
+	r.InDelta(16, terms.P, 1e-9)
+	r.InDelta(9, terms.I, 1e-9)
+	r.InDelta(-20, terms.D, 1e-9)
+	r.InDelta(5, out, 1e-9)
+}
+
+func (t *PIDTestSuite) TestUpdate_OutputLimits() {
+	r := t.Require()
+	p, err := pid.New(pid.Config{
+		Kp:        100,
+		SetPoint:  50,
+		OutputMin: 0,
+		OutputMax: 100,
+	})
+	r.Nil(err)
+
+	r.EqualValues(100, p.Update(0, time.Second))
+	r.EqualValues(0, p.Update(100, time.Second))
+}
+
+func (t *PIDTestSuite) TestUpdate_AntiWindup() {
+	args := []struct {
+		name       string
+		antiWindup bool
+		maxITerm   float64
+	}{
+		{
+			name:       "without anti windup integral grows",
+			antiWindup: false,
+			maxITerm:   1000,
+		},
+		{
+			name:       "anti windup stops integration on saturation",
+			antiWindup: true,
+			maxITerm:   100,
+		},
+	}
+	for _, arg := range args {
+		r := t.Require()
+		p, err := pid.New(pid.Config{
+			Kp:         1,
+			Ki:         1,
+			SetPoint:   100,
+			OutputMin:  0,
+			OutputMax:  100,
+			AntiWindup: arg.antiWindup,
+		})
+		r.Nil(err, arg.name)
+
+		// Process can't reach setpoint for a long time
+		for i := 0; i < 100; i++ {
+			p.Update(90, time.Second)
+		}
+		if arg.antiWindup {
+			r.LessOrEqual(p.Terms().I, arg.maxITerm, arg.name)
+		} else {
+			r.InDelta(arg.maxITerm, p.Terms().I, 1e-9, arg.name)
+		}
+
+		// Overshoot - with anti windup output should react immediately
+		out := p.Update(110, time.Second)
+		if arg.antiWindup {
+			r.Less(out, 100.0, arg.name)
+		} else {
+			r.EqualValues(100, out, arg.name)
+		}
+	}
+}
+
+func (t *PIDTestSuite) TestReset() {
+	r := t.Require()
+	p, err := pid.New(pid.Config{Ki: 1, SetPoint: 10, OutputMin: 0, OutputMax: 100})
+	r.Nil(err)
+
+	p.Update(0, time.Second)
+	r.NotZero(p.Terms().I)
+
+	p.Reset()
+	r.Equal(pid.Terms{}, p.Terms())
+	r.InDelta(10, p.Update(0, time.Second), 1e-9)
+}
@@ -82,3 +82,41 @@ func Put[T any, E error](url string, timeout time.Duration, value T) (T, error)
 	}
 	return put, e
 }
+
+func Post[T any, E error](url string, timeout time.Duration, value T) (T, error) {
+	ctx := context.Background()
+	reqContext, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var post T
+	b, err := json.Marshal(&value)
+	if err != nil {
+		return post, err
+	}
+
+	byteReader := bytes.NewReader(b)
+	r, err := http.NewRequestWithContext(reqContext, http.MethodPost, url, byteReader)
+	if err != nil {
+		return post, err
+	}
+
+	// Header needed
+	r.Header.Add("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return post, err
+	}
+
+	// If everything went fine, we should receive T
+	if res.StatusCode == http.StatusOK {
+		err = json.NewDecoder(res.Body).Decode(&post)
+		return post, err
+	}
+
+	// Otherwise, error is expected
+	var e E
+	if err = json.NewDecoder(res.Body).Decode(&e); err != nil {
+		return post, err
+	}
+	return post, e
+}